@@ -6,6 +6,8 @@ CONFIG=$1 ; shift
 
 function finish() {
 	set +e
+	kill %5
+	kill %4
 	kill %3
 	kill %2
 	kill %1
@@ -18,19 +20,34 @@ hegemonie web agent \
 	--endpoint 127.0.0.1:8080 \
 	--region 127.0.0.1:8081 \
 	--auth 127.0.0.1:8082 \
+	--events 127.0.0.1:8083 \
 	&
 
 hegemonie region agent \
 	--load $CONFIG \
 	--save /tmp \
 	--endpoint 127.0.0.1:8081 \
+	--name Calaquyr \
+	--events 127.0.0.1:8083 \
+	--auth 127.0.0.1:8082 \
 	&
 
 hegemonie auth agent \
 	--load $CONFIG/auth.json \
-	--save /tmp \
+	--save /tmp/auth.json \
 	--endpoint 127.0.0.1:8082 \
 	&
 
+hegemonie events agent \
+	--endpoint 127.0.0.1:8083 \
+	--reports /tmp/reports.json \
+	&
+
+hegemonie api agent \
+	--endpoint 127.0.0.1:8084 \
+	--region 127.0.0.1:8081 \
+	--auth 127.0.0.1:8082 \
+	&
+
 trap finish SIGTERM SIGINT
 wait
@@ -98,7 +98,7 @@ func (s {{.SetName}}) Has(id uint64) bool {
 
 func (s *{{.SetName}}) Add(a {{.TypeName}}) {
 	*s = append(*s, a)
-	if nb := len(*s); nb > 2 && !sort.IsSorted((*s)[nb-2:]) {
+	if nb := len(*s); nb > 1 && !sort.IsSorted((*s)[nb-2:]) {
 		sort.Sort(s)
 	}
 }
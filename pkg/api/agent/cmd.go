@@ -8,15 +8,58 @@ package hegemonie_api_agent
 import (
 	"errors"
 	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"gopkg.in/macaron.v1"
+	"net/http"
+	"sync"
 )
 
+// A stateless HTTP+JSON gateway over the internal gRPC services. Each call
+// is authenticated with a bearer token issued by the auth service, then
+// authorized with the same checks as the web UI.
+type apiService struct {
+	endpointNorth  string
+	endpointAuth   string
+	endpointRegion string
+
+	cnxAuth *grpc.ClientConn
+
+	// The directory of the Regions, by name, and the connections to their
+	// services, by endpoint
+	pool       sync.Mutex
+	regions    map[string]string
+	cnxRegions map[string]*grpc.ClientConn
+}
+
 func Command() *cobra.Command {
-	return &cobra.Command{
+	srv := apiService{}
+	agent := &cobra.Command{
 		Use:     "agent",
 		Aliases: []string{"worker", "server", "service", "srv"},
 		Short:   "API service",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return errors.New("NYI")
+			if srv.endpointAuth == "" {
+				return errors.New("Missing auth URL")
+			}
+			var err error
+			srv.cnxAuth, err = grpc.Dial(srv.endpointAuth, grpc.WithInsecure())
+			if err != nil {
+				return err
+			}
+			defer srv.cnxAuth.Close()
+
+			defer srv.closeRegions()
+
+			m := macaron.New()
+			m.Use(macaron.Logger())
+			m.Use(macaron.Recovery())
+			m.Use(macaron.Renderer())
+			srv.routes(m)
+			return http.ListenAndServe(srv.endpointNorth, m)
 		},
 	}
+	agent.Flags().StringVar(&srv.endpointNorth, "endpoint", ":8084", "TCP/IP North endpoint")
+	agent.Flags().StringVar(&srv.endpointAuth, "auth", "", "Auth Server to be contacted")
+	agent.Flags().StringVar(&srv.endpointRegion, "region", "", "Region Server contacted for the regions unknown to the directory")
+	return agent
 }
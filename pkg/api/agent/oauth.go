@@ -0,0 +1,298 @@
+// Copyright (C) 2018-2020 Hegemonie's AUTHORS
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package hegemonie_api_agent
+
+import (
+	authmodel "github.com/jfsmig/hegemonie/pkg/auth/model"
+	auth "github.com/jfsmig/hegemonie/pkg/auth/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gopkg.in/macaron.v1"
+	"html/template"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// The parameters of an authorization request, carried from the consent page
+// to its submission
+type authorizeParams struct {
+	ResponseType string
+	ClientId     string
+	Redirect     string
+	Scope        string
+	State        string
+	Challenge    string
+	Method       string
+}
+
+type consentPage struct {
+	authorizeParams
+	ClientName string
+	Scopes     []string
+	Error      string
+}
+
+var scopeDescriptions = map[string]string{
+	authmodel.ScopeRead: "look at your characters, their cities and armies",
+	authmodel.ScopeCity: "manage your cities: study, build, train",
+	authmodel.ScopeArmy: "command your armies",
+}
+
+var consentTemplate = template.Must(template.New("consent").Funcs(template.FuncMap{
+	"describe": func(s string) string { return scopeDescriptions[s] },
+}).Parse(`<!DOCTYPE html>
+<html><head><meta charset="utf-8"><title>Hegemonie - Authorization</title></head>
+<body>
+<h1>{{.ClientName}} requests access to your account</h1>
+<p>It will be allowed to:</p>
+<ul>{{range .Scopes}}<li>{{describe .}}</li>{{end}}</ul>
+{{if .Error}}<p><strong>{{.Error}}</strong></p>{{end}}
+<form method="post" action="/oauth/authorize">
+<input type="hidden" name="response_type" value="{{.ResponseType}}">
+<input type="hidden" name="client_id" value="{{.ClientId}}">
+<input type="hidden" name="redirect_uri" value="{{.Redirect}}">
+<input type="hidden" name="scope" value="{{.Scope}}">
+<input type="hidden" name="state" value="{{.State}}">
+<input type="hidden" name="code_challenge" value="{{.Challenge}}">
+<input type="hidden" name="code_challenge_method" value="{{.Method}}">
+<label>Mail <input type="email" name="mail"></label>
+<label>Password <input type="password" name="pass"></label>
+<button type="submit" name="decision" value="allow">Allow</button>
+<button type="submit" name="decision" value="deny">Deny</button>
+</form>
+</body></html>
+`))
+
+type oauthError struct {
+	Error       string `json:"error"`
+	Description string `json:"error_description,omitempty"`
+}
+
+type oauthToken struct {
+	AccessToken  string `json:"access_token"`
+	TokenType    string `json:"token_type"`
+	ExpiresIn    int64  `json:"expires_in"`
+	RefreshToken string `json:"refresh_token,omitempty"`
+	Scope        string `json:"scope"`
+}
+
+func (srv *apiService) routesOAuth(m *macaron.Macaron) {
+	m.Get("/oauth/authorize", srv.showConsent)
+	m.Post("/oauth/authorize", srv.doAuthorize)
+	m.Post("/oauth/token", srv.doToken)
+	m.Post("/oauth/revoke", srv.doRevoke)
+}
+
+func readAuthorizeParams(ctx *macaron.Context) authorizeParams {
+	return authorizeParams{
+		ResponseType: ctx.Query("response_type"),
+		ClientId:     ctx.Query("client_id"),
+		Redirect:     ctx.Query("redirect_uri"),
+		Scope:        ctx.Query("scope"),
+		State:        ctx.Query("state"),
+		Challenge:    ctx.Query("code_challenge"),
+		Method:       ctx.Query("code_challenge_method"),
+	}
+}
+
+func splitScopes(s string) []string {
+	return strings.Fields(s)
+}
+
+// Check the client and its redirection. Until both are validated, the
+// errors are displayed to the User instead of being sent to the redirection.
+func (srv *apiService) checkClient(ctx *macaron.Context, p authorizeParams) (*auth.ClientView, bool) {
+	cli := auth.NewAuthClient(srv.cnxAuth)
+	c, err := cli.ClientShow(ctx.Req.Context(), &auth.ClientShowReq{Id: p.ClientId})
+	if err != nil {
+		ctx.PlainText(http.StatusBadRequest, []byte("Unknown client"))
+		return nil, false
+	}
+	for _, r := range c.Redirects {
+		if r == p.Redirect {
+			return c, true
+		}
+	}
+	ctx.PlainText(http.StatusBadRequest, []byte("Redirect URI not registered"))
+	return nil, false
+}
+
+// Send the outcome of the authorization to the client, through the User agent
+func redirectTo(ctx *macaron.Context, p authorizeParams, args url.Values) {
+	if p.State != "" {
+		args.Set("state", p.State)
+	}
+	sep := "?"
+	if strings.Contains(p.Redirect, "?") {
+		sep = "&"
+	}
+	ctx.Redirect(p.Redirect + sep + args.Encode())
+}
+
+func renderConsent(ctx *macaron.Context, code int, page consentPage) {
+	ctx.Resp.Header().Set("Content-Type", "text/html; charset=utf-8")
+	ctx.Resp.Header().Set("X-Frame-Options", "DENY")
+	ctx.Resp.Header().Set("Cache-Control", "no-store")
+	ctx.Resp.WriteHeader(code)
+	consentTemplate.Execute(ctx.Resp, page)
+}
+
+// Return the scopes requested by the client, the read-only access by default,
+// and whether they are all known.
+func requestedScopes(p authorizeParams) ([]string, bool) {
+	scopes := splitScopes(p.Scope)
+	for _, s := range scopes {
+		if _, ok := scopeDescriptions[s]; !ok {
+			return nil, false
+		}
+	}
+	if len(scopes) == 0 {
+		scopes = []string{authmodel.ScopeRead}
+	}
+	return scopes, true
+}
+
+func (srv *apiService) showConsent(ctx *macaron.Context) {
+	p := readAuthorizeParams(ctx)
+	c, ok := srv.checkClient(ctx, p)
+	if !ok {
+		return
+	}
+	if p.ResponseType != "code" {
+		redirectTo(ctx, p, url.Values{"error": {"unsupported_response_type"}})
+		return
+	}
+	if p.Challenge == "" || p.Method != "S256" {
+		redirectTo(ctx, p, url.Values{"error": {"invalid_request"},
+			"error_description": {"PKCE with S256 required"}})
+		return
+	}
+	scopes, ok := requestedScopes(p)
+	if !ok {
+		redirectTo(ctx, p, url.Values{"error": {"invalid_scope"}})
+		return
+	}
+	renderConsent(ctx, http.StatusOK, consentPage{authorizeParams: p, ClientName: c.Name, Scopes: scopes})
+}
+
+func (srv *apiService) doAuthorize(ctx *macaron.Context) {
+	p := readAuthorizeParams(ctx)
+	c, ok := srv.checkClient(ctx, p)
+	if !ok {
+		return
+	}
+	if ctx.Query("decision") != "allow" {
+		redirectTo(ctx, p, url.Values{"error": {"access_denied"}})
+		return
+	}
+	scopes, ok := requestedScopes(p)
+	if !ok {
+		redirectTo(ctx, p, url.Values{"error": {"invalid_scope"}})
+		return
+	}
+
+	cli := auth.NewAuthClient(srv.cnxAuth)
+	u, err := cli.UserAuth(ctx.Req.Context(),
+		&auth.UserAuthReq{Mail: ctx.Query("mail"), Pass: ctx.Query("pass")})
+	if err != nil {
+		page := consentPage{authorizeParams: p, ClientName: c.Name, Scopes: scopes,
+			Error: "Authentication failed"}
+		renderConsent(ctx, http.StatusUnauthorized, page)
+		return
+	}
+
+	rep, err := cli.Authorize(ctx.Req.Context(), &auth.AuthorizeReq{
+		User: u.Id, Client: c.Id, Redirect: p.Redirect, Scopes: scopes,
+		Challenge: p.Challenge, Method: p.Method,
+	})
+	if err != nil {
+		code := "server_error"
+		if status.Code(err) == codes.InvalidArgument {
+			code = "invalid_request"
+		}
+		redirectTo(ctx, p, url.Values{"error": {code}})
+		return
+	}
+	redirectTo(ctx, p, url.Values{"code": {rep.Code}})
+}
+
+// Return the credentials of the client, from the basic authentication or
+// from the body of the request
+func clientCredentials(ctx *macaron.Context) (string, string) {
+	if id, secret, ok := ctx.Req.BasicAuth(); ok {
+		return id, secret
+	}
+	return ctx.Query("client_id"), ctx.Query("client_secret")
+}
+
+func failOAuth(ctx *macaron.Context, err error) {
+	code, rep := http.StatusInternalServerError, oauthError{Error: "server_error"}
+	switch status.Code(err) {
+	case codes.Unauthenticated:
+		code, rep.Error = http.StatusUnauthorized, "invalid_client"
+	case codes.PermissionDenied:
+		code, rep.Error = http.StatusBadRequest, "invalid_grant"
+	case codes.InvalidArgument:
+		code, rep.Error = http.StatusBadRequest, "invalid_scope"
+	}
+	if s, ok := status.FromError(err); ok {
+		rep.Description = s.Message()
+	}
+	ctx.Resp.Header().Set("Cache-Control", "no-store")
+	ctx.JSON(code, rep)
+}
+
+func (srv *apiService) doToken(ctx *macaron.Context) {
+	id, secret := clientCredentials(ctx)
+	cli := auth.NewAuthClient(srv.cnxAuth)
+
+	var rep *auth.TokenView
+	var err error
+	switch ctx.Query("grant_type") {
+	case "authorization_code":
+		rep, err = cli.TokenExchange(ctx.Req.Context(), &auth.TokenExchangeReq{
+			Client: id, Secret: secret, Code: ctx.Query("code"),
+			Redirect: ctx.Query("redirect_uri"), Verifier: ctx.Query("code_verifier"),
+		})
+	case "refresh_token":
+		rep, err = cli.TokenRefresh(ctx.Req.Context(), &auth.TokenRefreshReq{
+			Client: id, Secret: secret, Refresh: ctx.Query("refresh_token"),
+			Scopes: splitScopes(ctx.Query("scope")),
+		})
+	default:
+		ctx.JSON(http.StatusBadRequest, oauthError{Error: "unsupported_grant_type"})
+		return
+	}
+	if err != nil {
+		failOAuth(ctx, err)
+		return
+	}
+
+	ctx.Resp.Header().Set("Cache-Control", "no-store")
+	ctx.JSON(http.StatusOK, oauthToken{
+		AccessToken:  rep.Token,
+		TokenType:    "Bearer",
+		ExpiresIn:    rep.Expires - time.Now().Unix(),
+		RefreshToken: rep.Refresh,
+		Scope:        strings.Join(rep.Scopes, " "),
+	})
+}
+
+// Revoke a token on behalf of the client it has been granted to (RFC 7009).
+// Unknown tokens are not reported as errors.
+func (srv *apiService) doRevoke(ctx *macaron.Context) {
+	id, secret := clientCredentials(ctx)
+	cli := auth.NewAuthClient(srv.cnxAuth)
+	_, err := cli.ClientTokenRevoke(ctx.Req.Context(),
+		&auth.ClientTokenRevokeReq{Client: id, Secret: secret, Token: ctx.Query("token")})
+	if err != nil && status.Code(err) != codes.NotFound {
+		failOAuth(ctx, err)
+		return
+	}
+	ctx.Status(http.StatusOK)
+}
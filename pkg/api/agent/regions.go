@@ -0,0 +1,119 @@
+// Copyright (C) 2018-2020 Hegemonie's AUTHORS
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package hegemonie_api_agent
+
+import (
+	"context"
+	"errors"
+	auth "github.com/jfsmig/hegemonie/pkg/auth/proto"
+	"google.golang.org/grpc"
+	"log"
+	"sort"
+)
+
+// Refresh the directory of the Regions from the auth service
+func (srv *apiService) loadRegions(ctx context.Context) {
+	rep, err := auth.NewAuthClient(srv.cnxAuth).RegionList(ctx, &auth.None{})
+	if err != nil {
+		log.Println("Reload error (regions):", err.Error())
+		return
+	}
+	tab := make(map[string]string)
+	for _, r := range rep.Items {
+		tab[r.Name] = r.Endpoint
+	}
+	srv.pool.Lock()
+	srv.regions = tab
+	srv.pool.Unlock()
+}
+
+// Return the connection to the endpoint, dialed at the first use. The
+// connections are kept even when their Region leaves the directory, the
+// endpoints are few and the Regions come back after a restart.
+func (srv *apiService) dialRegion(endpoint string) (*grpc.ClientConn, error) {
+	srv.pool.Lock()
+	defer srv.pool.Unlock()
+	if cnx, ok := srv.cnxRegions[endpoint]; ok {
+		return cnx, nil
+	}
+	cnx, err := grpc.Dial(endpoint, grpc.WithInsecure())
+	if err != nil {
+		return nil, err
+	}
+	if srv.cnxRegions == nil {
+		srv.cnxRegions = make(map[string]*grpc.ClientConn)
+	}
+	srv.cnxRegions[endpoint] = cnx
+	return cnx, nil
+}
+
+func (srv *apiService) lookupRegion(name string) (string, bool) {
+	srv.pool.Lock()
+	defer srv.pool.Unlock()
+	endpoint, ok := srv.regions[name]
+	return endpoint, ok
+}
+
+// Return the connection to the service of the Region. A Region unknown to
+// the directory triggers a refresh, in case it just registered, then falls
+// back to the default Region service.
+func (srv *apiService) cnxRegionOf(ctx context.Context, name string) (*grpc.ClientConn, error) {
+	if name == "" {
+		return srv.cnxRegionDefault(ctx)
+	}
+	endpoint, ok := srv.lookupRegion(name)
+	if !ok {
+		srv.loadRegions(ctx)
+		endpoint, ok = srv.lookupRegion(name)
+	}
+	if !ok {
+		if srv.endpointRegion == "" {
+			return nil, errors.New("Region unavailable")
+		}
+		endpoint = srv.endpointRegion
+	}
+	return srv.dialRegion(endpoint)
+}
+
+// Return the connection to the default Region service, or to the first
+// Region of the directory if there is none.
+func (srv *apiService) cnxRegionDefault(ctx context.Context) (*grpc.ClientConn, error) {
+	if srv.endpointRegion != "" {
+		return srv.dialRegion(srv.endpointRegion)
+	}
+	names := srv.regionNames()
+	if len(names) == 0 {
+		srv.loadRegions(ctx)
+		names = srv.regionNames()
+	}
+	if len(names) == 0 {
+		return nil, errors.New("No region available")
+	}
+	endpoint, _ := srv.lookupRegion(names[0])
+	return srv.dialRegion(endpoint)
+}
+
+// Return the names of the Regions of the directory, sorted
+func (srv *apiService) regionNames() []string {
+	srv.pool.Lock()
+	defer srv.pool.Unlock()
+	names := make([]string, 0, len(srv.regions))
+	for name := range srv.regions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Close the connections to the Region services
+func (srv *apiService) closeRegions() {
+	srv.pool.Lock()
+	defer srv.pool.Unlock()
+	for _, cnx := range srv.cnxRegions {
+		cnx.Close()
+	}
+	srv.cnxRegions = nil
+}
@@ -0,0 +1,448 @@
+// Copyright (C) 2018-2020 Hegemonie's AUTHORS
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package hegemonie_api_agent
+
+import (
+	"encoding/json"
+	authmodel "github.com/jfsmig/hegemonie/pkg/auth/model"
+	auth "github.com/jfsmig/hegemonie/pkg/auth/proto"
+	region "github.com/jfsmig/hegemonie/pkg/region/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gopkg.in/macaron.v1"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+type FormToken struct {
+	Mail string `json:"mail"`
+	Pass string `json:"pass"`
+}
+
+type FormType struct {
+	Type uint64 `json:"type"`
+}
+
+type FormClient struct {
+	Name         string   `json:"name"`
+	Redirects    []string `json:"redirect_uris"`
+	Confidential bool     `json:"confidential"`
+}
+
+type FormCommand struct {
+	Target uint64 `json:"target"`
+	Action uint64 `json:"action"`
+	// A Cell of another Region, instead of the target City
+	Region string `json:"region,omitempty"`
+	Cell   uint64 `json:"cell,omitempty"`
+}
+
+type replyError struct {
+	Error string `json:"error"`
+}
+
+func (srv *apiService) routes(m *macaron.Macaron) {
+	m.Post("/v1/token", srv.issueToken)
+	srv.routesOAuth(m)
+
+	read, city, army := require(authmodel.ScopeRead), require(authmodel.ScopeCity), require(authmodel.ScopeArmy)
+
+	m.Group("/v1", func() {
+		m.Delete("/token", srv.revokeToken)
+		m.Get("/me", srv.showMe)
+
+		m.Post("/clients", firstParty, srv.registerClient)
+		m.Delete("/clients/:id", firstParty, srv.deleteClient)
+
+		m.Get("/definitions/units", srv.listUnits)
+		m.Get("/definitions/buildings", srv.listBuildings)
+		m.Get("/definitions/knowledges", srv.listKnowledges)
+
+		m.Group("/characters/:cid", func() {
+			m.Get("/cities", read, srv.listCities)
+			m.Get("/cities/:lid", read, srv.showCity)
+			m.Post("/cities/:lid/study", city, srv.cityStudy)
+			m.Post("/cities/:lid/build", city, srv.cityBuild)
+			m.Post("/cities/:lid/train", city, srv.cityTrain)
+			m.Get("/cities/:lid/armies", read, srv.listArmies)
+			m.Get("/cities/:lid/armies/:aid", read, srv.showArmy)
+			m.Get("/cities/:lid/armies/:aid/commands", read, srv.listCommands)
+			m.Post("/cities/:lid/armies/:aid/commands", army, srv.addCommand)
+			m.Delete("/cities/:lid/armies/:aid/commands/:idx", army, srv.cancelCommand)
+		}, srv.authorizeCharacter)
+	}, srv.authenticate)
+}
+
+// Translate the status of a failed gRPC call into an HTTP reply
+func fail(ctx *macaron.Context, err error) {
+	code := http.StatusInternalServerError
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		code = http.StatusBadRequest
+	case codes.Unauthenticated:
+		code = http.StatusUnauthorized
+	case codes.PermissionDenied:
+		code = http.StatusForbidden
+	case codes.NotFound:
+		code = http.StatusNotFound
+	case codes.AlreadyExists, codes.FailedPrecondition:
+		code = http.StatusConflict
+	case codes.Unavailable, codes.DeadlineExceeded:
+		code = http.StatusServiceUnavailable
+	}
+	msg := err.Error()
+	if s, ok := status.FromError(err); ok {
+		msg = s.Message()
+	}
+	ctx.JSON(code, replyError{msg})
+}
+
+func failWith(ctx *macaron.Context, code int, msg string) {
+	ctx.JSON(code, replyError{msg})
+}
+
+// Decode the JSON body of the request, and reply with an error on failure
+func decode(ctx *macaron.Context, out interface{}) bool {
+	defer ctx.Req.Body().ReadCloser().Close()
+	if err := json.NewDecoder(ctx.Req.Body().ReadCloser()).Decode(out); err != nil {
+		failWith(ctx, http.StatusBadRequest, "Malformed body")
+		return false
+	}
+	return true
+}
+
+func param(ctx *macaron.Context, name string) uint64 {
+	u, err := strconv.ParseUint(ctx.Params(name), 10, 63)
+	if err != nil {
+		return 0
+	}
+	return u
+}
+
+func query(ctx *macaron.Context, name string) uint64 {
+	u, err := strconv.ParseUint(ctx.Query(name), 10, 63)
+	if err != nil {
+		return 0
+	}
+	return u
+}
+
+func bearer(ctx *macaron.Context) string {
+	h := ctx.Req.Header.Get("Authorization")
+	if !strings.HasPrefix(h, "Bearer ") {
+		return ""
+	}
+	return strings.TrimSpace(h[len("Bearer "):])
+}
+
+// Resolve the bearer token into the User it has been granted to, and its
+// scopes
+func (srv *apiService) authenticate(ctx *macaron.Context) {
+	token := bearer(ctx)
+	if token == "" {
+		ctx.Resp.Header().Set("WWW-Authenticate", "Bearer")
+		failWith(ctx, http.StatusUnauthorized, "Missing bearer token")
+		return
+	}
+	cli := auth.NewAuthClient(srv.cnxAuth)
+	info, err := cli.TokenCheck(ctx.Req.Context(), &auth.TokenReq{Token: token})
+	if err != nil {
+		ctx.Resp.Header().Set("WWW-Authenticate", "Bearer")
+		fail(ctx, err)
+		return
+	}
+	ctx.Map(info)
+	ctx.Map(info.User)
+}
+
+// Check the token has been granted the scope
+func require(scope string) macaron.Handler {
+	return func(ctx *macaron.Context, info *auth.TokenInfo) {
+		for _, s := range info.Scopes {
+			if s == scope {
+				return
+			}
+		}
+		ctx.Resp.Header().Set("WWW-Authenticate", `Bearer error="insufficient_scope", scope="`+scope+`"`)
+		failWith(ctx, http.StatusForbidden, "Insufficient scope")
+	}
+}
+
+// Restrict to the tokens issued to the User itself, e.g. to prevent an OAuth2
+// client from registering other clients
+func firstParty(ctx *macaron.Context, info *auth.TokenInfo) {
+	if info.Client != "" {
+		failWith(ctx, http.StatusForbidden, "Not allowed to OAuth2 clients")
+	}
+}
+
+// Check the authenticated User manages the Character in the path, and
+// connect to the Region the Character lives in. The City and Army are then
+// checked by the region service itself.
+func (srv *apiService) authorizeCharacter(ctx *macaron.Context, u *auth.UserView) {
+	idChar := param(ctx, ":cid")
+	if idChar == 0 {
+		failWith(ctx, http.StatusBadRequest, "Invalid character ID")
+		return
+	}
+	cli := auth.NewAuthClient(srv.cnxAuth)
+	uView, err := cli.CharacterShow(ctx.Req.Context(),
+		&auth.CharacterShowReq{User: u.Id, Character: idChar})
+	if err != nil {
+		fail(ctx, err)
+		return
+	}
+	c := uView.Characters[0]
+	if c.Off {
+		failWith(ctx, http.StatusForbidden, "Character retired")
+		return
+	}
+	cnx, err := srv.cnxRegionOf(ctx.Req.Context(), c.Region)
+	if err != nil {
+		failWith(ctx, http.StatusServiceUnavailable, err.Error())
+		return
+	}
+	ctx.Map(c)
+	ctx.Map(cnx)
+}
+
+func (srv *apiService) issueToken(ctx *macaron.Context) {
+	var form FormToken
+	if !decode(ctx, &form) {
+		return
+	}
+	cli := auth.NewAuthClient(srv.cnxAuth)
+	rep, err := cli.TokenIssue(ctx.Req.Context(),
+		&auth.UserAuthReq{Mail: form.Mail, Pass: form.Pass})
+	if err != nil {
+		fail(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusCreated, rep)
+}
+
+func (srv *apiService) revokeToken(ctx *macaron.Context) {
+	cli := auth.NewAuthClient(srv.cnxAuth)
+	_, err := cli.TokenRevoke(ctx.Req.Context(), &auth.TokenReq{Token: bearer(ctx)})
+	if err != nil {
+		fail(ctx, err)
+		return
+	}
+	ctx.Status(http.StatusNoContent)
+}
+
+func (srv *apiService) showMe(ctx *macaron.Context, u *auth.UserView) {
+	ctx.JSON(http.StatusOK, u)
+}
+
+func (srv *apiService) registerClient(ctx *macaron.Context, u *auth.UserView) {
+	var form FormClient
+	if !decode(ctx, &form) {
+		return
+	}
+	cli := auth.NewAuthClient(srv.cnxAuth)
+	rep, err := cli.ClientRegister(ctx.Req.Context(), &auth.ClientRegisterReq{
+		User: u.Id, Name: form.Name, Redirects: form.Redirects, Confidential: form.Confidential,
+	})
+	if err != nil {
+		fail(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusCreated, rep)
+}
+
+func (srv *apiService) deleteClient(ctx *macaron.Context, u *auth.UserView) {
+	cli := auth.NewAuthClient(srv.cnxAuth)
+	_, err := cli.ClientDelete(ctx.Req.Context(), &auth.ClientDeleteReq{User: u.Id, Id: ctx.Params(":id")})
+	if err != nil {
+		fail(ctx, err)
+		return
+	}
+	ctx.Status(http.StatusNoContent)
+}
+
+func (srv *apiService) listUnits(ctx *macaron.Context) {
+	cnx, err := srv.cnxRegionDefault(ctx.Req.Context())
+	if err != nil {
+		failWith(ctx, http.StatusServiceUnavailable, err.Error())
+		return
+	}
+	cli := region.NewDefinitionsClient(cnx)
+	rep, err := cli.ListUnits(ctx.Req.Context(),
+		&region.PaginatedQuery{Marker: query(ctx, "marker"), Max: uint32(query(ctx, "max"))})
+	if err != nil {
+		fail(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, rep)
+}
+
+func (srv *apiService) listBuildings(ctx *macaron.Context) {
+	cnx, err := srv.cnxRegionDefault(ctx.Req.Context())
+	if err != nil {
+		failWith(ctx, http.StatusServiceUnavailable, err.Error())
+		return
+	}
+	cli := region.NewDefinitionsClient(cnx)
+	rep, err := cli.ListBuildings(ctx.Req.Context(),
+		&region.PaginatedQuery{Marker: query(ctx, "marker"), Max: uint32(query(ctx, "max"))})
+	if err != nil {
+		fail(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, rep)
+}
+
+func (srv *apiService) listKnowledges(ctx *macaron.Context) {
+	cnx, err := srv.cnxRegionDefault(ctx.Req.Context())
+	if err != nil {
+		failWith(ctx, http.StatusServiceUnavailable, err.Error())
+		return
+	}
+	cli := region.NewDefinitionsClient(cnx)
+	rep, err := cli.ListKnowledges(ctx.Req.Context(),
+		&region.PaginatedQuery{Marker: query(ctx, "marker"), Max: uint32(query(ctx, "max"))})
+	if err != nil {
+		fail(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, rep)
+}
+
+func (srv *apiService) listCities(ctx *macaron.Context, c *auth.CharacterView, cnx *grpc.ClientConn) {
+	cli := region.NewCityClient(cnx)
+	rep, err := cli.List(ctx.Req.Context(), &region.ListReq{Character: c.Id})
+	if err != nil {
+		fail(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, rep)
+}
+
+func (srv *apiService) showCity(ctx *macaron.Context, c *auth.CharacterView, cnx *grpc.ClientConn) {
+	cli := region.NewCityClient(cnx)
+	rep, err := cli.Show(ctx.Req.Context(),
+		&region.CityId{Character: c.Id, City: param(ctx, ":lid")})
+	if err != nil {
+		fail(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, rep)
+}
+
+func (srv *apiService) cityStudy(ctx *macaron.Context, c *auth.CharacterView, cnx *grpc.ClientConn) {
+	var form FormType
+	if !decode(ctx, &form) {
+		return
+	}
+	cli := region.NewCityClient(cnx)
+	_, err := cli.Study(ctx.Req.Context(),
+		&region.StudyReq{Character: c.Id, City: param(ctx, ":lid"), KnowledgeType: form.Type})
+	if err != nil {
+		fail(ctx, err)
+		return
+	}
+	ctx.Status(http.StatusNoContent)
+}
+
+func (srv *apiService) cityBuild(ctx *macaron.Context, c *auth.CharacterView, cnx *grpc.ClientConn) {
+	var form FormType
+	if !decode(ctx, &form) {
+		return
+	}
+	cli := region.NewCityClient(cnx)
+	_, err := cli.Build(ctx.Req.Context(),
+		&region.BuildReq{Character: c.Id, City: param(ctx, ":lid"), BuildingType: form.Type})
+	if err != nil {
+		fail(ctx, err)
+		return
+	}
+	ctx.Status(http.StatusNoContent)
+}
+
+func (srv *apiService) cityTrain(ctx *macaron.Context, c *auth.CharacterView, cnx *grpc.ClientConn) {
+	var form FormType
+	if !decode(ctx, &form) {
+		return
+	}
+	cli := region.NewCityClient(cnx)
+	_, err := cli.Train(ctx.Req.Context(),
+		&region.TrainReq{Character: c.Id, City: param(ctx, ":lid"), UnitType: form.Type})
+	if err != nil {
+		fail(ctx, err)
+		return
+	}
+	ctx.Status(http.StatusNoContent)
+}
+
+func (srv *apiService) listArmies(ctx *macaron.Context, c *auth.CharacterView, cnx *grpc.ClientConn) {
+	cli := region.NewCityClient(cnx)
+	rep, err := cli.ListArmies(ctx.Req.Context(),
+		&region.CityId{Character: c.Id, City: param(ctx, ":lid")})
+	if err != nil {
+		fail(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, rep)
+}
+
+func armyId(ctx *macaron.Context, c *auth.CharacterView) *region.ArmyId {
+	return &region.ArmyId{Character: c.Id, City: param(ctx, ":lid"), Army: param(ctx, ":aid")}
+}
+
+func (srv *apiService) showArmy(ctx *macaron.Context, c *auth.CharacterView, cnx *grpc.ClientConn) {
+	cli := region.NewArmyClient(cnx)
+	rep, err := cli.Show(ctx.Req.Context(), armyId(ctx, c))
+	if err != nil {
+		fail(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, rep)
+}
+
+func (srv *apiService) listCommands(ctx *macaron.Context, c *auth.CharacterView, cnx *grpc.ClientConn) {
+	cli := region.NewArmyClient(cnx)
+	rep, err := cli.ListCommands(ctx.Req.Context(), armyId(ctx, c))
+	if err != nil {
+		fail(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, rep)
+}
+
+func (srv *apiService) addCommand(ctx *macaron.Context, c *auth.CharacterView, cnx *grpc.ClientConn) {
+	var form FormCommand
+	if !decode(ctx, &form) {
+		return
+	}
+	cli := region.NewArmyClient(cnx)
+	_, err := cli.Command(ctx.Req.Context(),
+		&region.ArmyCommandReq{Id: armyId(ctx, c), Target: form.Target, Action: form.Action,
+			Region: form.Region, Cell: form.Cell})
+	if err != nil {
+		fail(ctx, err)
+		return
+	}
+	ctx.Status(http.StatusNoContent)
+}
+
+func (srv *apiService) cancelCommand(ctx *macaron.Context, c *auth.CharacterView, cnx *grpc.ClientConn) {
+	idx, err := strconv.ParseUint(ctx.Params(":idx"), 10, 32)
+	if err != nil {
+		failWith(ctx, http.StatusBadRequest, "Invalid command index")
+		return
+	}
+	cli := region.NewArmyClient(cnx)
+	_, err = cli.CancelCommand(ctx.Req.Context(),
+		&region.ArmyCommandCancelReq{Id: armyId(ctx, c), Index: uint32(idx)})
+	if err != nil {
+		fail(ctx, err)
+		return
+	}
+	ctx.Status(http.StatusNoContent)
+}
@@ -14,21 +14,47 @@ import (
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc"
 	"io"
+	"log"
 	"net"
 	"os"
+	"os/signal"
+	"path/filepath"
+	"sync/atomic"
+	"syscall"
+	"time"
 )
 
 type authConfig struct {
 	endpoint string
 	pathLoad string
 	pathSave string
+
+	periodSave time.Duration
+	saveEvery  uint64
+	saveKeep   int
+
+	maxCharacters int
+	tokenTTL      time.Duration
+	accessTTL     time.Duration
+	refreshTTL    time.Duration
+	sessionTTL    time.Duration
+	sessionMaxAge time.Duration
+
+	pathRegions string
+	regionTTL   time.Duration
 }
 
 type authService struct {
 	proto.AuthServer
 
 	db  auth.Db
+	dir *auth.Directory
 	cfg *authConfig
+
+	// Number of mutations since the last snapshot
+	mutations uint64
+	// Wakes the saver up when enough mutations happened
+	trigger chan struct{}
 }
 
 func Command() *cobra.Command {
@@ -52,7 +78,40 @@ func Command() *cobra.Command {
 		"Path of the DB backup to load at startup")
 	agent.Flags().StringVar(
 		&cfg.pathSave, "save", "",
-		"Path where to save the DB backup at exit")
+		"Path where to save the DB backup, periodically and at exit")
+	agent.Flags().DurationVar(
+		&cfg.periodSave, "period-save", time.Minute,
+		"Period of the DB snapshots (0 to disable)")
+	agent.Flags().Uint64Var(
+		&cfg.saveEvery, "save-every", 16,
+		"Number of mutations that trigger a DB snapshot (0 to disable)")
+	agent.Flags().IntVar(
+		&cfg.saveKeep, "save-keep", 5,
+		"Number of previous DB snapshots kept aside the latest one")
+	agent.Flags().IntVar(
+		&cfg.maxCharacters, "max-characters", auth.DefaultMaxCharacters,
+		"Maximum number of live characters per user")
+	agent.Flags().DurationVar(
+		&cfg.tokenTTL, "token-ttl", auth.DefaultTokenTTL,
+		"Validity of the bearer tokens")
+	agent.Flags().DurationVar(
+		&cfg.accessTTL, "oauth-access-ttl", auth.DefaultAccessTTL,
+		"Validity of the access tokens granted to the OAuth2 clients")
+	agent.Flags().DurationVar(
+		&cfg.refreshTTL, "oauth-refresh-ttl", auth.DefaultRefreshTTL,
+		"Validity of the refresh tokens granted to the OAuth2 clients")
+	agent.Flags().DurationVar(
+		&cfg.sessionTTL, "session-ttl", auth.DefaultSessionTTL,
+		"Validity of a session token, before its refresh")
+	agent.Flags().DurationVar(
+		&cfg.sessionMaxAge, "session-max-age", auth.DefaultSessionMaxAge,
+		"Maximum duration of a session, refreshes included")
+	agent.Flags().StringVar(
+		&cfg.pathRegions, "regions", "",
+		"Path of a JSON object mapping the names of the regions to the endpoints of their services")
+	agent.Flags().DurationVar(
+		&cfg.regionTTL, "region-ttl", auth.DefaultRegionTTL,
+		"Validity of the registration of a region service, before its renewal")
 
 	return agent
 }
@@ -65,6 +124,7 @@ func (service *authService) execute() error {
 	var err error
 
 	service.db.Init()
+	service.db.MaxCharacters = service.cfg.maxCharacters
 
 	if service.cfg.pathLoad != "" {
 		p := service.cfg.pathLoad
@@ -89,6 +149,31 @@ func (service *authService) execute() error {
 		return e("Inconsistent DB: %s", err.Error())
 	}
 
+	service.dir = auth.NewDirectory(service.cfg.regionTTL)
+	if service.cfg.pathRegions != "" {
+		p := service.cfg.pathRegions
+
+		var in io.ReadCloser
+		in, err = os.Open(p)
+		if err != nil {
+			return e("Failed to open the regions from [%s]: %s", p, err.Error())
+		}
+		err = service.dir.LoadStatic(in)
+		in.Close()
+		if err != nil {
+			return e("Failed to load the regions from [%s]: %s", p, err.Error())
+		}
+	}
+
+	// The key signing the sessions is generated once and then persisted, so
+	// that the sessions survive the restarts
+	if len(service.db.SessionKey) == 0 {
+		if _, err = service.db.SessionPublicKey(); err != nil {
+			return e("Failed to generate the session key: %s", err.Error())
+		}
+		service.mutated()
+	}
+
 	lis, err := net.Listen("tcp", service.cfg.endpoint)
 	if err != nil {
 		return e("failed to listen: %v", err)
@@ -96,7 +181,22 @@ func (service *authService) execute() error {
 
 	server := grpc.NewServer()
 	proto.RegisterAuthServer(server, service)
-	if err := server.Serve(lis); err != nil {
+
+	// Stop gracefully on a signal, the final snapshot happens below
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+	go func() {
+		sig := <-signals
+		log.Printf("Caught %v, stopping", sig)
+		server.GracefulStop()
+	}()
+
+	stop := make(chan struct{})
+	service.trigger = make(chan struct{}, 1)
+	go service.saver(stop)
+	err = server.Serve(lis)
+	close(stop)
+	if err != nil {
 		return e("failed to serve: %v", err)
 	}
 
@@ -113,6 +213,88 @@ func (srv *authService) postLoad() error {
 	return srv.db.ReHash()
 }
 
+// Account one mutation of the DB, and wake the saver up every Nth one
+func (srv *authService) mutated() {
+	nb := atomic.AddUint64(&srv.mutations, 1)
+	if srv.cfg.saveEvery > 0 && nb >= srv.cfg.saveEvery && srv.trigger != nil {
+		select {
+		case srv.trigger <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// Save the DB periodically or when woken up, until 'stop' is closed
+func (srv *authService) saver(stop <-chan struct{}) {
+	if srv.cfg.pathSave == "" {
+		return
+	}
+
+	var tick <-chan time.Time
+	if srv.cfg.periodSave > 0 {
+		ticker := time.NewTicker(srv.cfg.periodSave)
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-tick:
+		case <-srv.trigger:
+		}
+		if atomic.LoadUint64(&srv.mutations) == 0 {
+			continue
+		}
+		if err := srv.save(); err != nil {
+			log.Printf("Failed to save the DB: %s", err.Error())
+		}
+	}
+}
+
+// Atomically replace the snapshot at the save path, after a rotation of the
+// previous ones. The previous snapshots are kept with a numeric suffix, the
+// most recent first, and each of them is loadable with --load.
 func (srv *authService) save() error {
-	return e("NYI")
+	p := srv.cfg.pathSave
+	tmp := p + ".tmp"
+
+	out, err := os.OpenFile(tmp, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	srv.db.RLock()
+	nb := atomic.SwapUint64(&srv.mutations, 0)
+	err = json.NewEncoder(out).Encode(&srv.db)
+	srv.db.RUnlock()
+	if err == nil {
+		err = out.Sync()
+	}
+	out.Close()
+	if err != nil {
+		atomic.AddUint64(&srv.mutations, nb)
+		_ = os.Remove(tmp)
+		return err
+	}
+
+	// Rotate the previous snapshots. The current one is hard-linked so that
+	// the save path always exists.
+	if srv.cfg.saveKeep > 0 {
+		_ = os.Remove(fmt.Sprintf("%s.%d", p, srv.cfg.saveKeep))
+		for i := srv.cfg.saveKeep - 1; i > 0; i-- {
+			_ = os.Rename(fmt.Sprintf("%s.%d", p, i), fmt.Sprintf("%s.%d", p, i+1))
+		}
+		_ = os.Link(p, p+".1")
+	}
+
+	if err = os.Rename(tmp, p); err != nil {
+		atomic.AddUint64(&srv.mutations, nb)
+		return err
+	}
+	if dir, err := os.Open(filepath.Dir(p)); err == nil {
+		_ = dir.Sync()
+		dir.Close()
+	}
+	return nil
 }
@@ -11,6 +11,7 @@ import (
 	proto "github.com/jfsmig/hegemonie/pkg/auth/proto"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"time"
 )
 
 func userView(u *auth.User) *proto.UserView {
@@ -33,6 +34,9 @@ func userViewFull(u *auth.User) *proto.UserView {
 }
 
 func (srv *authService) UserShow(ctx context.Context, req *proto.UserShowReq) (*proto.UserView, error) {
+	srv.db.RLock()
+	defer srv.db.RUnlock()
+
 	var u *auth.User
 	if req.Id > 0 {
 		u = srv.db.UserGet(req.Id)
@@ -50,6 +54,9 @@ func (srv *authService) UserShow(ctx context.Context, req *proto.UserShowReq) (*
 }
 
 func (srv *authService) UserList(ctx context.Context, req *proto.UserListReq) (*proto.UserListRep, error) {
+	srv.db.RLock()
+	defer srv.db.RUnlock()
+
 	if req.Limit <= 0 {
 		req.Limit = 1024
 	}
@@ -62,6 +69,9 @@ func (srv *authService) UserList(ctx context.Context, req *proto.UserListReq) (*
 		if u.Deleted || u.Id <= req.Marker {
 			continue
 		}
+		if req.WeakPassword && !auth.PasswordWeak(u.Password) {
+			continue
+		}
 
 		rep.Items = append(rep.Items, userView(u))
 	}
@@ -69,49 +79,96 @@ func (srv *authService) UserList(ctx context.Context, req *proto.UserListReq) (*
 }
 
 func (srv *authService) UserCreate(ctx context.Context, req *proto.UserCreateReq) (*proto.UserView, error) {
+	srv.db.WLock()
+	defer srv.db.WUnlock()
+
 	u := srv.db.UserLookup(req.Mail)
 	if u != nil {
 		return nil, status.Error(codes.AlreadyExists, "User already registered")
 	} else {
 		u = srv.db.Create(req.Mail)
 	}
+	srv.mutated()
 	return userViewFull(u), nil
 }
 
 func (srv *authService) UserUpdate(ctx context.Context, req *proto.UserUpdateReq) (*proto.None, error) {
+	srv.db.WLock()
+	defer srv.db.WUnlock()
+
 	u := srv.db.UserGet(req.Id)
-	if u != nil || u.Deleted {
+	if u == nil || u.Deleted {
 		return nil, status.Error(codes.NotFound, "User not found")
 	}
 	if req.Pass != "" {
-		srv.db.SetPassword(u, req.Pass)
+		if err := srv.db.SetPassword(u, req.Pass); err != nil {
+			return nil, status.Errorf(codes.Internal, "Password error: %s", err.Error())
+		}
 		u.Name = req.Name
 	}
+	srv.mutated()
 	return &proto.None{}, nil
 }
 
 func (srv *authService) UserSuspend(ctx context.Context, req *proto.UserSuspendReq) (*proto.None, error) {
+	srv.db.WLock()
+	defer srv.db.WUnlock()
+
 	u := srv.db.UserGet(req.Id)
-	if u != nil || u.Deleted {
+	if u == nil || u.Deleted {
 		return nil, status.Error(codes.NotFound, "User not found")
 	}
 	u.Suspended = true
+	srv.mutated()
 	return &proto.None{}, nil
 }
 
-func (srv *authService) UserAuth(ctx context.Context, req *proto.UserAuthReq) (*proto.UserView, error) {
-	u := srv.db.UserLookup(req.Mail)
+// Check the password of the User. The costly check runs without any lock
+// held, the write lock is only taken to store the rehash of a weak password.
+func (srv *authService) authenticate(mail, pass string) (*auth.User, error) {
+	srv.db.RLock()
+	u := srv.db.UserLookup(mail)
+	valid, encoded := u.Valid(), ""
+	if valid {
+		encoded = u.Password
+	}
+	srv.db.RUnlock()
+
 	if u == nil {
 		return nil, status.Error(codes.NotFound, "No such User")
 	}
-	err := srv.db.AuthBasic(u, req.Pass)
-	if err != nil {
+	if !valid {
 		return nil, status.Error(codes.PermissionDenied, "User suspended")
 	}
+	rehash, err := srv.db.PasswordCheck(encoded, pass)
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, "Permission Denied")
+	}
+	if rehash != "" {
+		srv.db.WLock()
+		if srv.db.PasswordUpgrade(u, encoded, rehash) {
+			srv.mutated()
+		}
+		srv.db.WUnlock()
+	}
+	return u, nil
+}
+
+func (srv *authService) UserAuth(ctx context.Context, req *proto.UserAuthReq) (*proto.UserView, error) {
+	u, err := srv.authenticate(req.Mail, req.Pass)
+	if err != nil {
+		return nil, err
+	}
+
+	srv.db.RLock()
+	defer srv.db.RUnlock()
 	return userView(u), nil
 }
 
 func (srv *authService) CharacterShow(ctx context.Context, req *proto.CharacterShowReq) (*proto.UserView, error) {
+	srv.db.RLock()
+	defer srv.db.RUnlock()
+
 	if req.User <= 0 || req.Character <= 0 {
 		return nil, status.Error(codes.InvalidArgument, "Invalid user/role ID")
 	}
@@ -123,7 +180,7 @@ func (srv *authService) CharacterShow(ctx context.Context, req *proto.CharacterS
 			return nil, status.Error(codes.PermissionDenied, "User suspended")
 		}
 		for _, c := range u.Characters {
-			if c.Id == req.Character {
+			if c.Id == req.Character && !c.Deleted {
 				uView := userView(u)
 				uView.Characters = append(uView.Characters, &proto.CharacterView{
 					Id: c.Id, Region: c.Region, Name: c.Name, Off: c.Off,
@@ -134,3 +191,354 @@ func (srv *authService) CharacterShow(ctx context.Context, req *proto.CharacterS
 		return nil, status.Error(codes.PermissionDenied, "Character mismatch")
 	}
 }
+
+func characterView(c *auth.Character) *proto.CharacterView {
+	return &proto.CharacterView{Id: c.Id, Region: c.Region, Name: c.Name, Off: c.Off}
+}
+
+// Return the User with the given ID, if it is allowed to manage Characters
+func (srv *authService) characterOwner(id uint64) (*auth.User, error) {
+	if id <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "Invalid user ID")
+	}
+	u := srv.db.UserGet(id)
+	if u == nil || u.Deleted {
+		return nil, status.Error(codes.NotFound, "No such User")
+	}
+	if u.Suspended {
+		return nil, status.Error(codes.PermissionDenied, "User suspended")
+	}
+	return u, nil
+}
+
+func (srv *authService) CharacterList(ctx context.Context, req *proto.CharacterListReq) (*proto.CharacterListRep, error) {
+	srv.db.RLock()
+	defer srv.db.RUnlock()
+
+	u, err := srv.characterOwner(req.User)
+	if err != nil {
+		return nil, err
+	}
+	rep := &proto.CharacterListRep{}
+	for _, c := range u.CharacterList() {
+		rep.Items = append(rep.Items, characterView(c))
+	}
+	return rep, nil
+}
+
+func (srv *authService) CharacterCreate(ctx context.Context, req *proto.CharacterCreateReq) (*proto.CharacterView, error) {
+	srv.db.WLock()
+	defer srv.db.WUnlock()
+
+	u, err := srv.characterOwner(req.User)
+	if err != nil {
+		return nil, err
+	}
+	c, err := srv.db.CharacterCreate(u, req.Region, req.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "Creation error: %s", err.Error())
+	}
+	srv.mutated()
+	return characterView(c), nil
+}
+
+func (srv *authService) CharacterUpdate(ctx context.Context, req *proto.CharacterUpdateReq) (*proto.CharacterView, error) {
+	if req.Retire && req.Restore {
+		return nil, status.Error(codes.InvalidArgument, "Both retire and restore")
+	}
+
+	srv.db.WLock()
+	defer srv.db.WUnlock()
+
+	u, err := srv.characterOwner(req.User)
+	if err != nil {
+		return nil, err
+	}
+	c := u.CharacterGet(req.Character)
+	if c == nil {
+		return nil, status.Error(codes.NotFound, "No such Character")
+	}
+	if err = srv.db.CharacterUpdate(c, req.Region, req.Name); err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "Update error: %s", err.Error())
+	}
+	if req.Retire {
+		c.Off = true
+	} else if req.Restore {
+		c.Off = false
+	}
+	srv.mutated()
+	return characterView(c), nil
+}
+
+func (srv *authService) CharacterDelete(ctx context.Context, req *proto.CharacterDeleteReq) (*proto.None, error) {
+	srv.db.WLock()
+	defer srv.db.WUnlock()
+
+	u, err := srv.characterOwner(req.User)
+	if err != nil {
+		return nil, err
+	}
+	c := u.CharacterGet(req.Character)
+	if c == nil {
+		return nil, status.Error(codes.NotFound, "No such Character")
+	}
+	srv.db.CharacterDelete(c)
+	srv.mutated()
+	return &proto.None{}, nil
+}
+
+func tokenView(access, refresh string, t *auth.Token) *proto.TokenView {
+	return &proto.TokenView{
+		Token: access, Refresh: refresh, User: t.User,
+		Expires: t.Expires.Unix(), Scopes: t.Scopes, Client: t.Client,
+	}
+}
+
+func clientView(c *auth.Client) *proto.ClientView {
+	return &proto.ClientView{Id: c.Id, Name: c.Name, Owner: c.Owner, Redirects: c.Redirects}
+}
+
+// Translate the failure of an OAuth2 grant into a gRPC status
+func grantError(err error) error {
+	switch err {
+	case auth.ErrClientInvalid:
+		return status.Error(codes.Unauthenticated, err.Error())
+	case auth.ErrGrantInvalid:
+		return status.Error(codes.PermissionDenied, err.Error())
+	case auth.ErrScopeInvalid, auth.ErrRedirect, auth.ErrPKCE:
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return status.Errorf(codes.Internal, "Grant error: %s", err.Error())
+	}
+}
+
+func (srv *authService) TokenIssue(ctx context.Context, req *proto.UserAuthReq) (*proto.TokenView, error) {
+	u, err := srv.authenticate(req.Mail, req.Pass)
+	if err != nil {
+		return nil, err
+	}
+
+	srv.db.WLock()
+	defer srv.db.WUnlock()
+
+	now := time.Now()
+	srv.db.TokensExpire(now)
+	secret, t, err := srv.db.TokenCreate(u, srv.cfg.tokenTTL, now)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Token error: %s", err.Error())
+	}
+	srv.mutated()
+	return tokenView(secret, "", t), nil
+}
+
+func (srv *authService) TokenCheck(ctx context.Context, req *proto.TokenReq) (*proto.TokenInfo, error) {
+	srv.db.RLock()
+	defer srv.db.RUnlock()
+
+	u, t, err := srv.db.TokenCheck(req.Token, time.Now())
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	return &proto.TokenInfo{
+		User: userViewFull(u), Scopes: t.Scopes, Client: t.Client, Expires: t.Expires.Unix(),
+	}, nil
+}
+
+func (srv *authService) TokenRevoke(ctx context.Context, req *proto.TokenReq) (*proto.None, error) {
+	srv.db.WLock()
+	defer srv.db.WUnlock()
+
+	if !srv.db.TokenRevoke(req.Token) {
+		return nil, status.Error(codes.NotFound, "No such token")
+	}
+	srv.mutated()
+	return &proto.None{}, nil
+}
+
+func (srv *authService) ClientRegister(ctx context.Context, req *proto.ClientRegisterReq) (*proto.ClientView, error) {
+	srv.db.WLock()
+	defer srv.db.WUnlock()
+
+	u, err := srv.characterOwner(req.User)
+	if err != nil {
+		return nil, err
+	}
+	c, secret, err := srv.db.ClientRegister(u, req.Name, req.Redirects, req.Confidential, time.Now())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Registration error: %s", err.Error())
+	}
+	srv.mutated()
+	rep := clientView(c)
+	rep.Secret = secret
+	return rep, nil
+}
+
+func (srv *authService) ClientShow(ctx context.Context, req *proto.ClientShowReq) (*proto.ClientView, error) {
+	srv.db.RLock()
+	defer srv.db.RUnlock()
+
+	c := srv.db.ClientGet(req.Id)
+	if c == nil {
+		return nil, status.Error(codes.NotFound, "No such client")
+	}
+	return clientView(c), nil
+}
+
+func (srv *authService) ClientDelete(ctx context.Context, req *proto.ClientDeleteReq) (*proto.None, error) {
+	srv.db.WLock()
+	defer srv.db.WUnlock()
+
+	c := srv.db.ClientGet(req.Id)
+	if c == nil {
+		return nil, status.Error(codes.NotFound, "No such client")
+	}
+	if c.Owner != req.User {
+		return nil, status.Error(codes.PermissionDenied, "Client not owned")
+	}
+	srv.db.ClientDelete(c)
+	srv.mutated()
+	return &proto.None{}, nil
+}
+
+func (srv *authService) Authorize(ctx context.Context, req *proto.AuthorizeReq) (*proto.AuthorizeRep, error) {
+	srv.db.WLock()
+	defer srv.db.WUnlock()
+
+	u, err := srv.characterOwner(req.User)
+	if err != nil {
+		return nil, err
+	}
+	c := srv.db.ClientGet(req.Client)
+	if c == nil {
+		return nil, status.Error(codes.NotFound, "No such client")
+	}
+	code, err := srv.db.Authorize(c, u, req.Redirect, req.Scopes, req.Challenge, req.Method, time.Now())
+	if err != nil {
+		return nil, grantError(err)
+	}
+	return &proto.AuthorizeRep{Code: code}, nil
+}
+
+func (srv *authService) TokenExchange(ctx context.Context, req *proto.TokenExchangeReq) (*proto.TokenView, error) {
+	srv.db.WLock()
+	defer srv.db.WUnlock()
+
+	now := time.Now()
+	srv.db.TokensExpire(now)
+	access, refresh, t, err := srv.db.Exchange(req.Client, req.Secret, req.Code, req.Redirect, req.Verifier,
+		srv.cfg.accessTTL, srv.cfg.refreshTTL, now)
+	if err != nil {
+		return nil, grantError(err)
+	}
+	srv.mutated()
+	return tokenView(access, refresh, t), nil
+}
+
+func (srv *authService) TokenRefresh(ctx context.Context, req *proto.TokenRefreshReq) (*proto.TokenView, error) {
+	srv.db.WLock()
+	defer srv.db.WUnlock()
+
+	now := time.Now()
+	srv.db.TokensExpire(now)
+	access, refresh, t, err := srv.db.Refresh(req.Client, req.Secret, req.Refresh, req.Scopes,
+		srv.cfg.accessTTL, srv.cfg.refreshTTL, now)
+	if err != nil {
+		return nil, grantError(err)
+	}
+	srv.mutated()
+	return tokenView(access, refresh, t), nil
+}
+
+func (srv *authService) ClientTokenRevoke(ctx context.Context, req *proto.ClientTokenRevokeReq) (*proto.None, error) {
+	srv.db.WLock()
+	defer srv.db.WUnlock()
+
+	revoked, err := srv.db.ClientTokenRevoke(req.Client, req.Secret, req.Token)
+	if err != nil {
+		return nil, grantError(err)
+	}
+	if !revoked {
+		return nil, status.Error(codes.NotFound, "No such token")
+	}
+	srv.mutated()
+	return &proto.None{}, nil
+}
+
+func sessionView(token string, c *auth.SessionClaims, maxAge time.Duration) *proto.SessionView {
+	return &proto.SessionView{
+		Token: token, User: c.User, Expires: c.Expires,
+		Until: c.Since + int64(maxAge/time.Second),
+	}
+}
+
+func (srv *authService) SessionIssue(ctx context.Context, req *proto.UserAuthReq) (*proto.SessionView, error) {
+	u, err := srv.authenticate(req.Mail, req.Pass)
+	if err != nil {
+		return nil, err
+	}
+
+	srv.db.RLock()
+	defer srv.db.RUnlock()
+
+	token, claims, err := srv.db.SessionIssue(u, srv.cfg.sessionTTL, time.Now())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Session error: %s", err.Error())
+	}
+	return sessionView(token, claims, srv.cfg.sessionMaxAge), nil
+}
+
+func (srv *authService) SessionRefresh(ctx context.Context, req *proto.SessionReq) (*proto.SessionView, error) {
+	srv.db.RLock()
+	defer srv.db.RUnlock()
+
+	token, claims, err := srv.db.SessionRefresh(req.Token, srv.cfg.sessionTTL, srv.cfg.sessionMaxAge, time.Now())
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	return sessionView(token, claims, srv.cfg.sessionMaxAge), nil
+}
+
+func (srv *authService) SessionRevoke(ctx context.Context, req *proto.SessionReq) (*proto.None, error) {
+	srv.db.WLock()
+	defer srv.db.WUnlock()
+
+	now := time.Now()
+	srv.db.SessionsExpire(now)
+	if err := srv.db.SessionRevoke(req.Token, srv.cfg.sessionMaxAge, now); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	srv.mutated()
+	return &proto.None{}, nil
+}
+
+func (srv *authService) SessionKey(ctx context.Context, req *proto.None) (*proto.SessionKeyRep, error) {
+	srv.db.RLock()
+	defer srv.db.RUnlock()
+
+	pub, err := srv.db.SessionPublicKey()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Key error: %s", err.Error())
+	}
+	return &proto.SessionKeyRep{Key: pub}, nil
+}
+
+func (srv *authService) RegionRegister(ctx context.Context, req *proto.RegionView) (*proto.None, error) {
+	now := time.Now()
+	srv.dir.Expire(now)
+	switch err := srv.dir.Register(req.Name, req.Endpoint, now); err {
+	case nil:
+		return &proto.None{}, nil
+	case auth.ErrRegionStatic:
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+}
+
+func (srv *authService) RegionList(ctx context.Context, req *proto.None) (*proto.RegionListRep, error) {
+	rep := &proto.RegionListRep{}
+	for _, r := range srv.dir.List(time.Now()) {
+		rep.Items = append(rep.Items, &proto.RegionView{Name: r.Name, Endpoint: r.Endpoint})
+	}
+	return rep, nil
+}
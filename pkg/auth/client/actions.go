@@ -15,6 +15,7 @@ import (
 	"log"
 	"net/mail"
 	"os"
+	"strconv"
 )
 
 func doShow(cmd *cobra.Command, args []string, cfg *authConfig) error {
@@ -59,7 +60,7 @@ func doCreate(cmd *cobra.Command, args []string, cfg *authConfig) error {
 		u, err := client.UserCreate(context.Background(),
 			&proto.UserCreateReq{Mail: addr.Address})
 		if err != nil {
-			log.Printf("ERR", a, err)
+			log.Printf("%v : %v", a, err)
 		} else {
 			enc := json.NewEncoder(os.Stdout)
 			enc.SetIndent("", " ")
@@ -70,6 +71,14 @@ func doCreate(cmd *cobra.Command, args []string, cfg *authConfig) error {
 }
 
 func doList(cmd *cobra.Command, args []string, cfg *authConfig) error {
+	return listUsers(cfg, false)
+}
+
+func doListWeak(cmd *cobra.Command, args []string, cfg *authConfig) error {
+	return listUsers(cfg, true)
+}
+
+func listUsers(cfg *authConfig, weak bool) error {
 	cnx, err := grpc.Dial(cfg.endpoint, grpc.WithInsecure(), grpc.WithBlock())
 	if err != nil {
 		return err
@@ -79,7 +88,7 @@ func doList(cmd *cobra.Command, args []string, cfg *authConfig) error {
 	var last uint64
 	for {
 		rep, err := client.UserList(context.Background(),
-			&proto.UserListReq{Marker: last, Limit: 100})
+			&proto.UserListReq{Marker: last, Limit: 100, WeakPassword: weak})
 		if err != nil {
 			return err
 		}
@@ -96,3 +105,118 @@ func doList(cmd *cobra.Command, args []string, cfg *authConfig) error {
 	}
 	return nil
 }
+
+func dial(cfg *authConfig) (*grpc.ClientConn, proto.AuthClient, error) {
+	cnx, err := grpc.Dial(cfg.endpoint, grpc.WithInsecure(), grpc.WithBlock())
+	if err != nil {
+		return nil, nil, err
+	}
+	return cnx, proto.NewAuthClient(cnx), nil
+}
+
+// Return the ID of the User designated either by its ID or its e-mail
+func resolveUser(client proto.AuthClient, arg string) (uint64, error) {
+	if id, err := strconv.ParseUint(arg, 10, 64); err == nil {
+		return id, nil
+	}
+	u, err := client.UserShow(context.Background(), &proto.UserShowReq{Mail: arg})
+	if err != nil {
+		return 0, err
+	}
+	return u.Id, nil
+}
+
+func dumpJSON(obj interface{}) {
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", " ")
+	enc.Encode(obj)
+}
+
+func doCharacterList(cmd *cobra.Command, args []string, cfg *authConfig) error {
+	cnx, client, err := dial(cfg)
+	if err != nil {
+		return err
+	}
+	defer cnx.Close()
+
+	uid, err := resolveUser(client, args[0])
+	if err != nil {
+		return err
+	}
+	rep, err := client.CharacterList(context.Background(), &proto.CharacterListReq{User: uid})
+	if err != nil {
+		return err
+	}
+	for _, c := range rep.Items {
+		json.NewEncoder(os.Stdout).Encode(c)
+	}
+	return nil
+}
+
+func doCharacterCreate(cmd *cobra.Command, args []string, cfg *authConfig) error {
+	cnx, client, err := dial(cfg)
+	if err != nil {
+		return err
+	}
+	defer cnx.Close()
+
+	uid, err := resolveUser(client, args[0])
+	if err != nil {
+		return err
+	}
+	c, err := client.CharacterCreate(context.Background(),
+		&proto.CharacterCreateReq{User: uid, Region: args[1], Name: args[2]})
+	if err != nil {
+		return err
+	}
+	dumpJSON(c)
+	return nil
+}
+
+func doCharacterUpdate(cmd *cobra.Command, args []string, cfg *authConfig, name, region string, retire, restore bool) error {
+	cid, err := strconv.ParseUint(args[1], 10, 64)
+	if err != nil {
+		return errors.New("Invalid character ID")
+	}
+
+	cnx, client, err := dial(cfg)
+	if err != nil {
+		return err
+	}
+	defer cnx.Close()
+
+	uid, err := resolveUser(client, args[0])
+	if err != nil {
+		return err
+	}
+	c, err := client.CharacterUpdate(context.Background(), &proto.CharacterUpdateReq{
+		User: uid, Character: cid, Name: name, Region: region,
+		Retire: retire, Restore: restore,
+	})
+	if err != nil {
+		return err
+	}
+	dumpJSON(c)
+	return nil
+}
+
+func doCharacterDelete(cmd *cobra.Command, args []string, cfg *authConfig) error {
+	cid, err := strconv.ParseUint(args[1], 10, 64)
+	if err != nil {
+		return errors.New("Invalid character ID")
+	}
+
+	cnx, client, err := dial(cfg)
+	if err != nil {
+		return err
+	}
+	defer cnx.Close()
+
+	uid, err := resolveUser(client, args[0])
+	if err != nil {
+		return err
+	}
+	_, err = client.CharacterDelete(context.Background(),
+		&proto.CharacterDeleteReq{User: uid, Character: cid})
+	return err
+}
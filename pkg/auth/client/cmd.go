@@ -53,7 +53,75 @@ func Command() *cobra.Command {
 		},
 	}
 
-	cmd.Flags().StringVar(&cfg.endpoint, "endpoint", "127.0.0.1:8082", "IP:PORT endpoint for the TCP/IP server")
-	cmd.AddCommand(create, show, list)
+	weak := &cobra.Command{
+		Use:   "weak",
+		Short: "List the users whose password is stored in a weak format",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return doListWeak(cmd, args, &cfg)
+		},
+	}
+
+	cmd.PersistentFlags().StringVar(&cfg.endpoint, "endpoint", "127.0.0.1:8082", "IP:PORT endpoint for the TCP/IP server")
+	cmd.AddCommand(create, show, list, weak, characterCommand(&cfg))
+	return cmd
+}
+
+func characterCommand(cfg *authConfig) *cobra.Command {
+	var name, region string
+	var retire, restore bool
+
+	cmd := &cobra.Command{
+		Use:     "character",
+		Aliases: []string{"char", "role"},
+		Short:   "Manage the Characters of a User",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return errors.New("Missing subcommand")
+		},
+	}
+
+	list := &cobra.Command{
+		Use:     "list USER",
+		Aliases: []string{"ls"},
+		Short:   "List the Characters of a User, by ID or e-mail",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return doCharacterList(cmd, args, cfg)
+		},
+	}
+
+	create := &cobra.Command{
+		Use:     "create USER REGION NAME",
+		Aliases: []string{"add"},
+		Short:   "Create a Character for a User, in a Region",
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return doCharacterCreate(cmd, args, cfg)
+		},
+	}
+
+	update := &cobra.Command{
+		Use:   "update USER CHARACTER",
+		Short: "Rename, rebind, retire or restore a Character",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return doCharacterUpdate(cmd, args, cfg, name, region, retire, restore)
+		},
+	}
+	update.Flags().StringVar(&name, "name", "", "New name of the Character")
+	update.Flags().StringVar(&region, "region", "", "New Region of the Character")
+	update.Flags().BoolVar(&retire, "off", false, "Set the Character off")
+	update.Flags().BoolVar(&restore, "on", false, "Set the Character back on")
+
+	del := &cobra.Command{
+		Use:     "delete USER CHARACTER",
+		Aliases: []string{"del", "rm"},
+		Short:   "Delete a Character",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return doCharacterDelete(cmd, args, cfg)
+		},
+	}
+
+	cmd.AddCommand(list, create, update, del)
 	return cmd
 }
@@ -0,0 +1,99 @@
+// Copyright (C) 2018-2020 Hegemonie's AUTHORS
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package auth
+
+import (
+	"errors"
+	"strings"
+)
+
+// Maximum number of live Characters per User, when not configured
+const DefaultMaxCharacters = 8
+
+func (db *Db) maxCharacters() int {
+	if db.MaxCharacters > 0 {
+		return db.MaxCharacters
+	}
+	return DefaultMaxCharacters
+}
+
+// Return the Character of the User with the given ID, nil if the User has
+// no such Character or if it has been deleted.
+func (u *User) CharacterGet(id uint64) *Character {
+	for i := range u.Characters {
+		if c := &u.Characters[i]; c.Id == id && !c.Deleted {
+			return c
+		}
+	}
+	return nil
+}
+
+// Return the live Characters of the User, i.e. all but the deleted ones
+func (u *User) CharacterList() []*Character {
+	out := make([]*Character, 0, len(u.Characters))
+	for i := range u.Characters {
+		if c := &u.Characters[i]; !c.Deleted {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+// Tell if the name is already used by a live Character in the Region
+func (db *Db) characterNameUsed(region, name string) bool {
+	for _, u := range db.UsersById {
+		for _, c := range u.Characters {
+			if !c.Deleted && c.Region == region && strings.EqualFold(c.Name, name) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (db *Db) CharacterCreate(u *User, region, name string) (*Character, error) {
+	region, name = strings.TrimSpace(region), strings.TrimSpace(name)
+	if region == "" || name == "" {
+		return nil, errors.New("Missing region or name")
+	}
+	if len(u.CharacterList()) >= db.maxCharacters() {
+		return nil, errors.New("Too many characters")
+	}
+	if db.characterNameUsed(region, name) {
+		return nil, errors.New("Name already used in the region")
+	}
+
+	db.NextCharacterId++
+	u.Characters = append(u.Characters, Character{
+		Id: db.NextCharacterId, Region: region, Name: name,
+	})
+	return &u.Characters[len(u.Characters)-1], nil
+}
+
+// Rename the Character and/or bind it to another Region. An empty value
+// leaves the field unchanged.
+func (db *Db) CharacterUpdate(c *Character, region, name string) error {
+	region, name = strings.TrimSpace(region), strings.TrimSpace(name)
+	if region == "" {
+		region = c.Region
+	}
+	if name == "" {
+		name = c.Name
+	}
+	if region == c.Region && name == c.Name {
+		return nil
+	}
+	if (region != c.Region || !strings.EqualFold(name, c.Name)) && db.characterNameUsed(region, name) {
+		return errors.New("Name already used in the region")
+	}
+	c.Region, c.Name = region, name
+	return nil
+}
+
+func (db *Db) CharacterDelete(c *Character) {
+	c.Deleted = true
+	c.Off = true
+}
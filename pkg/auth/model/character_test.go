@@ -0,0 +1,76 @@
+// Copyright (C) 2018-2020 Hegemonie's AUTHORS
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package auth
+
+import (
+	"testing"
+)
+
+func TestCharacterCreate(t *testing.T) {
+	db := &Db{MaxCharacters: 2}
+	db.Init()
+	u0 := db.Create("a@b.c")
+	u1 := db.Create("d@e.f")
+
+	for _, tc := range []struct {
+		name   string
+		user   *User
+		region string
+		char   string
+		ok     bool
+	}{
+		{"first", u0, "r0", "Alice", true},
+		{"same-name-other-region", u1, "r1", "Alice", true},
+		{"same-name-same-region", u1, "r0", "alice", false},
+		{"empty-name", u1, "r0", " ", false},
+		{"empty-region", u1, "", "Bob", false},
+		{"second", u0, "r1", "Bob", true},
+		{"quota", u0, "r2", "Carol", false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			c, err := db.CharacterCreate(tc.user, tc.region, tc.char)
+			if (err == nil) != tc.ok {
+				t.Fatal("unexpected", err)
+			}
+			if tc.ok && tc.user.CharacterGet(c.Id) == nil {
+				t.Fatal("not found")
+			}
+		})
+	}
+
+	// Deleting a Character frees its name and a slot in the quota
+	c := u0.CharacterList()[0]
+	db.CharacterDelete(c)
+	if u0.CharacterGet(c.Id) != nil || len(u0.CharacterList()) != 1 {
+		t.Fatal("not deleted")
+	}
+	if _, err := db.CharacterCreate(u0, "r0", "Alice"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCharacterUpdate(t *testing.T) {
+	db := &Db{}
+	db.Init()
+	u := db.Create("a@b.c")
+	db.CharacterCreate(u, "r0", "Alice")
+	db.CharacterCreate(u, "r1", "Bob")
+	// The creations may move the Characters, get pointers afterwards
+	alice, bob := &u.Characters[0], &u.Characters[1]
+
+	if err := db.CharacterUpdate(alice, "", "ALICE"); err != nil {
+		t.Fatal("case change", err)
+	}
+	if err := db.CharacterUpdate(bob, "r0", ""); err != nil {
+		t.Fatal("rebind", err)
+	}
+	if err := db.CharacterUpdate(bob, "", "alice"); err == nil {
+		t.Fatal("name collision")
+	}
+	if bob.Region != "r0" || bob.Name != "Bob" || alice.Name != "ALICE" {
+		t.Fatal("unexpected state", alice, bob)
+	}
+}
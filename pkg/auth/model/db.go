@@ -6,8 +6,6 @@
 package auth
 
 import (
-	"crypto/sha256"
-	"encoding/hex"
 	"errors"
 	"sync/atomic"
 )
@@ -17,6 +15,14 @@ func (db *Db) Init() {
 	db.ReHash()
 }
 
+func (db *Db) WLock() { db.rw.Lock() }
+
+func (db *Db) WUnlock() { db.rw.Unlock() }
+
+func (db *Db) RLock() { db.rw.RLock() }
+
+func (db *Db) RUnlock() { db.rw.RUnlock() }
+
 func (db *Db) Check() error {
 	return nil
 }
@@ -30,6 +36,11 @@ func (db *Db) ReHash() error {
 		if u.Id > db.NextId {
 			db.NextId = u.Id
 		}
+		for _, c := range u.Characters {
+			if c.Id > db.NextCharacterId {
+				db.NextCharacterId = c.Id
+			}
+		}
 	}
 	db.NextId++
 	return nil
@@ -66,37 +77,53 @@ func (db *Db) Create(email string) *User {
 	return u
 }
 
-func (db *Db) SetPassword(u *User, pass string) {
-	u.Password = hashPassword(pass, db.Salt)
+func (db *Db) SetPassword(u *User, pass string) error {
+	encoded, err := hashPassword(pass)
+	if err != nil {
+		return err
+	}
+	u.Password = encoded
+	return nil
 }
 
-func (db *Db) AuthBasic(u *User, pass string) error {
+// Check the password of the User. On success, a password stored in a weak
+// format is transparently rehashed, and 'upgraded' is set.
+func (db *Db) AuthBasic(u *User, pass string) (upgraded bool, err error) {
 	if !u.Valid() {
-		return errors.New("User suspended")
+		return false, errors.New("User suspended")
 	}
-	if len(u.Password) <= 0 {
-		return errors.New("Permission Denied")
+	rehash, err := db.PasswordCheck(u.Password, pass)
+	if err != nil {
+		return false, err
 	}
+	return db.PasswordUpgrade(u, u.Password, rehash), nil
+}
 
-	if u.Password[0] == ':' {
-		if u.Password[1:] != pass {
-			return errors.New("Permission Denied")
-		}
-	} else {
-		if u.Password[:] != hashPassword(pass, db.Salt) {
-			return errors.New("Permission Denied")
-		}
+// Check the password against its stored form. The User is left untouched so
+// that the costly check may run without any lock held. When the stored form
+// is weak, the rehash to be stored with PasswordUpgrade is returned.
+func (db *Db) PasswordCheck(encoded, pass string) (rehash string, err error) {
+	ok, err := checkPassword(encoded, pass, db.Salt)
+	if err != nil || !ok {
+		return "", errors.New("Permission Denied")
 	}
-	return nil
+	if PasswordWeak(encoded) {
+		// A failed rehash only delays the upgrade to the next check
+		rehash, _ = hashPassword(pass)
+	}
+	return rehash, nil
 }
 
-func (u *User) Valid() bool {
-	return u != nil && !u.Suspended && !u.Deleted
+// Store the rehash of the password checked by PasswordCheck, unless the
+// password changed meanwhile. Tell if the User has been modified.
+func (db *Db) PasswordUpgrade(u *User, encoded, rehash string) bool {
+	if rehash == "" || u.Password != encoded {
+		return false
+	}
+	u.Password = rehash
+	return true
 }
 
-func hashPassword(pass, salt string) string {
-	checksum := sha256.New()
-	checksum.Write([]byte(salt))
-	checksum.Write([]byte(pass))
-	return hex.EncodeToString(checksum.Sum(nil))
+func (u *User) Valid() bool {
+	return u != nil && !u.Suspended && !u.Deleted
 }
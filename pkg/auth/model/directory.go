@@ -0,0 +1,141 @@
+// Copyright (C) 2018-2020 Hegemonie's AUTHORS
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package auth
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const DefaultRegionTTL = time.Minute
+
+var (
+	ErrRegionInvalid = errors.New("Invalid region")
+	ErrRegionStatic  = errors.New("Region statically configured")
+)
+
+// The endpoint of the Region service hosting a Region
+type RegionEntry struct {
+	Name     string
+	Endpoint string
+	// Loaded from the configuration, never expires
+	Static bool
+	// Last registration of a dynamic entry
+	Seen time.Time
+}
+
+// The directory resolving the Region of a Character into the endpoint of its
+// service. The entries come either from a static configuration or from the
+// periodic registrations of the Region services, and the registrations
+// expire unless they are renewed. The directory isn't persisted.
+type Directory struct {
+	ttl     time.Duration
+	regions map[string]*RegionEntry
+	rw      sync.RWMutex
+}
+
+func NewDirectory(ttl time.Duration) *Directory {
+	if ttl <= 0 {
+		ttl = DefaultRegionTTL
+	}
+	return &Directory{ttl: ttl, regions: make(map[string]*RegionEntry)}
+}
+
+func checkRegion(name, endpoint string) error {
+	if strings.TrimSpace(name) == "" || strings.TrimSpace(endpoint) == "" {
+		return ErrRegionInvalid
+	}
+	return nil
+}
+
+// Load the static entries from a JSON object mapping the names of the Regions
+// to their endpoints
+func (d *Directory) LoadStatic(in io.Reader) error {
+	static := make(map[string]string)
+	if err := json.NewDecoder(in).Decode(&static); err != nil {
+		return err
+	}
+	for name, endpoint := range static {
+		if err := checkRegion(name, endpoint); err != nil {
+			return err
+		}
+	}
+
+	d.rw.Lock()
+	defer d.rw.Unlock()
+	for name, endpoint := range static {
+		d.regions[name] = &RegionEntry{Name: name, Endpoint: endpoint, Static: true}
+	}
+	return nil
+}
+
+// Record (or renew) the registration of a Region service. A statically
+// configured Region cannot be moved to another endpoint.
+func (d *Directory) Register(name, endpoint string, now time.Time) error {
+	if err := checkRegion(name, endpoint); err != nil {
+		return err
+	}
+
+	d.rw.Lock()
+	defer d.rw.Unlock()
+	if r, ok := d.regions[name]; ok && r.Static {
+		if r.Endpoint != endpoint {
+			return ErrRegionStatic
+		}
+		return nil
+	}
+	d.regions[name] = &RegionEntry{Name: name, Endpoint: endpoint, Seen: now}
+	return nil
+}
+
+func (d *Directory) alive(r *RegionEntry, now time.Time) bool {
+	return r.Static || now.Before(r.Seen.Add(d.ttl))
+}
+
+// Return the endpoint of the Region, if known and still alive
+func (d *Directory) Lookup(name string, now time.Time) (string, bool) {
+	d.rw.RLock()
+	defer d.rw.RUnlock()
+	r, ok := d.regions[name]
+	if !ok || !d.alive(r, now) {
+		return "", false
+	}
+	return r.Endpoint, true
+}
+
+// Return the live entries, sorted by name
+func (d *Directory) List(now time.Time) []RegionEntry {
+	d.rw.RLock()
+	defer d.rw.RUnlock()
+	out := make([]RegionEntry, 0, len(d.regions))
+	for _, r := range d.regions {
+		if d.alive(r, now) {
+			out = append(out, *r)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out
+}
+
+// Drop the registrations that haven't been renewed, and return how many
+// were dropped
+func (d *Directory) Expire(now time.Time) int {
+	d.rw.Lock()
+	defer d.rw.Unlock()
+	nb := 0
+	for name, r := range d.regions {
+		if !d.alive(r, now) {
+			delete(d.regions, name)
+			nb++
+		}
+	}
+	return nb
+}
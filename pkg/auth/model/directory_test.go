@@ -0,0 +1,78 @@
+// Copyright (C) 2018-2020 Hegemonie's AUTHORS
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package auth
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDirectory(t *testing.T) {
+	d := NewDirectory(time.Minute)
+	now := time.Unix(1000000, 0)
+
+	err := d.LoadStatic(strings.NewReader(`{"calaquyr": "10.0.0.1:8081"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = d.LoadStatic(strings.NewReader(`{"x": ""}`)); err != ErrRegionInvalid {
+		t.Fatal("empty endpoint accepted", err)
+	}
+
+	for _, tc := range []struct {
+		name     string
+		endpoint string
+		err      error
+	}{
+		{"", "10.0.0.2:8081", ErrRegionInvalid},
+		{"other", "", ErrRegionInvalid},
+		{"calaquyr", "10.0.0.2:8081", ErrRegionStatic},
+		{"calaquyr", "10.0.0.1:8081", nil},
+		{"other", "10.0.0.2:8081", nil},
+	} {
+		if err := d.Register(tc.name, tc.endpoint, now); err != tc.err {
+			t.Fatal("register", tc.name, tc.endpoint, "expected", tc.err, "got", err)
+		}
+	}
+
+	for _, tc := range []struct {
+		name     string
+		when     time.Time
+		endpoint string
+		ok       bool
+	}{
+		{"calaquyr", now, "10.0.0.1:8081", true},
+		{"calaquyr", now.Add(time.Hour), "10.0.0.1:8081", true},
+		{"other", now.Add(59 * time.Second), "10.0.0.2:8081", true},
+		{"other", now.Add(time.Minute), "", false},
+		{"unknown", now, "", false},
+	} {
+		endpoint, ok := d.Lookup(tc.name, tc.when)
+		if endpoint != tc.endpoint || ok != tc.ok {
+			t.Fatal("lookup", tc.name, "expected", tc.endpoint, tc.ok, "got", endpoint, ok)
+		}
+	}
+
+	if l := d.List(now); len(l) != 2 || l[0].Name != "calaquyr" || l[1].Name != "other" {
+		t.Fatal("unexpected list", l)
+	}
+
+	// A renewal postpones the expiration
+	if err = d.Register("other", "10.0.0.3:8081", now.Add(30*time.Second)); err != nil {
+		t.Fatal(err)
+	}
+	if endpoint, ok := d.Lookup("other", now.Add(time.Minute)); !ok || endpoint != "10.0.0.3:8081" {
+		t.Fatal("renewal ignored", endpoint, ok)
+	}
+
+	if nb := d.Expire(now.Add(2 * time.Minute)); nb != 1 {
+		t.Fatal("expected 1 expiration, got", nb)
+	}
+	if l := d.List(now); len(l) != 1 || !l[0].Static {
+		t.Fatal("unexpected list", l)
+	}
+}
@@ -0,0 +1,317 @@
+// Copyright (C) 2018-2020 Hegemonie's AUTHORS
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// The scopes an OAuth2 client may be granted
+const (
+	// Look at the Characters, their Cities and Armies
+	ScopeRead = "read"
+	// Manage the Cities: study, build, train
+	ScopeCity = "city"
+	// Command the Armies
+	ScopeArmy = "army"
+)
+
+const (
+	DefaultAccessTTL  = time.Hour
+	DefaultRefreshTTL = 30 * 24 * time.Hour
+
+	// Validity of the authorization codes, that are exchanged right after
+	// the redirection
+	codeTTL = time.Minute
+)
+
+var (
+	ErrClientInvalid = errors.New("Invalid client")
+	ErrGrantInvalid  = errors.New("Invalid grant")
+	ErrScopeInvalid  = errors.New("Invalid scope")
+	ErrRedirect      = errors.New("Invalid redirect URI")
+	ErrPKCE          = errors.New("PKCE required")
+)
+
+func AllScopes() []string {
+	return []string{ScopeRead, ScopeCity, ScopeArmy}
+}
+
+// A third-party application registered by a User. A confidential client also
+// authenticates with a secret, of which only the hash is kept.
+type Client struct {
+	Id        string
+	Name      string
+	Owner     uint64
+	Redirects []string
+	Secret    string `json:",omitempty"`
+	Created   time.Time
+}
+
+// A one-time authorization code, waiting to be exchanged by the client
+type AuthCode struct {
+	Client    string
+	User      uint64
+	Redirect  string
+	Scopes    []string
+	Challenge string
+	Expires   time.Time
+}
+
+// Check the scopes are known, drop the duplicates and default to read-only
+func ParseScopes(scopes []string) ([]string, error) {
+	if len(scopes) == 0 {
+		return []string{ScopeRead}, nil
+	}
+	seen := make(map[string]bool)
+	out := make([]string, 0, len(scopes))
+	for _, s := range scopes {
+		switch s {
+		case ScopeRead, ScopeCity, ScopeArmy:
+		default:
+			return nil, ErrScopeInvalid
+		}
+		if !seen[s] {
+			seen[s] = true
+			out = append(out, s)
+		}
+	}
+	return out, nil
+}
+
+func checkRedirect(u string) error {
+	parsed, err := url.Parse(u)
+	if err != nil || parsed.Scheme == "" || parsed.Fragment != "" {
+		return ErrRedirect
+	}
+	if (parsed.Scheme == "http" || parsed.Scheme == "https") && parsed.Host == "" {
+		return ErrRedirect
+	}
+	return nil
+}
+
+func (c *Client) redirectAllowed(u string) bool {
+	for _, r := range c.Redirects {
+		if r == u {
+			return true
+		}
+	}
+	return false
+}
+
+// Authenticate the client. A public client has no secret.
+func (c *Client) authenticate(secret string) bool {
+	if c.Secret == "" {
+		return secret == ""
+	}
+	return subtle.ConstantTimeCompare([]byte(c.Secret), []byte(tokenKey(secret))) == 1
+}
+
+func (db *Db) ClientGet(id string) *Client {
+	return db.Clients[id]
+}
+
+// Register a client on behalf of the User. Return the client and, for a
+// confidential client, the secret to be handed to it.
+func (db *Db) ClientRegister(u *User, name string, redirects []string, confidential bool, now time.Time) (*Client, string, error) {
+	if !u.Valid() {
+		return nil, "", errors.New("User suspended")
+	}
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, "", errors.New("Missing name")
+	}
+	if len(redirects) == 0 {
+		return nil, "", errors.New("Missing redirect URI")
+	}
+	for _, r := range redirects {
+		if err := checkRedirect(r); err != nil {
+			return nil, "", err
+		}
+	}
+
+	raw := make([]byte, 16)
+	if _, err := rand.Read(raw); err != nil {
+		return nil, "", err
+	}
+	c := &Client{
+		Id:        hex.EncodeToString(raw),
+		Name:      name,
+		Owner:     u.Id,
+		Redirects: append([]string{}, redirects...),
+		Created:   now,
+	}
+	secret := ""
+	if confidential {
+		var err error
+		if secret, err = newSecret(); err != nil {
+			return nil, "", err
+		}
+		c.Secret = tokenKey(secret)
+	}
+	if db.Clients == nil {
+		db.Clients = make(map[string]*Client)
+	}
+	db.Clients[c.Id] = c
+	return c, secret, nil
+}
+
+// Forget the client and revoke all the tokens granted to it
+func (db *Db) ClientDelete(c *Client) {
+	delete(db.Clients, c.Id)
+	for _, tab := range []map[string]*Token{db.Tokens, db.RefreshTokens} {
+		for k, t := range tab {
+			if t.Client == c.Id {
+				delete(tab, k)
+			}
+		}
+	}
+	for k, code := range db.codes {
+		if code.Client == c.Id {
+			delete(db.codes, k)
+		}
+	}
+}
+
+// Record the consent of the User to grant the scopes to the client, and
+// return the code the client will exchange for tokens. PKCE is mandatory,
+// with the S256 method.
+func (db *Db) Authorize(c *Client, u *User, redirect string, scopes []string, challenge, method string, now time.Time) (string, error) {
+	if !u.Valid() {
+		return "", errors.New("User suspended")
+	}
+	if !c.redirectAllowed(redirect) {
+		return "", ErrRedirect
+	}
+	if challenge == "" || method != "S256" {
+		return "", ErrPKCE
+	}
+	scopes, err := ParseScopes(scopes)
+	if err != nil {
+		return "", err
+	}
+	code, err := newSecret()
+	if err != nil {
+		return "", err
+	}
+	if db.codes == nil {
+		db.codes = make(map[string]*AuthCode)
+	}
+	db.codes[tokenKey(code)] = &AuthCode{
+		Client: c.Id, User: u.Id, Redirect: redirect, Scopes: scopes,
+		Challenge: challenge, Expires: now.Add(codeTTL),
+	}
+	return code, nil
+}
+
+func pkceMatch(challenge, verifier string) bool {
+	sum := sha256.Sum256([]byte(verifier))
+	computed := base64.RawURLEncoding.EncodeToString(sum[:])
+	return subtle.ConstantTimeCompare([]byte(computed), []byte(challenge)) == 1
+}
+
+// Grant an access token and a refresh token for the same User and scopes
+func (db *Db) grant(clientId string, user uint64, scopes []string, accessTTL, refreshTTL time.Duration, now time.Time) (string, string, *Token, error) {
+	if accessTTL <= 0 {
+		accessTTL = DefaultAccessTTL
+	}
+	if refreshTTL <= 0 {
+		refreshTTL = DefaultRefreshTTL
+	}
+	access := &Token{User: user, Client: clientId, Scopes: scopes, Created: now, Expires: now.Add(accessTTL)}
+	refresh := &Token{User: user, Client: clientId, Scopes: scopes, Created: now, Expires: now.Add(refreshTTL)}
+	var a, r string
+	var err error
+	if db.Tokens, a, err = storeToken(db.Tokens, access); err != nil {
+		return "", "", nil, err
+	}
+	if db.RefreshTokens, r, err = storeToken(db.RefreshTokens, refresh); err != nil {
+		return "", "", nil, err
+	}
+	return a, r, access, nil
+}
+
+// Exchange the authorization code for an access token and a refresh token.
+// The code is consumed, whatever the outcome.
+func (db *Db) Exchange(clientId, clientSecret, code, redirect, verifier string, accessTTL, refreshTTL time.Duration, now time.Time) (string, string, *Token, error) {
+	c := db.ClientGet(clientId)
+	if c == nil || !c.authenticate(clientSecret) {
+		return "", "", nil, ErrClientInvalid
+	}
+	k := tokenKey(code)
+	ac, ok := db.codes[k]
+	if !ok {
+		return "", "", nil, ErrGrantInvalid
+	}
+	delete(db.codes, k)
+	if ac.Client != c.Id || ac.Redirect != redirect || !now.Before(ac.Expires) {
+		return "", "", nil, ErrGrantInvalid
+	}
+	if !pkceMatch(ac.Challenge, verifier) {
+		return "", "", nil, ErrGrantInvalid
+	}
+	if u := db.UserGet(ac.User); !u.Valid() {
+		return "", "", nil, ErrGrantInvalid
+	}
+	return db.grant(c.Id, ac.User, ac.Scopes, accessTTL, refreshTTL, now)
+}
+
+// Exchange the refresh token for a new pair of tokens. The refresh token is
+// rotated, and the scopes may only be narrowed.
+func (db *Db) Refresh(clientId, clientSecret, refresh string, scopes []string, accessTTL, refreshTTL time.Duration, now time.Time) (string, string, *Token, error) {
+	c := db.ClientGet(clientId)
+	if c == nil || !c.authenticate(clientSecret) {
+		return "", "", nil, ErrClientInvalid
+	}
+	k := tokenKey(refresh)
+	rt, ok := db.RefreshTokens[k]
+	if !ok || rt.Client != c.Id || !now.Before(rt.Expires) {
+		return "", "", nil, ErrGrantInvalid
+	}
+	if u := db.UserGet(rt.User); !u.Valid() {
+		return "", "", nil, ErrGrantInvalid
+	}
+	granted := rt.Scopes
+	if len(scopes) > 0 {
+		for _, s := range scopes {
+			if !rt.Allows(s) {
+				return "", "", nil, ErrScopeInvalid
+			}
+		}
+		granted, _ = ParseScopes(scopes)
+	}
+	delete(db.RefreshTokens, k)
+	return db.grant(c.Id, rt.User, granted, accessTTL, refreshTTL, now)
+}
+
+// Revoke an access token or a refresh token on behalf of the client, that
+// must be the one the token has been granted to. Return false when the token
+// is unknown.
+func (db *Db) ClientTokenRevoke(clientId, clientSecret, secret string) (bool, error) {
+	c := db.ClientGet(clientId)
+	if c == nil || !c.authenticate(clientSecret) {
+		return false, ErrClientInvalid
+	}
+	k := tokenKey(secret)
+	for _, tab := range []map[string]*Token{db.Tokens, db.RefreshTokens} {
+		if t, ok := tab[k]; ok {
+			if t.Client != c.Id {
+				return false, ErrGrantInvalid
+			}
+			delete(tab, k)
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,197 @@
+// Copyright (C) 2018-2020 Hegemonie's AUTHORS
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package auth
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"testing"
+	"time"
+)
+
+func challengeOf(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+func TestClientRegister(t *testing.T) {
+	db := &Db{}
+	db.Init()
+	u := db.Create("a@b.c")
+	now := time.Now()
+
+	for _, tc := range []struct {
+		name      string
+		redirects []string
+		ok        bool
+	}{
+		{"https", []string{"https://bot.example.com/cb"}, true},
+		{"native", []string{"com.example.bot:/cb"}, true},
+		{"none", nil, false},
+		{"relative", []string{"/cb"}, false},
+		{"no-host", []string{"https:/cb"}, false},
+		{"fragment", []string{"https://bot.example.com/cb#x"}, false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, _, err := db.ClientRegister(u, "bot", tc.redirects, false, now)
+			if (err == nil) != tc.ok {
+				t.Fatal("unexpected", err)
+			}
+		})
+	}
+}
+
+func TestAuthorizationCode(t *testing.T) {
+	db := &Db{}
+	db.Init()
+	u := db.Create("a@b.c")
+	now := time.Unix(1000000, 0)
+	redirect := "https://bot.example.com/cb"
+	verifier := "a-verifier-long-enough-to-be-unguessable-0123456789"
+
+	c, secret, err := db.ClientRegister(u, "bot", []string{redirect}, true, now)
+	if err != nil || secret == "" {
+		t.Fatal(err)
+	}
+
+	// PKCE is mandatory and the redirection must be registered
+	if _, err = db.Authorize(c, u, redirect, nil, "", "", now); err != ErrPKCE {
+		t.Fatal("unexpected", err)
+	}
+	if _, err = db.Authorize(c, u, redirect, nil, challengeOf(verifier), "plain", now); err != ErrPKCE {
+		t.Fatal("unexpected", err)
+	}
+	if _, err = db.Authorize(c, u, redirect+"x", nil, challengeOf(verifier), "S256", now); err != ErrRedirect {
+		t.Fatal("unexpected", err)
+	}
+	if _, err = db.Authorize(c, u, redirect, []string{"admin"}, challengeOf(verifier), "S256", now); err != ErrScopeInvalid {
+		t.Fatal("unexpected", err)
+	}
+
+	authorize := func() string {
+		code, err := db.Authorize(c, u, redirect, []string{ScopeRead, ScopeCity}, challengeOf(verifier), "S256", now)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return code
+	}
+
+	for _, tc := range []struct {
+		name     string
+		secret   string
+		redirect string
+		verifier string
+		err      error
+	}{
+		{"bad-secret", "x", redirect, verifier, ErrClientInvalid},
+		{"bad-redirect", secret, redirect + "x", verifier, ErrGrantInvalid},
+		{"bad-verifier", secret, redirect, verifier + "x", ErrGrantInvalid},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			code := authorize()
+			_, _, _, err := db.Exchange(c.Id, tc.secret, code, tc.redirect, tc.verifier, 0, 0, now)
+			if err != tc.err {
+				t.Fatal("unexpected", err)
+			}
+		})
+	}
+
+	code := authorize()
+	access, refresh, tok, err := db.Exchange(c.Id, secret, code, redirect, verifier, 0, 0, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tok.Client != c.Id || !tok.Allows(ScopeCity) || tok.Allows(ScopeArmy) {
+		t.Fatal("unexpected token", tok)
+	}
+	if found, _, err := db.TokenCheck(access, now); err != nil || found != u {
+		t.Fatal("access token refused", err)
+	}
+
+	// The code is single-use
+	if _, _, _, err = db.Exchange(c.Id, secret, code, redirect, verifier, 0, 0, now); err != ErrGrantInvalid {
+		t.Fatal("code reused", err)
+	}
+
+	// The scopes cannot be widened, the refresh token is rotated
+	if _, _, _, err = db.Refresh(c.Id, secret, refresh, []string{ScopeArmy}, 0, 0, now); err != ErrScopeInvalid {
+		t.Fatal("scope widened", err)
+	}
+	_, refresh2, tok, err := db.Refresh(c.Id, secret, refresh, []string{ScopeRead}, 0, 0, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tok.Allows(ScopeCity) {
+		t.Fatal("scope not narrowed")
+	}
+	if _, _, _, err = db.Refresh(c.Id, secret, refresh, nil, 0, 0, now); err != ErrGrantInvalid {
+		t.Fatal("refresh token reused", err)
+	}
+
+	// Deleting the client revokes its tokens
+	db.ClientDelete(c)
+	if _, _, err = db.TokenCheck(access, now); err != ErrTokenInvalid {
+		t.Fatal("token survived its client", err)
+	}
+	if db.TokenRevoke(refresh2) {
+		t.Fatal("refresh token survived its client")
+	}
+}
+
+func TestClientTokenRevoke(t *testing.T) {
+	db := &Db{}
+	db.Init()
+	u := db.Create("a@b.c")
+	now := time.Unix(1000000, 0)
+	redirect := "https://bot.example.com/cb"
+	verifier := "a-verifier-long-enough-to-be-unguessable-0123456789"
+
+	c, secret, err := db.ClientRegister(u, "bot", []string{redirect}, true, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, otherSecret, err := db.ClientRegister(u, "other", []string{redirect}, true, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	code, err := db.Authorize(c, u, redirect, nil, challengeOf(verifier), "S256", now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	access, refresh, _, err := db.Exchange(c.Id, secret, code, redirect, verifier, 0, 0, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range []struct {
+		name    string
+		client  string
+		secret  string
+		token   string
+		revoked bool
+		err     error
+	}{
+		{"bad-secret", c.Id, "x", access, false, ErrClientInvalid},
+		{"no-client", "x", secret, access, false, ErrClientInvalid},
+		{"other-client", other.Id, otherSecret, access, false, ErrGrantInvalid},
+		{"other-client-refresh", other.Id, otherSecret, refresh, false, ErrGrantInvalid},
+		{"unknown", c.Id, secret, "x", false, nil},
+		{"access", c.Id, secret, access, true, nil},
+		{"refresh", c.Id, secret, refresh, true, nil},
+		{"again", c.Id, secret, access, false, nil},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			revoked, err := db.ClientTokenRevoke(tc.client, tc.secret, tc.token)
+			if err != tc.err || revoked != tc.revoked {
+				t.Fatal("unexpected", revoked, err)
+			}
+		})
+	}
+
+	if _, _, err = db.TokenCheck(access, now); err != ErrTokenInvalid {
+		t.Fatal("access token survived", err)
+	}
+}
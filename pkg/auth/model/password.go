@@ -0,0 +1,108 @@
+// Copyright (C) 2018-2020 Hegemonie's AUTHORS
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+)
+
+// Parameters of the argon2id KDF for the new hashes. The parameters are
+// stored with each hash, so that they can change without breaking the
+// existing hashes.
+const (
+	argonTime    = 1
+	argonMemory  = 64 * 1024
+	argonThreads = 2
+	argonKeyLen  = 32
+	argonSaltLen = 16
+)
+
+const argonPrefix = "$argon2id$"
+
+// Hash the password with argon2id and a random salt. The result is
+// self-describing: $argon2id$v=19$m=<memory>,t=<time>,p=<threads>$<salt>$<hash>
+func hashPassword(pass string) (string, error) {
+	salt := make([]byte, argonSaltLen)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+	key := argon2.IDKey([]byte(pass), salt, argonTime, argonMemory, argonThreads, argonKeyLen)
+	return fmt.Sprintf("%sv=%d$m=%d,t=%d,p=%d$%s$%s", argonPrefix,
+		argon2.Version, argonMemory, argonTime, argonThreads,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key)), nil
+}
+
+// Tell if the stored password uses a legacy format: either the plain text
+// prefixed with ':' or the SHA-256 of the global salt and the password.
+func PasswordWeak(encoded string) bool {
+	return !strings.HasPrefix(encoded, argonPrefix)
+}
+
+// Check the password against its stored form, whatever the format.
+func checkPassword(encoded, pass, legacySalt string) (bool, error) {
+	switch {
+	case len(encoded) <= 0:
+		return false, nil
+	case encoded[0] == ':':
+		return equal(encoded[1:], pass), nil
+	case !PasswordWeak(encoded):
+		return checkArgon2(encoded, pass)
+	default:
+		return equal(encoded, legacyHash(pass, legacySalt)), nil
+	}
+}
+
+func checkArgon2(encoded, pass string) (bool, error) {
+	fields := strings.Split(encoded[len(argonPrefix):], "$")
+	if len(fields) != 4 {
+		return false, errors.New("Malformed argon2id hash")
+	}
+
+	var version int
+	var memory, time uint32
+	var threads uint8
+	if _, err := fmt.Sscanf(fields[0], "v=%d", &version); err != nil {
+		return false, err
+	}
+	if version != argon2.Version {
+		return false, errors.New("Unsupported argon2 version")
+	}
+	if _, err := fmt.Sscanf(fields[1], "m=%d,t=%d,p=%d", &memory, &time, &threads); err != nil {
+		return false, err
+	}
+	salt, err := base64.RawStdEncoding.DecodeString(fields[2])
+	if err != nil {
+		return false, err
+	}
+	expected, err := base64.RawStdEncoding.DecodeString(fields[3])
+	if err != nil {
+		return false, err
+	}
+
+	key := argon2.IDKey([]byte(pass), salt, time, memory, threads, uint32(len(expected)))
+	return subtle.ConstantTimeCompare(key, expected) == 1, nil
+}
+
+func legacyHash(pass, salt string) string {
+	checksum := sha256.New()
+	checksum.Write([]byte(salt))
+	checksum.Write([]byte(pass))
+	return hex.EncodeToString(checksum.Sum(nil))
+}
+
+func equal(a, b string) bool {
+	return subtle.ConstantTimeCompare([]byte(a), []byte(b)) == 1
+}
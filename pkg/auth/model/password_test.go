@@ -0,0 +1,101 @@
+// Copyright (C) 2018-2020 Hegemonie's AUTHORS
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package auth
+
+import (
+	"testing"
+)
+
+func TestAuthBasic(t *testing.T) {
+	strong, err := hashPassword("plop")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range []struct {
+		name     string
+		stored   string
+		pass     string
+		ok       bool
+		upgraded bool
+	}{
+		{"argon2id", strong, "plop", true, false},
+		{"argon2id-wrong", strong, "plip", false, false},
+		{"plain", ":plop", "plop", true, true},
+		{"plain-wrong", ":plop", "plip", false, false},
+		{"sha256", legacyHash("plop", "salt"), "plop", true, true},
+		{"sha256-wrong", legacyHash("plop", "salt"), "plip", false, false},
+		{"empty", "", "", false, false},
+		{"malformed", "$argon2id$v=19$junk", "plop", false, false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			db := &Db{Salt: "salt"}
+			db.Init()
+			u := db.Create("a@b.c")
+			u.Password = tc.stored
+
+			upgraded, err := db.AuthBasic(u, tc.pass)
+			if (err == nil) != tc.ok || upgraded != tc.upgraded {
+				t.Fatal("err", err, "upgraded", upgraded)
+			}
+			if PasswordWeak(u.Password) != (PasswordWeak(tc.stored) && !tc.upgraded) {
+				t.Fatal("format", u.Password)
+			}
+			// The rehashed password still matches
+			if tc.upgraded {
+				if _, err = db.AuthBasic(u, tc.pass); err != nil {
+					t.Fatal("rehash", err)
+				}
+			}
+		})
+	}
+}
+
+func TestSetPassword(t *testing.T) {
+	db := &Db{}
+	db.Init()
+	u := db.Create("a@b.c")
+	if err := db.SetPassword(u, "plop"); err != nil {
+		t.Fatal(err)
+	}
+	first := u.Password
+	if err := db.SetPassword(u, "plop"); err != nil {
+		t.Fatal(err)
+	}
+	if first == u.Password {
+		t.Fatal("salt not random")
+	}
+	if PasswordWeak(u.Password) {
+		t.Fatal("weak format")
+	}
+}
+
+func TestPasswordUpgrade(t *testing.T) {
+	db := &Db{}
+	db.Init()
+	u := db.Create("a@b.c")
+	u.Password = ":plop"
+
+	rehash, err := db.PasswordCheck(u.Password, "plop")
+	if err != nil || PasswordWeak(rehash) {
+		t.Fatal("no rehash", err, rehash)
+	}
+	if u.Password != ":plop" {
+		t.Fatal("user modified by the check")
+	}
+
+	// A password changed during the check is not overwritten
+	db.SetPassword(u, "plip")
+	if db.PasswordUpgrade(u, ":plop", rehash) {
+		t.Fatal("stale rehash stored")
+	}
+	if _, err = db.AuthBasic(u, "plip"); err != nil {
+		t.Fatal(err)
+	}
+	if rehash, err = db.PasswordCheck(u.Password, "plip"); err != nil || rehash != "" {
+		t.Fatal("strong password rehashed", err)
+	}
+}
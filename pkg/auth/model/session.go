@@ -0,0 +1,193 @@
+// Copyright (C) 2018-2020 Hegemonie's AUTHORS
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package auth
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+)
+
+const (
+	DefaultSessionTTL    = 15 * time.Minute
+	DefaultSessionMaxAge = 30 * 24 * time.Hour
+)
+
+var (
+	ErrSessionInvalid = errors.New("Invalid session")
+	ErrSessionExpired = errors.New("Session expired")
+	ErrSessionRevoked = errors.New("Session revoked")
+)
+
+// The content of a session token. The token is signed by the auth service
+// and verified by the front services with the public key only, so that they
+// need no state and no call to validate a session. A token is short-lived
+// and then refreshed by the auth service, that checks the session hasn't
+// been revoked meanwhile.
+type SessionClaims struct {
+	Id   string `json:"sid"`
+	User uint64 `json:"uid"`
+	// Start of the session, the refreshes don't go beyond the maximum age
+	Since   int64 `json:"iat"`
+	Expires int64 `json:"exp"`
+}
+
+func (db *Db) sessionKey() (ed25519.PrivateKey, error) {
+	if len(db.SessionKey) != ed25519.SeedSize {
+		seed := make([]byte, ed25519.SeedSize)
+		if _, err := rand.Read(seed); err != nil {
+			return nil, err
+		}
+		db.SessionKey = seed
+	}
+	return ed25519.NewKeyFromSeed(db.SessionKey), nil
+}
+
+// Return the key the front services need to verify the session tokens.
+// The signing key is generated at the first call.
+func (db *Db) SessionPublicKey() (ed25519.PublicKey, error) {
+	key, err := db.sessionKey()
+	if err != nil {
+		return nil, err
+	}
+	return key.Public().(ed25519.PublicKey), nil
+}
+
+func (db *Db) sessionSign(claims *SessionClaims) (string, error) {
+	key, err := db.sessionKey()
+	if err != nil {
+		return "", err
+	}
+	encoded, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+	payload := base64.RawURLEncoding.EncodeToString(encoded)
+	sig := ed25519.Sign(key, []byte(payload))
+	return payload + "." + base64.RawURLEncoding.EncodeToString(sig), nil
+}
+
+// Check the signature and the expiration of the session token. An expired
+// token is returned with ErrSessionExpired, so that it may be refreshed.
+func VerifySession(pub ed25519.PublicKey, token string, now time.Time) (*SessionClaims, error) {
+	dot := strings.IndexByte(token, '.')
+	if dot < 0 || len(pub) != ed25519.PublicKeySize {
+		return nil, ErrSessionInvalid
+	}
+	payload, encodedSig := token[:dot], token[dot+1:]
+	sig, err := base64.RawURLEncoding.DecodeString(encodedSig)
+	if err != nil || !ed25519.Verify(pub, []byte(payload), sig) {
+		return nil, ErrSessionInvalid
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(payload)
+	if err != nil {
+		return nil, ErrSessionInvalid
+	}
+	claims := &SessionClaims{}
+	if err = json.Unmarshal(raw, claims); err != nil || claims.Id == "" {
+		return nil, ErrSessionInvalid
+	}
+	if now.Unix() >= claims.Expires {
+		return claims, ErrSessionExpired
+	}
+	return claims, nil
+}
+
+// Open a session for the User
+func (db *Db) SessionIssue(u *User, ttl time.Duration, now time.Time) (string, *SessionClaims, error) {
+	if !u.Valid() {
+		return "", nil, errors.New("User suspended")
+	}
+	if ttl <= 0 {
+		ttl = DefaultSessionTTL
+	}
+	raw := make([]byte, 16)
+	if _, err := rand.Read(raw); err != nil {
+		return "", nil, err
+	}
+	claims := &SessionClaims{
+		Id:      hex.EncodeToString(raw),
+		User:    u.Id,
+		Since:   now.Unix(),
+		Expires: now.Add(ttl).Unix(),
+	}
+	token, err := db.sessionSign(claims)
+	return token, claims, err
+}
+
+// Extend the session with a new token, even if the given one has expired,
+// unless the session has been revoked, is too old or the User is not allowed
+// anymore.
+func (db *Db) SessionRefresh(token string, ttl, maxAge time.Duration, now time.Time) (string, *SessionClaims, error) {
+	pub, err := db.SessionPublicKey()
+	if err != nil {
+		return "", nil, err
+	}
+	claims, err := VerifySession(pub, token, now)
+	if err != nil && err != ErrSessionExpired {
+		return "", nil, err
+	}
+	if _, ok := db.RevokedSessions[claims.Id]; ok {
+		return "", nil, ErrSessionRevoked
+	}
+	if maxAge <= 0 {
+		maxAge = DefaultSessionMaxAge
+	}
+	if now.Unix() >= claims.Since+int64(maxAge/time.Second) {
+		return "", nil, ErrSessionExpired
+	}
+	if u := db.UserGet(claims.User); !u.Valid() {
+		return "", nil, ErrSessionInvalid
+	}
+	if ttl <= 0 {
+		ttl = DefaultSessionTTL
+	}
+	renewed := *claims
+	renewed.Expires = now.Add(ttl).Unix()
+	token, err = db.sessionSign(&renewed)
+	return token, &renewed, err
+}
+
+// Prevent any further refresh of the session. The revocation is not
+// immediate: the front services verify the tokens without calling the auth
+// service, so a token already issued stays valid until it expires, i.e. at
+// most the session TTL (DefaultSessionTTL unless configured).
+func (db *Db) SessionRevoke(token string, maxAge time.Duration, now time.Time) error {
+	pub, err := db.SessionPublicKey()
+	if err != nil {
+		return err
+	}
+	claims, err := VerifySession(pub, token, now)
+	if err != nil && err != ErrSessionExpired {
+		return err
+	}
+	if maxAge <= 0 {
+		maxAge = DefaultSessionMaxAge
+	}
+	if db.RevokedSessions == nil {
+		db.RevokedSessions = make(map[string]int64)
+	}
+	// The revocation is useless once the session couldn't be refreshed anyway
+	db.RevokedSessions[claims.Id] = claims.Since + int64(maxAge/time.Second)
+	return nil
+}
+
+// Forget the revocations of the sessions that are too old to be refreshed
+func (db *Db) SessionsExpire(now time.Time) int {
+	nb := 0
+	for id, until := range db.RevokedSessions {
+		if now.Unix() >= until {
+			delete(db.RevokedSessions, id)
+			nb++
+		}
+	}
+	return nb
+}
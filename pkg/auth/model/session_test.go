@@ -0,0 +1,93 @@
+// Copyright (C) 2018-2020 Hegemonie's AUTHORS
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package auth
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestSession(t *testing.T) {
+	db := &Db{}
+	db.Init()
+	u := db.Create("a@b.c")
+	now := time.Unix(1000000, 0)
+	ttl, maxAge := time.Minute, time.Hour
+
+	token, claims, err := db.SessionIssue(u, ttl, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pub, _ := db.SessionPublicKey()
+
+	for _, tc := range []struct {
+		name  string
+		token string
+		when  time.Time
+		err   error
+	}{
+		{"valid", token, now, nil},
+		{"expired", token, now.Add(ttl), ErrSessionExpired},
+		{"garbage", "garbage", now, ErrSessionInvalid},
+		{"tampered", "x" + token, now, ErrSessionInvalid},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := VerifySession(pub, tc.token, tc.when); err != tc.err {
+				t.Fatal("unexpected", err)
+			}
+		})
+	}
+
+	// Another key doesn't validate the token, the key survives a reload
+	other := &Db{}
+	other.Init()
+	otherPub, _ := other.SessionPublicKey()
+	if _, err = VerifySession(otherPub, token, now); err != ErrSessionInvalid {
+		t.Fatal("foreign key accepted")
+	}
+	encoded, _ := json.Marshal(db)
+	reloaded := &Db{}
+	json.Unmarshal(encoded, reloaded)
+	reloadedPub, _ := reloaded.SessionPublicKey()
+	if _, err = VerifySession(reloadedPub, token, now); err != nil {
+		t.Fatal("key lost at reload", err)
+	}
+
+	// An expired token is refreshed within the maximum age of the session
+	later := now.Add(2 * ttl)
+	renewed, c, err := db.SessionRefresh(token, ttl, maxAge, later)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Id != claims.Id || c.Since != claims.Since {
+		t.Fatal("not the same session")
+	}
+	if _, err = VerifySession(pub, renewed, later); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err = db.SessionRefresh(token, ttl, maxAge, now.Add(maxAge)); err != ErrSessionExpired {
+		t.Fatal("session refreshed beyond its maximum age", err)
+	}
+
+	// A suspended User cannot refresh its sessions
+	u.Suspended = true
+	if _, _, err = db.SessionRefresh(token, ttl, maxAge, later); err != ErrSessionInvalid {
+		t.Fatal("suspended user refreshed", err)
+	}
+	u.Suspended = false
+
+	// A revoked session cannot be refreshed, with any of its tokens
+	if err = db.SessionRevoke(renewed, maxAge, later); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err = db.SessionRefresh(token, ttl, maxAge, later); err != ErrSessionRevoked {
+		t.Fatal("revoked session refreshed", err)
+	}
+	if db.SessionsExpire(later) != 0 || db.SessionsExpire(now.Add(maxAge)) != 1 {
+		t.Fatal("revocations expiration")
+	}
+}
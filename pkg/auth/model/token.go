@@ -0,0 +1,141 @@
+// Copyright (C) 2018-2020 Hegemonie's AUTHORS
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"time"
+)
+
+const DefaultTokenTTL = 24 * time.Hour
+
+// A bearer token granted to a User. Only the hash of the secret is kept, so
+// that a dump of the Db doesn't leak usable tokens.
+type Token struct {
+	User uint64
+	// The OAuth2 client the token has been granted to, empty for the tokens
+	// issued to the User itself.
+	Client  string   `json:",omitempty"`
+	Scopes  []string `json:",omitempty"`
+	Created time.Time
+	Expires time.Time
+}
+
+var (
+	ErrTokenInvalid = errors.New("Invalid token")
+	ErrTokenExpired = errors.New("Token expired")
+)
+
+func tokenKey(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+func newSecret() (string, error) {
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
+
+// Tell if the token has been granted the scope
+func (t *Token) Allows(scope string) bool {
+	for _, s := range t.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+func storeToken(tab map[string]*Token, t *Token) (map[string]*Token, string, error) {
+	secret, err := newSecret()
+	if err != nil {
+		return tab, "", err
+	}
+	if tab == nil {
+		tab = make(map[string]*Token)
+	}
+	tab[tokenKey(secret)] = t
+	return tab, secret, nil
+}
+
+// Grant a new token to the User, valid for the given duration (the default
+// one if zero) and with all the scopes. Return the secret to be handed to
+// the client.
+func (db *Db) TokenCreate(u *User, ttl time.Duration, now time.Time) (string, *Token, error) {
+	if !u.Valid() {
+		return "", nil, errors.New("User suspended")
+	}
+	if ttl <= 0 {
+		ttl = DefaultTokenTTL
+	}
+	t := &Token{User: u.Id, Scopes: AllScopes(), Created: now, Expires: now.Add(ttl)}
+	var secret string
+	var err error
+	if db.Tokens, secret, err = storeToken(db.Tokens, t); err != nil {
+		return "", nil, err
+	}
+	return secret, t, nil
+}
+
+// Return the User the token has been granted to, if the token is still
+// valid and the User still allowed.
+func (db *Db) TokenCheck(secret string, now time.Time) (*User, *Token, error) {
+	t, ok := db.Tokens[tokenKey(secret)]
+	if !ok {
+		return nil, nil, ErrTokenInvalid
+	}
+	if !now.Before(t.Expires) {
+		return nil, nil, ErrTokenExpired
+	}
+	u := db.UserGet(t.User)
+	if !u.Valid() {
+		return nil, nil, ErrTokenInvalid
+	}
+	return u, t, nil
+}
+
+// Forget the token, either an access token or a refresh token. Return false
+// if it was unknown.
+func (db *Db) TokenRevoke(secret string) bool {
+	k := tokenKey(secret)
+	if _, ok := db.Tokens[k]; ok {
+		delete(db.Tokens, k)
+		return true
+	}
+	if _, ok := db.RefreshTokens[k]; ok {
+		delete(db.RefreshTokens, k)
+		return true
+	}
+	return false
+}
+
+// Drop the expired tokens and authorization codes, and return how many were
+// dropped
+func (db *Db) TokensExpire(now time.Time) int {
+	nb := 0
+	for _, tab := range []map[string]*Token{db.Tokens, db.RefreshTokens} {
+		for k, t := range tab {
+			if !now.Before(t.Expires) {
+				delete(tab, k)
+				nb++
+			}
+		}
+	}
+	for k, c := range db.codes {
+		if !now.Before(c.Expires) {
+			delete(db.codes, k)
+			nb++
+		}
+	}
+	return nb
+}
@@ -0,0 +1,80 @@
+// Copyright (C) 2018-2020 Hegemonie's AUTHORS
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package auth
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestToken(t *testing.T) {
+	db := &Db{}
+	db.Init()
+	u := db.Create("a@b.c")
+	now := time.Unix(1000000, 0)
+
+	secret, tok, err := db.TokenCreate(u, time.Hour, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tok.User != u.Id || !tok.Expires.Equal(now.Add(time.Hour)) {
+		t.Fatal("unexpected token", tok)
+	}
+
+	// Only the hash of the secret is persisted
+	encoded, _ := json.Marshal(db)
+	if strings.Contains(string(encoded), secret) {
+		t.Fatal("secret persisted")
+	}
+
+	for _, tc := range []struct {
+		name   string
+		secret string
+		when   time.Time
+		err    error
+	}{
+		{"valid", secret, now.Add(time.Minute), nil},
+		{"unknown", secret + "x", now, ErrTokenInvalid},
+		{"expired", secret, now.Add(time.Hour), ErrTokenExpired},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			found, _, err := db.TokenCheck(tc.secret, tc.when)
+			if err != tc.err {
+				t.Fatal("unexpected", err)
+			}
+			if err == nil && found != u {
+				t.Fatal("user mismatch")
+			}
+		})
+	}
+
+	// A suspended User cannot use its tokens anymore
+	u.Suspended = true
+	if _, _, err = db.TokenCheck(secret, now); err != ErrTokenInvalid {
+		t.Fatal("suspended user accepted")
+	}
+	if _, _, err = db.TokenCreate(u, 0, now); err == nil {
+		t.Fatal("token granted to a suspended user")
+	}
+	u.Suspended = false
+
+	if db.TokensExpire(now.Add(time.Minute)) != 0 {
+		t.Fatal("live token expired")
+	}
+	if !db.TokenRevoke(secret) || db.TokenRevoke(secret) {
+		t.Fatal("revocation")
+	}
+	if _, _, err = db.TokenCheck(secret, now); err != ErrTokenInvalid {
+		t.Fatal("revoked token accepted")
+	}
+
+	db.TokenCreate(u, time.Second, now)
+	if db.TokensExpire(now.Add(time.Second)) != 1 || len(db.Tokens) != 0 {
+		t.Fatal("expiration")
+	}
+}
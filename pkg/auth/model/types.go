@@ -5,7 +5,10 @@
 
 package auth
 
-import "time"
+import (
+	"sync"
+	"time"
+)
 
 type Character struct {
 	Id      uint64
@@ -33,8 +36,29 @@ type User struct {
 
 type Db struct {
 	UsersById   []*User          `json:"users"`
-	UsersByMail map[string]*User `json:""`
+	UsersByMail map[string]*User `json:"-"`
 
-	NextId uint64
-	Salt   string
+	NextId          uint64
+	NextCharacterId uint64
+	Salt            string
+
+	// Bearer tokens, by hash of their secret
+	Tokens        map[string]*Token `json:"tokens,omitempty"`
+	RefreshTokens map[string]*Token `json:"refresh,omitempty"`
+
+	// OAuth2 clients, by ID
+	Clients map[string]*Client `json:"clients,omitempty"`
+	// Pending authorization codes, by hash. Short-lived, thus not persisted.
+	codes map[string]*AuthCode
+
+	// Seed of the key signing the session tokens
+	SessionKey []byte `json:"sessionKey,omitempty"`
+	// Sessions that cannot be refreshed anymore, with the time (UNIX, in
+	// seconds) after which the revocation can be forgotten
+	RevokedSessions map[string]int64 `json:"revokedSessions,omitempty"`
+
+	// Maximum number of live Characters per User. Zero means the default.
+	MaxCharacters int `json:"-"`
+
+	rw sync.RWMutex
 }
@@ -504,8 +504,10 @@ func (m *UserSuspendReq) GetName() string {
 }
 
 type UserListReq struct {
-	Marker               uint64   `protobuf:"varint,1,opt,name=marker,proto3" json:"marker,omitempty"`
-	Limit                uint64   `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Marker uint64 `protobuf:"varint,1,opt,name=marker,proto3" json:"marker,omitempty"`
+	Limit  uint64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Only list the users whose password is stored in a weak format
+	WeakPassword         bool     `protobuf:"varint,3,opt,name=weakPassword,proto3" json:"weakPassword,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -550,6 +552,13 @@ func (m *UserListReq) GetLimit() uint64 {
 	return 0
 }
 
+func (m *UserListReq) GetWeakPassword() bool {
+	if m != nil {
+		return m.WeakPassword
+	}
+	return false
+}
+
 type UserListRep struct {
 	Items                []*UserView `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
@@ -636,322 +645,2299 @@ func (m *CharacterShowReq) GetCharacter() uint64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*None)(nil), "hegemonie.auth.proto.None")
-	proto.RegisterType((*UserCreateReq)(nil), "hegemonie.auth.proto.UserCreateReq")
-	proto.RegisterType((*UserUpdateReq)(nil), "hegemonie.auth.proto.UserUpdateReq")
-	proto.RegisterType((*UserAuthReq)(nil), "hegemonie.auth.proto.UserAuthReq")
-	proto.RegisterType((*UserShowReq)(nil), "hegemonie.auth.proto.UserShowReq")
-	proto.RegisterType((*NamedItem)(nil), "hegemonie.auth.proto.NamedItem")
-	proto.RegisterType((*CharacterView)(nil), "hegemonie.auth.proto.CharacterView")
-	proto.RegisterType((*UserView)(nil), "hegemonie.auth.proto.UserView")
-	proto.RegisterType((*UserSuspendReq)(nil), "hegemonie.auth.proto.UserSuspendReq")
-	proto.RegisterType((*UserListReq)(nil), "hegemonie.auth.proto.UserListReq")
-	proto.RegisterType((*UserListRep)(nil), "hegemonie.auth.proto.UserListRep")
-	proto.RegisterType((*CharacterShowReq)(nil), "hegemonie.auth.proto.CharacterShowReq")
+type CharacterCreateReq struct {
+	User                 uint64   `protobuf:"varint,1,opt,name=user,proto3" json:"user,omitempty"`
+	Region               string   `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	Name                 string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func init() { proto.RegisterFile("auth.proto", fileDescriptor_8bbd6f3875b0e874) }
+func (m *CharacterCreateReq) Reset()         { *m = CharacterCreateReq{} }
+func (m *CharacterCreateReq) String() string { return proto.CompactTextString(m) }
+func (*CharacterCreateReq) ProtoMessage()    {}
+func (*CharacterCreateReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8bbd6f3875b0e874, []int{12}
+}
 
-var fileDescriptor_8bbd6f3875b0e874 = []byte{
-	// 541 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x4d, 0x6f, 0xd3, 0x40,
-	0x10, 0xad, 0x1d, 0xc7, 0x24, 0x53, 0xb5, 0xaa, 0x56, 0x55, 0x64, 0x59, 0x08, 0xca, 0x06, 0xa1,
-	0x9e, 0x82, 0x54, 0x8a, 0x38, 0x70, 0x42, 0x41, 0x42, 0x95, 0x50, 0x2b, 0x5c, 0x15, 0x89, 0xe3,
-	0x12, 0x4f, 0xeb, 0x15, 0x5d, 0xdb, 0x78, 0x37, 0xf4, 0x9f, 0xf0, 0xbb, 0xb8, 0xf1, 0x77, 0xd0,
-	0x7e, 0xc4, 0x31, 0xea, 0x3a, 0xc9, 0x6d, 0x67, 0x3c, 0xf3, 0xf6, 0xbd, 0x37, 0xeb, 0x01, 0x60,
-	0x4b, 0x55, 0xcc, 0xea, 0xa6, 0x52, 0x15, 0x39, 0x2e, 0xf0, 0x0e, 0x45, 0x55, 0x72, 0x9c, 0xad,
-	0xb3, 0x34, 0x86, 0xe8, 0xb2, 0x2a, 0x91, 0x4e, 0xe1, 0xe0, 0x46, 0x62, 0x33, 0x6f, 0x90, 0x29,
-	0xcc, 0xf0, 0x27, 0x21, 0x10, 0x09, 0xc6, 0xef, 0x93, 0xe0, 0x24, 0x38, 0x1d, 0x67, 0xe6, 0x4c,
-	0x3f, 0xd9, 0xa2, 0x9b, 0x3a, 0x77, 0x45, 0x87, 0x10, 0xf2, 0xdc, 0x94, 0x44, 0x59, 0xc8, 0x73,
-	0xdd, 0x54, 0x33, 0x29, 0x93, 0xd0, 0x36, 0xe9, 0xb3, 0xce, 0x95, 0x4c, 0x60, 0x32, 0xb0, 0x39,
-	0x7d, 0xa6, 0x6f, 0x61, 0x5f, 0x03, 0x7d, 0x58, 0xaa, 0xa2, 0xe7, 0x2e, 0x1f, 0x14, 0xbd, 0xb0,
-	0x6d, 0xd7, 0x45, 0xf5, 0xd0, 0x73, 0xbb, 0x81, 0x09, 0x3b, 0x30, 0x13, 0x88, 0xd9, 0x42, 0xf1,
-	0x5f, 0xf6, 0xfe, 0x51, 0xe6, 0x22, 0xfa, 0x1a, 0xc6, 0x97, 0x4c, 0x60, 0x7e, 0xa1, 0x50, 0xf8,
-	0x80, 0x0c, 0xe5, 0xb0, 0x43, 0xf9, 0x77, 0x00, 0x07, 0xf3, 0x82, 0x35, 0x6c, 0xa1, 0xb0, 0xf9,
-	0xca, 0xf1, 0xe1, 0x51, 0xd7, 0x04, 0xe2, 0x06, 0xef, 0x78, 0x55, 0xba, 0x3e, 0x17, 0xf9, 0x0c,
-	0x20, 0x47, 0x30, 0xa8, 0x6e, 0x6f, 0x93, 0xc8, 0x70, 0xd2, 0x47, 0xf2, 0x0e, 0xe2, 0x05, 0x57,
-	0x1c, 0x65, 0x32, 0x3c, 0x19, 0x9c, 0xee, 0x9f, 0x3d, 0x9f, 0xf9, 0xe6, 0x35, 0x6b, 0x49, 0x67,
-	0xae, 0x9c, 0xfe, 0x0d, 0x60, 0xa4, 0x5d, 0xf1, 0x72, 0xf2, 0x59, 0xe2, 0xe3, 0x93, 0xc2, 0x88,
-	0x97, 0xce, 0x28, 0x4b, 0xaa, 0x8d, 0xc9, 0x53, 0x18, 0xcb, 0xa5, 0xac, 0xb1, 0xcc, 0x31, 0x4f,
-	0x86, 0xe6, 0xe3, 0x3a, 0x41, 0x8e, 0x61, 0xc8, 0x72, 0xc1, 0xcb, 0x24, 0x36, 0x5f, 0x6c, 0x40,
-	0xe6, 0x00, 0x8b, 0x95, 0x59, 0x32, 0x79, 0x62, 0x14, 0x4d, 0xfd, 0x8a, 0xfe, 0x33, 0x35, 0xeb,
-	0xb4, 0xd1, 0x73, 0x38, 0x34, 0xe3, 0xb6, 0x77, 0xf5, 0x4c, 0xfc, 0xd1, 0xa0, 0xde, 0xdb, 0x47,
-	0xf2, 0x99, 0x4b, 0xa5, 0x5b, 0x26, 0x10, 0x0b, 0xd6, 0xfc, 0xc0, 0xc6, 0xb5, 0xb9, 0x48, 0xf3,
-	0xbe, 0xe7, 0x82, 0x2b, 0xd3, 0x1b, 0x65, 0x36, 0xa0, 0xf3, 0x6e, 0x73, 0x4d, 0xce, 0x61, 0xc8,
-	0x15, 0x0a, 0x99, 0x04, 0x46, 0xc1, 0x33, 0xbf, 0x82, 0x95, 0xfb, 0x99, 0x2d, 0xa6, 0x1f, 0xe1,
-	0xa8, 0x15, 0xb5, 0x7a, 0xab, 0x04, 0xa2, 0xa5, 0x6c, 0x49, 0x98, 0xb3, 0x36, 0xb6, 0x55, 0xeb,
-	0x68, 0xac, 0x13, 0x67, 0x7f, 0x22, 0x88, 0xf4, 0x0f, 0x42, 0x32, 0x3b, 0x5f, 0xcd, 0x89, 0xbc,
-	0xe8, 0x67, 0xe0, 0x04, 0xa7, 0x5b, 0x4b, 0x6a, 0xba, 0x47, 0xae, 0x2c, 0xa6, 0x66, 0xb7, 0x09,
-	0xd3, 0xb1, 0x4f, 0xb7, 0x08, 0xa7, 0x7b, 0xe4, 0x1a, 0x60, 0xbd, 0x3f, 0xc8, 0xb4, 0xbf, 0xbe,
-	0xdd, 0x30, 0x3b, 0x80, 0x5e, 0x59, 0x50, 0xbb, 0x6f, 0x36, 0x81, 0xb6, 0x1b, 0x29, 0x4d, 0x7b,
-	0x7e, 0x1b, 0xbd, 0xe3, 0xf6, 0xc8, 0x17, 0xb7, 0x40, 0xec, 0x8b, 0x22, 0x2f, 0x37, 0x28, 0x6f,
-	0x1f, 0xdd, 0x16, 0x48, 0xe7, 0xa4, 0x99, 0xd4, 0x06, 0x27, 0xdd, 0xaa, 0xdb, 0x41, 0xf4, 0xb7,
-	0xce, 0x9e, 0x31, 0xf3, 0x79, 0xb5, 0xe5, 0xbf, 0xd9, 0x79, 0x48, 0xdf, 0x63, 0x93, 0x79, 0xf3,
-	0x2f, 0x00, 0x00, 0xff, 0xff, 0x9a, 0x6d, 0x06, 0x00, 0x17, 0x06, 0x00, 0x00,
+func (m *CharacterCreateReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CharacterCreateReq.Unmarshal(m, b)
+}
+func (m *CharacterCreateReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CharacterCreateReq.Marshal(b, m, deterministic)
+}
+func (m *CharacterCreateReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CharacterCreateReq.Merge(m, src)
+}
+func (m *CharacterCreateReq) XXX_Size() int {
+	return xxx_messageInfo_CharacterCreateReq.Size(m)
+}
+func (m *CharacterCreateReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_CharacterCreateReq.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_CharacterCreateReq proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *CharacterCreateReq) GetUser() uint64 {
+	if m != nil {
+		return m.User
+	}
+	return 0
+}
 
-// AuthClient is the client API for Auth service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type AuthClient interface {
-	UserList(ctx context.Context, in *UserListReq, opts ...grpc.CallOption) (*UserListRep, error)
-	UserShow(ctx context.Context, in *UserShowReq, opts ...grpc.CallOption) (*UserView, error)
-	UserCreate(ctx context.Context, in *UserCreateReq, opts ...grpc.CallOption) (*UserView, error)
-	UserUpdate(ctx context.Context, in *UserUpdateReq, opts ...grpc.CallOption) (*None, error)
-	UserSuspend(ctx context.Context, in *UserSuspendReq, opts ...grpc.CallOption) (*None, error)
-	UserAuth(ctx context.Context, in *UserAuthReq, opts ...grpc.CallOption) (*UserView, error)
-	// Check the given Character can be managed by the given User and return
-	// an abstract of the Character information. The user information is also
-	// returned to save calls from the main service.
-	CharacterShow(ctx context.Context, in *CharacterShowReq, opts ...grpc.CallOption) (*UserView, error)
+func (m *CharacterCreateReq) GetRegion() string {
+	if m != nil {
+		return m.Region
+	}
+	return ""
 }
 
-type authClient struct {
-	cc *grpc.ClientConn
+func (m *CharacterCreateReq) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
 }
 
-func NewAuthClient(cc *grpc.ClientConn) AuthClient {
-	return &authClient{cc}
+type CharacterUpdateReq struct {
+	User      uint64 `protobuf:"varint,1,opt,name=user,proto3" json:"user,omitempty"`
+	Character uint64 `protobuf:"varint,2,opt,name=character,proto3" json:"character,omitempty"`
+	// Empty to keep the current name
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// Empty to keep the current region
+	Region string `protobuf:"bytes,4,opt,name=region,proto3" json:"region,omitempty"`
+	// Set the character off
+	Retire bool `protobuf:"varint,5,opt,name=retire,proto3" json:"retire,omitempty"`
+	// Set the character back on
+	Restore              bool     `protobuf:"varint,6,opt,name=restore,proto3" json:"restore,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CharacterUpdateReq) Reset()         { *m = CharacterUpdateReq{} }
+func (m *CharacterUpdateReq) String() string { return proto.CompactTextString(m) }
+func (*CharacterUpdateReq) ProtoMessage()    {}
+func (*CharacterUpdateReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8bbd6f3875b0e874, []int{13}
+}
+
+func (m *CharacterUpdateReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CharacterUpdateReq.Unmarshal(m, b)
+}
+func (m *CharacterUpdateReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CharacterUpdateReq.Marshal(b, m, deterministic)
+}
+func (m *CharacterUpdateReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CharacterUpdateReq.Merge(m, src)
+}
+func (m *CharacterUpdateReq) XXX_Size() int {
+	return xxx_messageInfo_CharacterUpdateReq.Size(m)
+}
+func (m *CharacterUpdateReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_CharacterUpdateReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CharacterUpdateReq proto.InternalMessageInfo
+
+func (m *CharacterUpdateReq) GetUser() uint64 {
+	if m != nil {
+		return m.User
+	}
+	return 0
+}
+
+func (m *CharacterUpdateReq) GetCharacter() uint64 {
+	if m != nil {
+		return m.Character
+	}
+	return 0
+}
+
+func (m *CharacterUpdateReq) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CharacterUpdateReq) GetRegion() string {
+	if m != nil {
+		return m.Region
+	}
+	return ""
+}
+
+func (m *CharacterUpdateReq) GetRetire() bool {
+	if m != nil {
+		return m.Retire
+	}
+	return false
+}
+
+func (m *CharacterUpdateReq) GetRestore() bool {
+	if m != nil {
+		return m.Restore
+	}
+	return false
+}
+
+type CharacterDeleteReq struct {
+	User                 uint64   `protobuf:"varint,1,opt,name=user,proto3" json:"user,omitempty"`
+	Character            uint64   `protobuf:"varint,2,opt,name=character,proto3" json:"character,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CharacterDeleteReq) Reset()         { *m = CharacterDeleteReq{} }
+func (m *CharacterDeleteReq) String() string { return proto.CompactTextString(m) }
+func (*CharacterDeleteReq) ProtoMessage()    {}
+func (*CharacterDeleteReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8bbd6f3875b0e874, []int{14}
+}
+
+func (m *CharacterDeleteReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CharacterDeleteReq.Unmarshal(m, b)
+}
+func (m *CharacterDeleteReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CharacterDeleteReq.Marshal(b, m, deterministic)
+}
+func (m *CharacterDeleteReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CharacterDeleteReq.Merge(m, src)
+}
+func (m *CharacterDeleteReq) XXX_Size() int {
+	return xxx_messageInfo_CharacterDeleteReq.Size(m)
+}
+func (m *CharacterDeleteReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_CharacterDeleteReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CharacterDeleteReq proto.InternalMessageInfo
+
+func (m *CharacterDeleteReq) GetUser() uint64 {
+	if m != nil {
+		return m.User
+	}
+	return 0
+}
+
+func (m *CharacterDeleteReq) GetCharacter() uint64 {
+	if m != nil {
+		return m.Character
+	}
+	return 0
+}
+
+type CharacterListReq struct {
+	User                 uint64   `protobuf:"varint,1,opt,name=user,proto3" json:"user,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CharacterListReq) Reset()         { *m = CharacterListReq{} }
+func (m *CharacterListReq) String() string { return proto.CompactTextString(m) }
+func (*CharacterListReq) ProtoMessage()    {}
+func (*CharacterListReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8bbd6f3875b0e874, []int{15}
+}
+
+func (m *CharacterListReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CharacterListReq.Unmarshal(m, b)
+}
+func (m *CharacterListReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CharacterListReq.Marshal(b, m, deterministic)
+}
+func (m *CharacterListReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CharacterListReq.Merge(m, src)
+}
+func (m *CharacterListReq) XXX_Size() int {
+	return xxx_messageInfo_CharacterListReq.Size(m)
+}
+func (m *CharacterListReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_CharacterListReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CharacterListReq proto.InternalMessageInfo
+
+func (m *CharacterListReq) GetUser() uint64 {
+	if m != nil {
+		return m.User
+	}
+	return 0
+}
+
+type CharacterListRep struct {
+	Items                []*CharacterView `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *CharacterListRep) Reset()         { *m = CharacterListRep{} }
+func (m *CharacterListRep) String() string { return proto.CompactTextString(m) }
+func (*CharacterListRep) ProtoMessage()    {}
+func (*CharacterListRep) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8bbd6f3875b0e874, []int{16}
+}
+
+func (m *CharacterListRep) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CharacterListRep.Unmarshal(m, b)
+}
+func (m *CharacterListRep) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CharacterListRep.Marshal(b, m, deterministic)
+}
+func (m *CharacterListRep) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CharacterListRep.Merge(m, src)
+}
+func (m *CharacterListRep) XXX_Size() int {
+	return xxx_messageInfo_CharacterListRep.Size(m)
+}
+func (m *CharacterListRep) XXX_DiscardUnknown() {
+	xxx_messageInfo_CharacterListRep.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CharacterListRep proto.InternalMessageInfo
+
+func (m *CharacterListRep) GetItems() []*CharacterView {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type TokenReq struct {
+	Token                string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TokenReq) Reset()         { *m = TokenReq{} }
+func (m *TokenReq) String() string { return proto.CompactTextString(m) }
+func (*TokenReq) ProtoMessage()    {}
+func (*TokenReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8bbd6f3875b0e874, []int{17}
+}
+
+func (m *TokenReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TokenReq.Unmarshal(m, b)
+}
+func (m *TokenReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TokenReq.Marshal(b, m, deterministic)
+}
+func (m *TokenReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenReq.Merge(m, src)
+}
+func (m *TokenReq) XXX_Size() int {
+	return xxx_messageInfo_TokenReq.Size(m)
+}
+func (m *TokenReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenReq proto.InternalMessageInfo
+
+func (m *TokenReq) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+type TokenView struct {
+	// The secret to present as a bearer token, only known by the client
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	User  uint64 `protobuf:"varint,2,opt,name=user,proto3" json:"user,omitempty"`
+	// Expiration of the token, as a UNIX timestamp in seconds
+	Expires int64 `protobuf:"varint,3,opt,name=expires,proto3" json:"expires,omitempty"`
+	// The refresh token, only for the tokens granted to OAuth2 clients
+	Refresh              string   `protobuf:"bytes,4,opt,name=refresh,proto3" json:"refresh,omitempty"`
+	Scopes               []string `protobuf:"bytes,5,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	Client               string   `protobuf:"bytes,6,opt,name=client,proto3" json:"client,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TokenView) Reset()         { *m = TokenView{} }
+func (m *TokenView) String() string { return proto.CompactTextString(m) }
+func (*TokenView) ProtoMessage()    {}
+func (*TokenView) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8bbd6f3875b0e874, []int{18}
+}
+
+func (m *TokenView) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TokenView.Unmarshal(m, b)
+}
+func (m *TokenView) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TokenView.Marshal(b, m, deterministic)
+}
+func (m *TokenView) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenView.Merge(m, src)
+}
+func (m *TokenView) XXX_Size() int {
+	return xxx_messageInfo_TokenView.Size(m)
+}
+func (m *TokenView) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenView.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenView proto.InternalMessageInfo
+
+func (m *TokenView) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *TokenView) GetUser() uint64 {
+	if m != nil {
+		return m.User
+	}
+	return 0
+}
+
+func (m *TokenView) GetExpires() int64 {
+	if m != nil {
+		return m.Expires
+	}
+	return 0
+}
+
+func (m *TokenView) GetRefresh() string {
+	if m != nil {
+		return m.Refresh
+	}
+	return ""
+}
+
+func (m *TokenView) GetScopes() []string {
+	if m != nil {
+		return m.Scopes
+	}
+	return nil
+}
+
+func (m *TokenView) GetClient() string {
+	if m != nil {
+		return m.Client
+	}
+	return ""
+}
+
+type SessionReq struct {
+	Token                string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SessionReq) Reset()         { *m = SessionReq{} }
+func (m *SessionReq) String() string { return proto.CompactTextString(m) }
+func (*SessionReq) ProtoMessage()    {}
+func (*SessionReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8bbd6f3875b0e874, []int{19}
+}
+
+func (m *SessionReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SessionReq.Unmarshal(m, b)
+}
+func (m *SessionReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SessionReq.Marshal(b, m, deterministic)
+}
+func (m *SessionReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SessionReq.Merge(m, src)
+}
+func (m *SessionReq) XXX_Size() int {
+	return xxx_messageInfo_SessionReq.Size(m)
+}
+func (m *SessionReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_SessionReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SessionReq proto.InternalMessageInfo
+
+func (m *SessionReq) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+type SessionView struct {
+	// Signed token, verifiable with the key returned by SessionKey
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	User  uint64 `protobuf:"varint,2,opt,name=user,proto3" json:"user,omitempty"`
+	// Expiration of the token, as a UNIX timestamp in seconds
+	Expires int64 `protobuf:"varint,3,opt,name=expires,proto3" json:"expires,omitempty"`
+	// End of the session, beyond which the token cannot be refreshed
+	Until                int64    `protobuf:"varint,4,opt,name=until,proto3" json:"until,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SessionView) Reset()         { *m = SessionView{} }
+func (m *SessionView) String() string { return proto.CompactTextString(m) }
+func (*SessionView) ProtoMessage()    {}
+func (*SessionView) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8bbd6f3875b0e874, []int{20}
+}
+
+func (m *SessionView) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SessionView.Unmarshal(m, b)
+}
+func (m *SessionView) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SessionView.Marshal(b, m, deterministic)
+}
+func (m *SessionView) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SessionView.Merge(m, src)
+}
+func (m *SessionView) XXX_Size() int {
+	return xxx_messageInfo_SessionView.Size(m)
+}
+func (m *SessionView) XXX_DiscardUnknown() {
+	xxx_messageInfo_SessionView.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SessionView proto.InternalMessageInfo
+
+func (m *SessionView) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *SessionView) GetUser() uint64 {
+	if m != nil {
+		return m.User
+	}
+	return 0
+}
+
+func (m *SessionView) GetExpires() int64 {
+	if m != nil {
+		return m.Expires
+	}
+	return 0
+}
+
+func (m *SessionView) GetUntil() int64 {
+	if m != nil {
+		return m.Until
+	}
+	return 0
+}
+
+type SessionKeyRep struct {
+	// Ed25519 public key
+	Key                  []byte   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SessionKeyRep) Reset()         { *m = SessionKeyRep{} }
+func (m *SessionKeyRep) String() string { return proto.CompactTextString(m) }
+func (*SessionKeyRep) ProtoMessage()    {}
+func (*SessionKeyRep) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8bbd6f3875b0e874, []int{21}
+}
+
+func (m *SessionKeyRep) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SessionKeyRep.Unmarshal(m, b)
+}
+func (m *SessionKeyRep) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SessionKeyRep.Marshal(b, m, deterministic)
+}
+func (m *SessionKeyRep) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SessionKeyRep.Merge(m, src)
+}
+func (m *SessionKeyRep) XXX_Size() int {
+	return xxx_messageInfo_SessionKeyRep.Size(m)
+}
+func (m *SessionKeyRep) XXX_DiscardUnknown() {
+	xxx_messageInfo_SessionKeyRep.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SessionKeyRep proto.InternalMessageInfo
+
+func (m *SessionKeyRep) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+type TokenInfo struct {
+	User   *UserView `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Scopes []string  `protobuf:"bytes,2,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	// Empty for the tokens issued to the User itself
+	Client               string   `protobuf:"bytes,3,opt,name=client,proto3" json:"client,omitempty"`
+	Expires              int64    `protobuf:"varint,4,opt,name=expires,proto3" json:"expires,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TokenInfo) Reset()         { *m = TokenInfo{} }
+func (m *TokenInfo) String() string { return proto.CompactTextString(m) }
+func (*TokenInfo) ProtoMessage()    {}
+func (*TokenInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8bbd6f3875b0e874, []int{22}
+}
+
+func (m *TokenInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TokenInfo.Unmarshal(m, b)
+}
+func (m *TokenInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TokenInfo.Marshal(b, m, deterministic)
+}
+func (m *TokenInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenInfo.Merge(m, src)
+}
+func (m *TokenInfo) XXX_Size() int {
+	return xxx_messageInfo_TokenInfo.Size(m)
+}
+func (m *TokenInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenInfo proto.InternalMessageInfo
+
+func (m *TokenInfo) GetUser() *UserView {
+	if m != nil {
+		return m.User
+	}
+	return nil
+}
+
+func (m *TokenInfo) GetScopes() []string {
+	if m != nil {
+		return m.Scopes
+	}
+	return nil
+}
+
+func (m *TokenInfo) GetClient() string {
+	if m != nil {
+		return m.Client
+	}
+	return ""
+}
+
+func (m *TokenInfo) GetExpires() int64 {
+	if m != nil {
+		return m.Expires
+	}
+	return 0
+}
+
+type ClientRegisterReq struct {
+	User      uint64   `protobuf:"varint,1,opt,name=user,proto3" json:"user,omitempty"`
+	Name      string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Redirects []string `protobuf:"bytes,3,rep,name=redirects,proto3" json:"redirects,omitempty"`
+	// Confidential clients authenticate with a secret
+	Confidential         bool     `protobuf:"varint,4,opt,name=confidential,proto3" json:"confidential,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ClientRegisterReq) Reset()         { *m = ClientRegisterReq{} }
+func (m *ClientRegisterReq) String() string { return proto.CompactTextString(m) }
+func (*ClientRegisterReq) ProtoMessage()    {}
+func (*ClientRegisterReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8bbd6f3875b0e874, []int{23}
+}
+
+func (m *ClientRegisterReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ClientRegisterReq.Unmarshal(m, b)
+}
+func (m *ClientRegisterReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ClientRegisterReq.Marshal(b, m, deterministic)
+}
+func (m *ClientRegisterReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClientRegisterReq.Merge(m, src)
+}
+func (m *ClientRegisterReq) XXX_Size() int {
+	return xxx_messageInfo_ClientRegisterReq.Size(m)
+}
+func (m *ClientRegisterReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClientRegisterReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClientRegisterReq proto.InternalMessageInfo
+
+func (m *ClientRegisterReq) GetUser() uint64 {
+	if m != nil {
+		return m.User
+	}
+	return 0
+}
+
+func (m *ClientRegisterReq) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ClientRegisterReq) GetRedirects() []string {
+	if m != nil {
+		return m.Redirects
+	}
+	return nil
+}
+
+func (m *ClientRegisterReq) GetConfidential() bool {
+	if m != nil {
+		return m.Confidential
+	}
+	return false
+}
+
+type ClientShowReq struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ClientShowReq) Reset()         { *m = ClientShowReq{} }
+func (m *ClientShowReq) String() string { return proto.CompactTextString(m) }
+func (*ClientShowReq) ProtoMessage()    {}
+func (*ClientShowReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8bbd6f3875b0e874, []int{24}
+}
+
+func (m *ClientShowReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ClientShowReq.Unmarshal(m, b)
+}
+func (m *ClientShowReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ClientShowReq.Marshal(b, m, deterministic)
+}
+func (m *ClientShowReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClientShowReq.Merge(m, src)
+}
+func (m *ClientShowReq) XXX_Size() int {
+	return xxx_messageInfo_ClientShowReq.Size(m)
+}
+func (m *ClientShowReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClientShowReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClientShowReq proto.InternalMessageInfo
+
+func (m *ClientShowReq) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type ClientDeleteReq struct {
+	User                 uint64   `protobuf:"varint,1,opt,name=user,proto3" json:"user,omitempty"`
+	Id                   string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ClientDeleteReq) Reset()         { *m = ClientDeleteReq{} }
+func (m *ClientDeleteReq) String() string { return proto.CompactTextString(m) }
+func (*ClientDeleteReq) ProtoMessage()    {}
+func (*ClientDeleteReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8bbd6f3875b0e874, []int{25}
+}
+
+func (m *ClientDeleteReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ClientDeleteReq.Unmarshal(m, b)
+}
+func (m *ClientDeleteReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ClientDeleteReq.Marshal(b, m, deterministic)
+}
+func (m *ClientDeleteReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClientDeleteReq.Merge(m, src)
+}
+func (m *ClientDeleteReq) XXX_Size() int {
+	return xxx_messageInfo_ClientDeleteReq.Size(m)
+}
+func (m *ClientDeleteReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClientDeleteReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClientDeleteReq proto.InternalMessageInfo
+
+func (m *ClientDeleteReq) GetUser() uint64 {
+	if m != nil {
+		return m.User
+	}
+	return 0
+}
+
+func (m *ClientDeleteReq) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type ClientView struct {
+	Id        string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name      string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Owner     uint64   `protobuf:"varint,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	Redirects []string `protobuf:"bytes,4,rep,name=redirects,proto3" json:"redirects,omitempty"`
+	// Only returned at the registration
+	Secret               string   `protobuf:"bytes,5,opt,name=secret,proto3" json:"secret,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ClientView) Reset()         { *m = ClientView{} }
+func (m *ClientView) String() string { return proto.CompactTextString(m) }
+func (*ClientView) ProtoMessage()    {}
+func (*ClientView) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8bbd6f3875b0e874, []int{26}
+}
+
+func (m *ClientView) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ClientView.Unmarshal(m, b)
+}
+func (m *ClientView) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ClientView.Marshal(b, m, deterministic)
+}
+func (m *ClientView) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClientView.Merge(m, src)
+}
+func (m *ClientView) XXX_Size() int {
+	return xxx_messageInfo_ClientView.Size(m)
+}
+func (m *ClientView) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClientView.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClientView proto.InternalMessageInfo
+
+func (m *ClientView) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ClientView) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ClientView) GetOwner() uint64 {
+	if m != nil {
+		return m.Owner
+	}
+	return 0
+}
+
+func (m *ClientView) GetRedirects() []string {
+	if m != nil {
+		return m.Redirects
+	}
+	return nil
+}
+
+func (m *ClientView) GetSecret() string {
+	if m != nil {
+		return m.Secret
+	}
+	return ""
+}
+
+type AuthorizeReq struct {
+	User     uint64   `protobuf:"varint,1,opt,name=user,proto3" json:"user,omitempty"`
+	Client   string   `protobuf:"bytes,2,opt,name=client,proto3" json:"client,omitempty"`
+	Redirect string   `protobuf:"bytes,3,opt,name=redirect,proto3" json:"redirect,omitempty"`
+	Scopes   []string `protobuf:"bytes,4,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	// PKCE challenge, only the S256 method is accepted
+	Challenge            string   `protobuf:"bytes,5,opt,name=challenge,proto3" json:"challenge,omitempty"`
+	Method               string   `protobuf:"bytes,6,opt,name=method,proto3" json:"method,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AuthorizeReq) Reset()         { *m = AuthorizeReq{} }
+func (m *AuthorizeReq) String() string { return proto.CompactTextString(m) }
+func (*AuthorizeReq) ProtoMessage()    {}
+func (*AuthorizeReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8bbd6f3875b0e874, []int{27}
+}
+
+func (m *AuthorizeReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuthorizeReq.Unmarshal(m, b)
+}
+func (m *AuthorizeReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuthorizeReq.Marshal(b, m, deterministic)
+}
+func (m *AuthorizeReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthorizeReq.Merge(m, src)
+}
+func (m *AuthorizeReq) XXX_Size() int {
+	return xxx_messageInfo_AuthorizeReq.Size(m)
+}
+func (m *AuthorizeReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthorizeReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuthorizeReq proto.InternalMessageInfo
+
+func (m *AuthorizeReq) GetUser() uint64 {
+	if m != nil {
+		return m.User
+	}
+	return 0
+}
+
+func (m *AuthorizeReq) GetClient() string {
+	if m != nil {
+		return m.Client
+	}
+	return ""
+}
+
+func (m *AuthorizeReq) GetRedirect() string {
+	if m != nil {
+		return m.Redirect
+	}
+	return ""
+}
+
+func (m *AuthorizeReq) GetScopes() []string {
+	if m != nil {
+		return m.Scopes
+	}
+	return nil
+}
+
+func (m *AuthorizeReq) GetChallenge() string {
+	if m != nil {
+		return m.Challenge
+	}
+	return ""
+}
+
+func (m *AuthorizeReq) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+type AuthorizeRep struct {
+	Code                 string   `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AuthorizeRep) Reset()         { *m = AuthorizeRep{} }
+func (m *AuthorizeRep) String() string { return proto.CompactTextString(m) }
+func (*AuthorizeRep) ProtoMessage()    {}
+func (*AuthorizeRep) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8bbd6f3875b0e874, []int{28}
+}
+
+func (m *AuthorizeRep) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuthorizeRep.Unmarshal(m, b)
+}
+func (m *AuthorizeRep) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuthorizeRep.Marshal(b, m, deterministic)
+}
+func (m *AuthorizeRep) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthorizeRep.Merge(m, src)
+}
+func (m *AuthorizeRep) XXX_Size() int {
+	return xxx_messageInfo_AuthorizeRep.Size(m)
+}
+func (m *AuthorizeRep) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthorizeRep.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuthorizeRep proto.InternalMessageInfo
+
+func (m *AuthorizeRep) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+type TokenExchangeReq struct {
+	Client               string   `protobuf:"bytes,1,opt,name=client,proto3" json:"client,omitempty"`
+	Secret               string   `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+	Code                 string   `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	Redirect             string   `protobuf:"bytes,4,opt,name=redirect,proto3" json:"redirect,omitempty"`
+	Verifier             string   `protobuf:"bytes,5,opt,name=verifier,proto3" json:"verifier,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TokenExchangeReq) Reset()         { *m = TokenExchangeReq{} }
+func (m *TokenExchangeReq) String() string { return proto.CompactTextString(m) }
+func (*TokenExchangeReq) ProtoMessage()    {}
+func (*TokenExchangeReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8bbd6f3875b0e874, []int{29}
+}
+
+func (m *TokenExchangeReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TokenExchangeReq.Unmarshal(m, b)
+}
+func (m *TokenExchangeReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TokenExchangeReq.Marshal(b, m, deterministic)
+}
+func (m *TokenExchangeReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenExchangeReq.Merge(m, src)
+}
+func (m *TokenExchangeReq) XXX_Size() int {
+	return xxx_messageInfo_TokenExchangeReq.Size(m)
+}
+func (m *TokenExchangeReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenExchangeReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenExchangeReq proto.InternalMessageInfo
+
+func (m *TokenExchangeReq) GetClient() string {
+	if m != nil {
+		return m.Client
+	}
+	return ""
+}
+
+func (m *TokenExchangeReq) GetSecret() string {
+	if m != nil {
+		return m.Secret
+	}
+	return ""
+}
+
+func (m *TokenExchangeReq) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+func (m *TokenExchangeReq) GetRedirect() string {
+	if m != nil {
+		return m.Redirect
+	}
+	return ""
+}
+
+func (m *TokenExchangeReq) GetVerifier() string {
+	if m != nil {
+		return m.Verifier
+	}
+	return ""
+}
+
+type TokenRefreshReq struct {
+	Client  string `protobuf:"bytes,1,opt,name=client,proto3" json:"client,omitempty"`
+	Secret  string `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+	Refresh string `protobuf:"bytes,3,opt,name=refresh,proto3" json:"refresh,omitempty"`
+	// Empty to keep the scopes of the refresh token
+	Scopes               []string `protobuf:"bytes,4,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TokenRefreshReq) Reset()         { *m = TokenRefreshReq{} }
+func (m *TokenRefreshReq) String() string { return proto.CompactTextString(m) }
+func (*TokenRefreshReq) ProtoMessage()    {}
+func (*TokenRefreshReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8bbd6f3875b0e874, []int{30}
+}
+
+func (m *TokenRefreshReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TokenRefreshReq.Unmarshal(m, b)
+}
+func (m *TokenRefreshReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TokenRefreshReq.Marshal(b, m, deterministic)
+}
+func (m *TokenRefreshReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenRefreshReq.Merge(m, src)
+}
+func (m *TokenRefreshReq) XXX_Size() int {
+	return xxx_messageInfo_TokenRefreshReq.Size(m)
+}
+func (m *TokenRefreshReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenRefreshReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenRefreshReq proto.InternalMessageInfo
+
+func (m *TokenRefreshReq) GetClient() string {
+	if m != nil {
+		return m.Client
+	}
+	return ""
+}
+
+func (m *TokenRefreshReq) GetSecret() string {
+	if m != nil {
+		return m.Secret
+	}
+	return ""
+}
+
+func (m *TokenRefreshReq) GetRefresh() string {
+	if m != nil {
+		return m.Refresh
+	}
+	return ""
+}
+
+func (m *TokenRefreshReq) GetScopes() []string {
+	if m != nil {
+		return m.Scopes
+	}
+	return nil
+}
+
+type ClientTokenRevokeReq struct {
+	Client               string   `protobuf:"bytes,1,opt,name=client,proto3" json:"client,omitempty"`
+	Secret               string   `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+	Token                string   `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ClientTokenRevokeReq) Reset()         { *m = ClientTokenRevokeReq{} }
+func (m *ClientTokenRevokeReq) String() string { return proto.CompactTextString(m) }
+func (*ClientTokenRevokeReq) ProtoMessage()    {}
+func (*ClientTokenRevokeReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8bbd6f3875b0e874, []int{31}
+}
+
+func (m *ClientTokenRevokeReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ClientTokenRevokeReq.Unmarshal(m, b)
+}
+func (m *ClientTokenRevokeReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ClientTokenRevokeReq.Marshal(b, m, deterministic)
+}
+func (m *ClientTokenRevokeReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClientTokenRevokeReq.Merge(m, src)
+}
+func (m *ClientTokenRevokeReq) XXX_Size() int {
+	return xxx_messageInfo_ClientTokenRevokeReq.Size(m)
+}
+func (m *ClientTokenRevokeReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClientTokenRevokeReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClientTokenRevokeReq proto.InternalMessageInfo
+
+func (m *ClientTokenRevokeReq) GetClient() string {
+	if m != nil {
+		return m.Client
+	}
+	return ""
+}
+
+func (m *ClientTokenRevokeReq) GetSecret() string {
+	if m != nil {
+		return m.Secret
+	}
+	return ""
+}
+
+func (m *ClientTokenRevokeReq) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+type RegionView struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// IP:PORT of the Region service
+	Endpoint             string   `protobuf:"bytes,2,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RegionView) Reset()         { *m = RegionView{} }
+func (m *RegionView) String() string { return proto.CompactTextString(m) }
+func (*RegionView) ProtoMessage()    {}
+func (*RegionView) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8bbd6f3875b0e874, []int{32}
+}
+
+func (m *RegionView) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RegionView.Unmarshal(m, b)
+}
+func (m *RegionView) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RegionView.Marshal(b, m, deterministic)
+}
+func (m *RegionView) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegionView.Merge(m, src)
+}
+func (m *RegionView) XXX_Size() int {
+	return xxx_messageInfo_RegionView.Size(m)
+}
+func (m *RegionView) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegionView.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegionView proto.InternalMessageInfo
+
+func (m *RegionView) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *RegionView) GetEndpoint() string {
+	if m != nil {
+		return m.Endpoint
+	}
+	return ""
+}
+
+type RegionListRep struct {
+	Items                []*RegionView `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *RegionListRep) Reset()         { *m = RegionListRep{} }
+func (m *RegionListRep) String() string { return proto.CompactTextString(m) }
+func (*RegionListRep) ProtoMessage()    {}
+func (*RegionListRep) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8bbd6f3875b0e874, []int{33}
+}
+
+func (m *RegionListRep) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RegionListRep.Unmarshal(m, b)
+}
+func (m *RegionListRep) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RegionListRep.Marshal(b, m, deterministic)
+}
+func (m *RegionListRep) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegionListRep.Merge(m, src)
+}
+func (m *RegionListRep) XXX_Size() int {
+	return xxx_messageInfo_RegionListRep.Size(m)
+}
+func (m *RegionListRep) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegionListRep.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegionListRep proto.InternalMessageInfo
+
+func (m *RegionListRep) GetItems() []*RegionView {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*None)(nil), "hegemonie.auth.proto.None")
+	proto.RegisterType((*UserCreateReq)(nil), "hegemonie.auth.proto.UserCreateReq")
+	proto.RegisterType((*UserUpdateReq)(nil), "hegemonie.auth.proto.UserUpdateReq")
+	proto.RegisterType((*UserAuthReq)(nil), "hegemonie.auth.proto.UserAuthReq")
+	proto.RegisterType((*UserShowReq)(nil), "hegemonie.auth.proto.UserShowReq")
+	proto.RegisterType((*NamedItem)(nil), "hegemonie.auth.proto.NamedItem")
+	proto.RegisterType((*CharacterView)(nil), "hegemonie.auth.proto.CharacterView")
+	proto.RegisterType((*UserView)(nil), "hegemonie.auth.proto.UserView")
+	proto.RegisterType((*UserSuspendReq)(nil), "hegemonie.auth.proto.UserSuspendReq")
+	proto.RegisterType((*UserListReq)(nil), "hegemonie.auth.proto.UserListReq")
+	proto.RegisterType((*UserListRep)(nil), "hegemonie.auth.proto.UserListRep")
+	proto.RegisterType((*CharacterShowReq)(nil), "hegemonie.auth.proto.CharacterShowReq")
+	proto.RegisterType((*CharacterCreateReq)(nil), "hegemonie.auth.proto.CharacterCreateReq")
+	proto.RegisterType((*CharacterUpdateReq)(nil), "hegemonie.auth.proto.CharacterUpdateReq")
+	proto.RegisterType((*CharacterDeleteReq)(nil), "hegemonie.auth.proto.CharacterDeleteReq")
+	proto.RegisterType((*CharacterListReq)(nil), "hegemonie.auth.proto.CharacterListReq")
+	proto.RegisterType((*CharacterListRep)(nil), "hegemonie.auth.proto.CharacterListRep")
+	proto.RegisterType((*TokenReq)(nil), "hegemonie.auth.proto.TokenReq")
+	proto.RegisterType((*TokenView)(nil), "hegemonie.auth.proto.TokenView")
+	proto.RegisterType((*SessionReq)(nil), "hegemonie.auth.proto.SessionReq")
+	proto.RegisterType((*SessionView)(nil), "hegemonie.auth.proto.SessionView")
+	proto.RegisterType((*SessionKeyRep)(nil), "hegemonie.auth.proto.SessionKeyRep")
+	proto.RegisterType((*TokenInfo)(nil), "hegemonie.auth.proto.TokenInfo")
+	proto.RegisterType((*ClientRegisterReq)(nil), "hegemonie.auth.proto.ClientRegisterReq")
+	proto.RegisterType((*ClientShowReq)(nil), "hegemonie.auth.proto.ClientShowReq")
+	proto.RegisterType((*ClientDeleteReq)(nil), "hegemonie.auth.proto.ClientDeleteReq")
+	proto.RegisterType((*ClientView)(nil), "hegemonie.auth.proto.ClientView")
+	proto.RegisterType((*AuthorizeReq)(nil), "hegemonie.auth.proto.AuthorizeReq")
+	proto.RegisterType((*AuthorizeRep)(nil), "hegemonie.auth.proto.AuthorizeRep")
+	proto.RegisterType((*TokenExchangeReq)(nil), "hegemonie.auth.proto.TokenExchangeReq")
+	proto.RegisterType((*TokenRefreshReq)(nil), "hegemonie.auth.proto.TokenRefreshReq")
+	proto.RegisterType((*ClientTokenRevokeReq)(nil), "hegemonie.auth.proto.ClientTokenRevokeReq")
+	proto.RegisterType((*RegionView)(nil), "hegemonie.auth.proto.RegionView")
+	proto.RegisterType((*RegionListRep)(nil), "hegemonie.auth.proto.RegionListRep")
+}
+
+func init() { proto.RegisterFile("auth.proto", fileDescriptor_8bbd6f3875b0e874) }
+
+var fileDescriptor_8bbd6f3875b0e874 = []byte{
+	// 1365 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xdd, 0x6e, 0x1b, 0xc5,
+	0x17, 0xf7, 0xc7, 0xda, 0xb5, 0x4f, 0x9c, 0x34, 0xff, 0x51, 0x54, 0x59, 0xd6, 0x5f, 0xad, 0x3b,
+	0x81, 0x52, 0x71, 0x11, 0xa4, 0xd0, 0x82, 0x90, 0xb8, 0x41, 0x2e, 0xa0, 0xa8, 0xd0, 0x94, 0x4d,
+	0x53, 0x28, 0xa2, 0x42, 0xdb, 0xdd, 0x63, 0x7b, 0x64, 0x7b, 0xd7, 0xec, 0xae, 0x93, 0x16, 0xc1,
+	0x03, 0x70, 0xc3, 0x25, 0x12, 0xf7, 0xbc, 0x02, 0xcf, 0xc2, 0xeb, 0xa0, 0xf9, 0xd8, 0xd9, 0x59,
+	0x67, 0xbf, 0x1a, 0x71, 0xb7, 0x67, 0xf6, 0x7c, 0x9f, 0x33, 0xe7, 0xfc, 0x06, 0xc0, 0xd9, 0xc4,
+	0xf3, 0xa3, 0x75, 0x18, 0xc4, 0x01, 0x39, 0x98, 0xe3, 0x0c, 0x57, 0x81, 0xcf, 0xf0, 0x28, 0x3d,
+	0xa5, 0x5d, 0xb0, 0x9e, 0x04, 0x3e, 0xd2, 0x43, 0xd8, 0x3d, 0x8f, 0x30, 0x9c, 0x84, 0xe8, 0xc4,
+	0x68, 0xe3, 0x4f, 0x84, 0x80, 0xb5, 0x72, 0xd8, 0x72, 0xd8, 0x1c, 0x37, 0xef, 0xf7, 0x6d, 0xf1,
+	0x4d, 0xbf, 0x94, 0x4c, 0xe7, 0x6b, 0x4f, 0x31, 0xed, 0x41, 0x8b, 0x79, 0x82, 0xc5, 0xb2, 0x5b,
+	0xcc, 0xe3, 0x42, 0x6b, 0x27, 0x8a, 0x86, 0x2d, 0x29, 0xc4, 0xbf, 0xf9, 0x99, 0xef, 0xac, 0x70,
+	0xd8, 0x96, 0x67, 0xfc, 0x9b, 0x3e, 0x84, 0x1d, 0xae, 0xe8, 0xb3, 0x4d, 0x3c, 0x2f, 0xb0, 0x95,
+	0xa7, 0x8a, 0x9e, 0x48, 0xb1, 0xb3, 0x79, 0x70, 0x59, 0x60, 0x5d, 0xa8, 0x69, 0x19, 0x6a, 0x6e,
+	0x41, 0xd7, 0x71, 0x63, 0x76, 0x21, 0xed, 0xf7, 0x6c, 0x45, 0xd1, 0x0f, 0xa0, 0xff, 0xc4, 0x59,
+	0xa1, 0x77, 0x12, 0xe3, 0x2a, 0x4f, 0x91, 0x70, 0xb9, 0x65, 0xb8, 0xfc, 0x47, 0x13, 0x76, 0x27,
+	0x73, 0x27, 0x74, 0xdc, 0x18, 0xc3, 0xe7, 0x0c, 0x2f, 0xaf, 0x48, 0xdd, 0x82, 0x6e, 0x88, 0x33,
+	0x16, 0xf8, 0x4a, 0x4e, 0x51, 0x79, 0x09, 0x20, 0xfb, 0xd0, 0x0e, 0xa6, 0xd3, 0xa1, 0x25, 0x7c,
+	0xe2, 0x9f, 0xe4, 0x63, 0xe8, 0xba, 0x2c, 0x66, 0x18, 0x0d, 0x3b, 0xe3, 0xf6, 0xfd, 0x9d, 0xe3,
+	0x3b, 0x47, 0x79, 0xf5, 0x3a, 0xd2, 0x4e, 0xdb, 0x8a, 0x9d, 0xfe, 0xd3, 0x84, 0xde, 0x79, 0x54,
+	0xe0, 0x53, 0x5e, 0x4a, 0xf2, 0xfc, 0x19, 0x41, 0x8f, 0xf9, 0x2a, 0x51, 0xd2, 0x29, 0x4d, 0x93,
+	0xff, 0x43, 0x3f, 0xda, 0x44, 0x6b, 0xf4, 0x3d, 0xf4, 0x86, 0x1d, 0xf1, 0x33, 0x3d, 0x20, 0x07,
+	0xd0, 0x71, 0xbc, 0x15, 0xf3, 0x87, 0x5d, 0xf1, 0x47, 0x12, 0x64, 0x02, 0xe0, 0x26, 0xc9, 0x8a,
+	0x86, 0x37, 0x44, 0x44, 0x87, 0xf9, 0x11, 0x65, 0x92, 0x6a, 0x1b, 0x62, 0xf4, 0x01, 0xec, 0x89,
+	0x72, 0x4b, 0x5b, 0x05, 0x15, 0xbf, 0x52, 0xa8, 0x1f, 0x65, 0x93, 0x7c, 0xc5, 0xa2, 0x98, 0x8b,
+	0xdc, 0x82, 0xee, 0xca, 0x09, 0x17, 0x18, 0x2a, 0x31, 0x45, 0x71, 0xbf, 0x97, 0x6c, 0xc5, 0x62,
+	0x21, 0x6b, 0xd9, 0x92, 0x20, 0x14, 0x06, 0x97, 0xe8, 0x2c, 0x9e, 0x3a, 0x51, 0x74, 0x19, 0x84,
+	0x9e, 0x6a, 0x9a, 0xcc, 0x19, 0x9d, 0x98, 0x06, 0xd6, 0xe4, 0x01, 0x74, 0x58, 0x8c, 0xab, 0x68,
+	0xd8, 0x14, 0x51, 0xde, 0xce, 0x8f, 0x32, 0xa9, 0x90, 0x2d, 0x99, 0xe9, 0x23, 0xd8, 0xd7, 0x81,
+	0x27, 0xfd, 0x4c, 0xc0, 0xda, 0x44, 0xda, 0x51, 0xf1, 0xcd, 0x93, 0xaf, 0x33, 0xa2, 0x5c, 0x4d,
+	0x0f, 0xe8, 0x33, 0x20, 0x5a, 0x4b, 0xe6, 0xea, 0x5e, 0xd1, 0xf3, 0x16, 0xcd, 0x49, 0xff, 0x6a,
+	0x1a, 0x6a, 0xd3, 0xcb, 0xfe, 0xd6, 0xee, 0xe5, 0x76, 0x5a, 0xea, 0x88, 0x95, 0x71, 0x44, 0x9c,
+	0xc7, 0x2c, 0x44, 0xd5, 0x62, 0x8a, 0x22, 0x43, 0xb8, 0x11, 0x62, 0x14, 0x07, 0x21, 0xaa, 0x0e,
+	0x4b, 0x48, 0xfa, 0x85, 0xe1, 0xe5, 0x23, 0x5c, 0xe2, 0x35, 0xbd, 0xa4, 0xf7, 0x8c, 0x52, 0x24,
+	0x5d, 0x93, 0xa3, 0x85, 0x7e, 0x7d, 0x85, 0x6f, 0x4d, 0x3e, 0xc9, 0x16, 0xbf, 0x56, 0x8b, 0xab,
+	0x0e, 0x18, 0x43, 0xef, 0x59, 0xb0, 0x40, 0x9f, 0x9b, 0x3b, 0x80, 0x4e, 0xcc, 0xbf, 0xd5, 0x04,
+	0x94, 0x04, 0xfd, 0xb3, 0x09, 0x7d, 0xc1, 0x22, 0xae, 0x76, 0x2e, 0x8f, 0x76, 0xb4, 0x65, 0x84,
+	0x3b, 0x84, 0x1b, 0xf8, 0x7a, 0xcd, 0x42, 0x8c, 0x44, 0xe6, 0xdb, 0x76, 0x42, 0xca, 0x64, 0x4e,
+	0x43, 0x8c, 0xe6, 0x2a, 0xfb, 0x09, 0xc9, 0xd3, 0x1f, 0xb9, 0xc1, 0x5a, 0x8d, 0x9f, 0xbe, 0xad,
+	0x28, 0x7e, 0xee, 0x2e, 0x19, 0xfa, 0xb1, 0xc8, 0x7e, 0xdf, 0x56, 0x14, 0xa5, 0x00, 0x67, 0x18,
+	0x45, 0x2c, 0x28, 0xf1, 0x7f, 0x06, 0x3b, 0x8a, 0xe7, 0x3f, 0x0b, 0xe0, 0x00, 0x3a, 0x1b, 0x3f,
+	0x66, 0x4b, 0xe1, 0x7e, 0xdb, 0x96, 0x04, 0xbd, 0x0b, 0xbb, 0xca, 0xd0, 0x63, 0x7c, 0xc3, 0xcb,
+	0xb2, 0x0f, 0xed, 0x05, 0xbe, 0x11, 0x86, 0x06, 0x36, 0xff, 0xa4, 0xbf, 0x25, 0xb9, 0x3c, 0xf1,
+	0xa7, 0x01, 0x39, 0x36, 0xca, 0x5b, 0x7d, 0x65, 0xf5, 0x0d, 0x52, 0x19, 0x6a, 0x15, 0x64, 0xa8,
+	0x6d, 0x66, 0xc8, 0x0c, 0xc2, 0xca, 0x04, 0x41, 0x7f, 0x85, 0xff, 0x4d, 0x04, 0x8f, 0x8d, 0x33,
+	0x16, 0xc5, 0x18, 0x16, 0xf5, 0x6d, 0xce, 0x78, 0xe3, 0xbd, 0x1c, 0xa2, 0xc7, 0x42, 0x74, 0x63,
+	0x9e, 0x1d, 0xee, 0x49, 0x7a, 0xc0, 0xe7, 0x97, 0x1b, 0xf8, 0x53, 0xe6, 0xa1, 0x1f, 0x33, 0x67,
+	0xa9, 0x66, 0x79, 0xe6, 0x8c, 0xde, 0x81, 0x5d, 0x69, 0xfe, 0xea, 0x1e, 0xed, 0xf3, 0xa9, 0x4a,
+	0x1f, 0xc2, 0x4d, 0xc9, 0x50, 0x7e, 0xab, 0xa4, 0x58, 0x4b, 0x8b, 0xfd, 0x02, 0x20, 0xc5, 0xb6,
+	0x36, 0x51, 0xbf, 0x68, 0x54, 0xf3, 0x6a, 0x06, 0x97, 0x3e, 0x86, 0x22, 0x73, 0x96, 0x2d, 0x89,
+	0x6c, 0x84, 0xd6, 0x76, 0x84, 0xbc, 0x0c, 0xe8, 0x86, 0x18, 0x8b, 0x39, 0xd1, 0xb7, 0x15, 0xc5,
+	0x87, 0xd6, 0x80, 0xe3, 0x89, 0x20, 0x64, 0x3f, 0x97, 0x4d, 0x41, 0x55, 0xab, 0x56, 0xa6, 0x56,
+	0x23, 0xe8, 0x25, 0x16, 0x54, 0x15, 0x35, 0x6d, 0xd4, 0xdd, 0xca, 0xd4, 0x5d, 0x0e, 0x95, 0xe5,
+	0x12, 0xfd, 0x19, 0x2a, 0x5f, 0xd2, 0x03, 0xb1, 0x76, 0x30, 0x9e, 0x07, 0x5e, 0x72, 0x6f, 0x24,
+	0x45, 0x69, 0xc6, 0xcb, 0x35, 0xf7, 0xd2, 0x0d, 0x3c, 0x4c, 0xa0, 0x0f, 0xff, 0xa6, 0xbf, 0x37,
+	0x61, 0x5f, 0xf4, 0xea, 0xe7, 0xaf, 0xdd, 0xb9, 0xe3, 0xcf, 0x50, 0xed, 0x31, 0xe5, 0x7a, 0x33,
+	0xe3, 0x7a, 0x9a, 0x8f, 0x96, 0x99, 0x0f, 0xad, 0xb8, 0x9d, 0x2a, 0xce, 0x84, 0x69, 0x6d, 0x85,
+	0x39, 0x82, 0xde, 0x05, 0x86, 0x6c, 0xca, 0x30, 0x54, 0xd1, 0x68, 0x9a, 0x46, 0x70, 0x53, 0x8d,
+	0x2a, 0x31, 0x2c, 0xae, 0xe3, 0x8e, 0x31, 0x79, 0xda, 0x45, 0x93, 0x27, 0x93, 0x5f, 0xfa, 0x03,
+	0x1c, 0xc8, 0x76, 0x52, 0xa6, 0x2f, 0x82, 0xc5, 0xb5, 0x12, 0xa1, 0xc7, 0x4e, 0xdb, 0x9c, 0x4d,
+	0x9f, 0x02, 0xd8, 0x62, 0xf1, 0x88, 0x66, 0x4d, 0x9a, 0xb3, 0x99, 0x85, 0x44, 0xe8, 0x7b, 0xeb,
+	0x80, 0xe9, 0x6e, 0xd1, 0x34, 0x07, 0xc2, 0x52, 0x3a, 0xd9, 0x03, 0x1f, 0x65, 0xf7, 0xc0, 0x38,
+	0x7f, 0xa2, 0xa4, 0x16, 0xd5, 0x12, 0x38, 0xfe, 0x9b, 0x80, 0xc5, 0xfb, 0x81, 0xd8, 0x12, 0xc4,
+	0x71, 0x7d, 0xe4, 0x6e, 0xf1, 0x3c, 0x52, 0xfb, 0x69, 0x54, 0xc9, 0xb2, 0xa6, 0x0d, 0x72, 0x2a,
+	0x75, 0xf2, 0x6b, 0x5e, 0xa6, 0x53, 0x8d, 0x81, 0x51, 0xc5, 0x18, 0xa4, 0x0d, 0x72, 0x06, 0x90,
+	0x3e, 0x12, 0xc8, 0x61, 0x31, 0xbf, 0xc6, 0x22, 0x35, 0x94, 0x9e, 0x4a, 0xa5, 0x12, 0x67, 0x94,
+	0x29, 0xd5, 0x48, 0x64, 0x34, 0x2a, 0xc0, 0xc6, 0xfc, 0x21, 0xd3, 0x20, 0xdf, 0xc0, 0x8e, 0x01,
+	0x1b, 0xc9, 0x3b, 0x25, 0x91, 0x6b, 0x64, 0x59, 0xa1, 0x52, 0x65, 0x52, 0x54, 0xaa, 0x24, 0x93,
+	0xea, 0x3d, 0x53, 0x23, 0xe8, 0x17, 0xc6, 0x63, 0x42, 0xd4, 0xe7, 0x5e, 0x05, 0x72, 0xa8, 0x5f,
+	0x24, 0xc7, 0x50, 0x2d, 0xda, 0xa9, 0x4a, 0x75, 0xd2, 0x53, 0xf5, 0xf8, 0x78, 0x63, 0xbd, 0x82,
+	0x9b, 0x5b, 0xb0, 0x93, 0xdc, 0xaf, 0x10, 0x4e, 0x3b, 0xa2, 0x0e, 0x46, 0xda, 0xb2, 0xa1, 0x7a,
+	0xa3, 0xca, 0x46, 0xda, 0x20, 0x35, 0x6d, 0xbc, 0x30, 0x6c, 0xc8, 0x5d, 0x57, 0x69, 0x43, 0xaf,
+	0xc4, 0x8a, 0x8e, 0xb1, 0x01, 0x24, 0xdc, 0x88, 0xa2, 0x0d, 0xd6, 0xe9, 0x99, 0x82, 0xf7, 0x9e,
+	0xc6, 0x7f, 0xf2, 0xa6, 0x08, 0x72, 0x32, 0x47, 0x77, 0x41, 0x6e, 0x97, 0x08, 0x54, 0x29, 0xe4,
+	0x20, 0x88, 0x36, 0xc8, 0x63, 0xd8, 0x31, 0x86, 0x6b, 0xa5, 0xc6, 0xf2, 0x88, 0x5f, 0xc2, 0x5e,
+	0x16, 0xd5, 0x90, 0xf7, 0x0a, 0x72, 0xb9, 0x8d, 0x7d, 0x46, 0xe3, 0x32, 0x46, 0x15, 0xfc, 0x79,
+	0x82, 0x2e, 0xc4, 0x75, 0x39, 0x2c, 0x93, 0x48, 0xee, 0x4a, 0x1d, 0xb5, 0x67, 0x30, 0x30, 0xb1,
+	0x0e, 0x79, 0xb7, 0x4c, 0xa6, 0x6e, 0xf1, 0xcf, 0xa1, 0xaf, 0x97, 0x3c, 0xa1, 0xf9, 0xac, 0x26,
+	0x56, 0x19, 0x55, 0xf3, 0xf0, 0x6b, 0xf7, 0x3d, 0xec, 0x66, 0x60, 0x41, 0xd1, 0xcd, 0xde, 0xc6,
+	0x0e, 0x75, 0x7a, 0xeb, 0x3b, 0x18, 0x98, 0x2b, 0xbe, 0x28, 0x0f, 0x5b, 0x30, 0xa0, 0x8e, 0xe6,
+	0x97, 0x09, 0xda, 0x35, 0x5b, 0xed, 0xfd, 0xb2, 0x34, 0x67, 0x17, 0x7e, 0x45, 0xae, 0x9f, 0xc3,
+	0x40, 0x61, 0xff, 0xda, 0x57, 0xad, 0x80, 0xc5, 0x78, 0xab, 0xd0, 0x06, 0xf9, 0x16, 0xf6, 0xf4,
+	0x03, 0x47, 0xa6, 0x64, 0x5c, 0x2a, 0x56, 0x5b, 0xf1, 0xa9, 0x7e, 0xac, 0xa8, 0x5c, 0x54, 0xeb,
+	0xad, 0x5a, 0x4e, 0x90, 0xbe, 0x7e, 0x48, 0x09, 0x6f, 0xd1, 0x58, 0xcc, 0xbc, 0x9d, 0x68, 0x83,
+	0x3c, 0x85, 0x3d, 0x89, 0x54, 0xf4, 0x4d, 0xae, 0xc4, 0x33, 0xd5, 0x2e, 0xa6, 0x78, 0xe9, 0x3a,
+	0x2e, 0x66, 0xd0, 0x16, 0x6d, 0xbc, 0xea, 0x8a, 0xe3, 0x0f, 0xff, 0x1d, 0x00, 0xb6, 0xfd, 0x67,
+	0x91, 0xe1, 0x14, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// AuthClient is the client API for Auth service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type AuthClient interface {
+	UserList(ctx context.Context, in *UserListReq, opts ...grpc.CallOption) (*UserListRep, error)
+	UserShow(ctx context.Context, in *UserShowReq, opts ...grpc.CallOption) (*UserView, error)
+	UserCreate(ctx context.Context, in *UserCreateReq, opts ...grpc.CallOption) (*UserView, error)
+	UserUpdate(ctx context.Context, in *UserUpdateReq, opts ...grpc.CallOption) (*None, error)
+	UserSuspend(ctx context.Context, in *UserSuspendReq, opts ...grpc.CallOption) (*None, error)
+	UserAuth(ctx context.Context, in *UserAuthReq, opts ...grpc.CallOption) (*UserView, error)
+	// Check the given Character can be managed by the given User and return
+	// an abstract of the Character information. The user information is also
+	// returned to save calls from the main service.
+	CharacterShow(ctx context.Context, in *CharacterShowReq, opts ...grpc.CallOption) (*UserView, error)
+	// Return all the live Characters of the given User, including those set off
+	CharacterList(ctx context.Context, in *CharacterListReq, opts ...grpc.CallOption) (*CharacterListRep, error)
+	// Create a Character for the User, bound to the given Region. The name must
+	// be unique in the Region and the User cannot exceed its quota of Characters.
+	CharacterCreate(ctx context.Context, in *CharacterCreateReq, opts ...grpc.CallOption) (*CharacterView, error)
+	// Rename, rebind, retire or restore a Character
+	CharacterUpdate(ctx context.Context, in *CharacterUpdateReq, opts ...grpc.CallOption) (*CharacterView, error)
+	CharacterDelete(ctx context.Context, in *CharacterDeleteReq, opts ...grpc.CallOption) (*None, error)
+	// Authenticate the User with its password and grant a bearer token
+	TokenIssue(ctx context.Context, in *UserAuthReq, opts ...grpc.CallOption) (*TokenView, error)
+	// Return the User the token has been granted to, if it is still valid,
+	// with the scopes of the token
+	TokenCheck(ctx context.Context, in *TokenReq, opts ...grpc.CallOption) (*TokenInfo, error)
+	// Revoke an access token or a refresh token
+	TokenRevoke(ctx context.Context, in *TokenReq, opts ...grpc.CallOption) (*None, error)
+	// Register an OAuth2 client on behalf of the User
+	ClientRegister(ctx context.Context, in *ClientRegisterReq, opts ...grpc.CallOption) (*ClientView, error)
+	ClientShow(ctx context.Context, in *ClientShowReq, opts ...grpc.CallOption) (*ClientView, error)
+	// Forget the client and revoke all the tokens granted to it
+	ClientDelete(ctx context.Context, in *ClientDeleteReq, opts ...grpc.CallOption) (*None, error)
+	// Record the consent of the (already authenticated) User and return an
+	// authorization code for the client
+	Authorize(ctx context.Context, in *AuthorizeReq, opts ...grpc.CallOption) (*AuthorizeRep, error)
+	// Exchange an authorization code for an access and a refresh token
+	TokenExchange(ctx context.Context, in *TokenExchangeReq, opts ...grpc.CallOption) (*TokenView, error)
+	// Exchange a refresh token for a new pair of tokens
+	TokenRefresh(ctx context.Context, in *TokenRefreshReq, opts ...grpc.CallOption) (*TokenView, error)
+	// Revoke an access token or a refresh token on behalf of the client it
+	// has been granted to (RFC 7009)
+	ClientTokenRevoke(ctx context.Context, in *ClientTokenRevokeReq, opts ...grpc.CallOption) (*None, error)
+	// Authenticate the User with its password and open a session
+	SessionIssue(ctx context.Context, in *UserAuthReq, opts ...grpc.CallOption) (*SessionView, error)
+	// Return a new token for the session, even if the given token expired,
+	// unless the session has been revoked or is too old
+	SessionRefresh(ctx context.Context, in *SessionReq, opts ...grpc.CallOption) (*SessionView, error)
+	// Prevent any further refresh of the session. The token already issued
+	// stays valid until it expires, i.e. up to --session-ttl (15 minutes by
+	// default), because the front services verify it without any call.
+	SessionRevoke(ctx context.Context, in *SessionReq, opts ...grpc.CallOption) (*None, error)
+	// Return the key verifying the session tokens
+	SessionKey(ctx context.Context, in *None, opts ...grpc.CallOption) (*SessionKeyRep, error)
+	// Record the endpoint of a Region service. The registration must be
+	// renewed periodically, or it expires.
+	RegionRegister(ctx context.Context, in *RegionView, opts ...grpc.CallOption) (*None, error)
+	// Return the live Regions, both the static and the registered ones
+	RegionList(ctx context.Context, in *None, opts ...grpc.CallOption) (*RegionListRep, error)
+}
+
+type authClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewAuthClient(cc *grpc.ClientConn) AuthClient {
+	return &authClient{cc}
+}
+
+func (c *authClient) UserList(ctx context.Context, in *UserListReq, opts ...grpc.CallOption) (*UserListRep, error) {
+	out := new(UserListRep)
+	err := c.cc.Invoke(ctx, "/hegemonie.auth.proto.Auth/UserList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authClient) UserShow(ctx context.Context, in *UserShowReq, opts ...grpc.CallOption) (*UserView, error) {
+	out := new(UserView)
+	err := c.cc.Invoke(ctx, "/hegemonie.auth.proto.Auth/UserShow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authClient) UserCreate(ctx context.Context, in *UserCreateReq, opts ...grpc.CallOption) (*UserView, error) {
+	out := new(UserView)
+	err := c.cc.Invoke(ctx, "/hegemonie.auth.proto.Auth/UserCreate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authClient) UserUpdate(ctx context.Context, in *UserUpdateReq, opts ...grpc.CallOption) (*None, error) {
+	out := new(None)
+	err := c.cc.Invoke(ctx, "/hegemonie.auth.proto.Auth/UserUpdate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authClient) UserSuspend(ctx context.Context, in *UserSuspendReq, opts ...grpc.CallOption) (*None, error) {
+	out := new(None)
+	err := c.cc.Invoke(ctx, "/hegemonie.auth.proto.Auth/UserSuspend", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authClient) UserAuth(ctx context.Context, in *UserAuthReq, opts ...grpc.CallOption) (*UserView, error) {
+	out := new(UserView)
+	err := c.cc.Invoke(ctx, "/hegemonie.auth.proto.Auth/UserAuth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authClient) CharacterShow(ctx context.Context, in *CharacterShowReq, opts ...grpc.CallOption) (*UserView, error) {
+	out := new(UserView)
+	err := c.cc.Invoke(ctx, "/hegemonie.auth.proto.Auth/CharacterShow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authClient) CharacterList(ctx context.Context, in *CharacterListReq, opts ...grpc.CallOption) (*CharacterListRep, error) {
+	out := new(CharacterListRep)
+	err := c.cc.Invoke(ctx, "/hegemonie.auth.proto.Auth/CharacterList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authClient) CharacterCreate(ctx context.Context, in *CharacterCreateReq, opts ...grpc.CallOption) (*CharacterView, error) {
+	out := new(CharacterView)
+	err := c.cc.Invoke(ctx, "/hegemonie.auth.proto.Auth/CharacterCreate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authClient) CharacterUpdate(ctx context.Context, in *CharacterUpdateReq, opts ...grpc.CallOption) (*CharacterView, error) {
+	out := new(CharacterView)
+	err := c.cc.Invoke(ctx, "/hegemonie.auth.proto.Auth/CharacterUpdate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authClient) CharacterDelete(ctx context.Context, in *CharacterDeleteReq, opts ...grpc.CallOption) (*None, error) {
+	out := new(None)
+	err := c.cc.Invoke(ctx, "/hegemonie.auth.proto.Auth/CharacterDelete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authClient) TokenIssue(ctx context.Context, in *UserAuthReq, opts ...grpc.CallOption) (*TokenView, error) {
+	out := new(TokenView)
+	err := c.cc.Invoke(ctx, "/hegemonie.auth.proto.Auth/TokenIssue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authClient) TokenCheck(ctx context.Context, in *TokenReq, opts ...grpc.CallOption) (*TokenInfo, error) {
+	out := new(TokenInfo)
+	err := c.cc.Invoke(ctx, "/hegemonie.auth.proto.Auth/TokenCheck", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authClient) TokenRevoke(ctx context.Context, in *TokenReq, opts ...grpc.CallOption) (*None, error) {
+	out := new(None)
+	err := c.cc.Invoke(ctx, "/hegemonie.auth.proto.Auth/TokenRevoke", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authClient) ClientRegister(ctx context.Context, in *ClientRegisterReq, opts ...grpc.CallOption) (*ClientView, error) {
+	out := new(ClientView)
+	err := c.cc.Invoke(ctx, "/hegemonie.auth.proto.Auth/ClientRegister", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authClient) ClientShow(ctx context.Context, in *ClientShowReq, opts ...grpc.CallOption) (*ClientView, error) {
+	out := new(ClientView)
+	err := c.cc.Invoke(ctx, "/hegemonie.auth.proto.Auth/ClientShow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authClient) ClientDelete(ctx context.Context, in *ClientDeleteReq, opts ...grpc.CallOption) (*None, error) {
+	out := new(None)
+	err := c.cc.Invoke(ctx, "/hegemonie.auth.proto.Auth/ClientDelete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authClient) Authorize(ctx context.Context, in *AuthorizeReq, opts ...grpc.CallOption) (*AuthorizeRep, error) {
+	out := new(AuthorizeRep)
+	err := c.cc.Invoke(ctx, "/hegemonie.auth.proto.Auth/Authorize", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authClient) TokenExchange(ctx context.Context, in *TokenExchangeReq, opts ...grpc.CallOption) (*TokenView, error) {
+	out := new(TokenView)
+	err := c.cc.Invoke(ctx, "/hegemonie.auth.proto.Auth/TokenExchange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authClient) TokenRefresh(ctx context.Context, in *TokenRefreshReq, opts ...grpc.CallOption) (*TokenView, error) {
+	out := new(TokenView)
+	err := c.cc.Invoke(ctx, "/hegemonie.auth.proto.Auth/TokenRefresh", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authClient) ClientTokenRevoke(ctx context.Context, in *ClientTokenRevokeReq, opts ...grpc.CallOption) (*None, error) {
+	out := new(None)
+	err := c.cc.Invoke(ctx, "/hegemonie.auth.proto.Auth/ClientTokenRevoke", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authClient) SessionIssue(ctx context.Context, in *UserAuthReq, opts ...grpc.CallOption) (*SessionView, error) {
+	out := new(SessionView)
+	err := c.cc.Invoke(ctx, "/hegemonie.auth.proto.Auth/SessionIssue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authClient) SessionRefresh(ctx context.Context, in *SessionReq, opts ...grpc.CallOption) (*SessionView, error) {
+	out := new(SessionView)
+	err := c.cc.Invoke(ctx, "/hegemonie.auth.proto.Auth/SessionRefresh", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authClient) SessionRevoke(ctx context.Context, in *SessionReq, opts ...grpc.CallOption) (*None, error) {
+	out := new(None)
+	err := c.cc.Invoke(ctx, "/hegemonie.auth.proto.Auth/SessionRevoke", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authClient) SessionKey(ctx context.Context, in *None, opts ...grpc.CallOption) (*SessionKeyRep, error) {
+	out := new(SessionKeyRep)
+	err := c.cc.Invoke(ctx, "/hegemonie.auth.proto.Auth/SessionKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authClient) RegionRegister(ctx context.Context, in *RegionView, opts ...grpc.CallOption) (*None, error) {
+	out := new(None)
+	err := c.cc.Invoke(ctx, "/hegemonie.auth.proto.Auth/RegionRegister", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authClient) RegionList(ctx context.Context, in *None, opts ...grpc.CallOption) (*RegionListRep, error) {
+	out := new(RegionListRep)
+	err := c.cc.Invoke(ctx, "/hegemonie.auth.proto.Auth/RegionList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AuthServer is the server API for Auth service.
+type AuthServer interface {
+	UserList(context.Context, *UserListReq) (*UserListRep, error)
+	UserShow(context.Context, *UserShowReq) (*UserView, error)
+	UserCreate(context.Context, *UserCreateReq) (*UserView, error)
+	UserUpdate(context.Context, *UserUpdateReq) (*None, error)
+	UserSuspend(context.Context, *UserSuspendReq) (*None, error)
+	UserAuth(context.Context, *UserAuthReq) (*UserView, error)
+	// Check the given Character can be managed by the given User and return
+	// an abstract of the Character information. The user information is also
+	// returned to save calls from the main service.
+	CharacterShow(context.Context, *CharacterShowReq) (*UserView, error)
+	// Return all the live Characters of the given User, including those set off
+	CharacterList(context.Context, *CharacterListReq) (*CharacterListRep, error)
+	// Create a Character for the User, bound to the given Region. The name must
+	// be unique in the Region and the User cannot exceed its quota of Characters.
+	CharacterCreate(context.Context, *CharacterCreateReq) (*CharacterView, error)
+	// Rename, rebind, retire or restore a Character
+	CharacterUpdate(context.Context, *CharacterUpdateReq) (*CharacterView, error)
+	CharacterDelete(context.Context, *CharacterDeleteReq) (*None, error)
+	// Authenticate the User with its password and grant a bearer token
+	TokenIssue(context.Context, *UserAuthReq) (*TokenView, error)
+	// Return the User the token has been granted to, if it is still valid,
+	// with the scopes of the token
+	TokenCheck(context.Context, *TokenReq) (*TokenInfo, error)
+	// Revoke an access token or a refresh token
+	TokenRevoke(context.Context, *TokenReq) (*None, error)
+	// Register an OAuth2 client on behalf of the User
+	ClientRegister(context.Context, *ClientRegisterReq) (*ClientView, error)
+	ClientShow(context.Context, *ClientShowReq) (*ClientView, error)
+	// Forget the client and revoke all the tokens granted to it
+	ClientDelete(context.Context, *ClientDeleteReq) (*None, error)
+	// Record the consent of the (already authenticated) User and return an
+	// authorization code for the client
+	Authorize(context.Context, *AuthorizeReq) (*AuthorizeRep, error)
+	// Exchange an authorization code for an access and a refresh token
+	TokenExchange(context.Context, *TokenExchangeReq) (*TokenView, error)
+	// Exchange a refresh token for a new pair of tokens
+	TokenRefresh(context.Context, *TokenRefreshReq) (*TokenView, error)
+	// Revoke an access token or a refresh token on behalf of the client it
+	// has been granted to (RFC 7009)
+	ClientTokenRevoke(context.Context, *ClientTokenRevokeReq) (*None, error)
+	// Authenticate the User with its password and open a session
+	SessionIssue(context.Context, *UserAuthReq) (*SessionView, error)
+	// Return a new token for the session, even if the given token expired,
+	// unless the session has been revoked or is too old
+	SessionRefresh(context.Context, *SessionReq) (*SessionView, error)
+	// Prevent any further refresh of the session. The token already issued
+	// stays valid until it expires, i.e. up to --session-ttl (15 minutes by
+	// default), because the front services verify it without any call.
+	SessionRevoke(context.Context, *SessionReq) (*None, error)
+	// Return the key verifying the session tokens
+	SessionKey(context.Context, *None) (*SessionKeyRep, error)
+	// Record the endpoint of a Region service. The registration must be
+	// renewed periodically, or it expires.
+	RegionRegister(context.Context, *RegionView) (*None, error)
+	// Return the live Regions, both the static and the registered ones
+	RegionList(context.Context, *None) (*RegionListRep, error)
+}
+
+// UnimplementedAuthServer can be embedded to have forward compatible implementations.
+type UnimplementedAuthServer struct {
+}
+
+func (*UnimplementedAuthServer) UserList(ctx context.Context, req *UserListReq) (*UserListRep, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserList not implemented")
+}
+func (*UnimplementedAuthServer) UserShow(ctx context.Context, req *UserShowReq) (*UserView, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserShow not implemented")
+}
+func (*UnimplementedAuthServer) UserCreate(ctx context.Context, req *UserCreateReq) (*UserView, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserCreate not implemented")
+}
+func (*UnimplementedAuthServer) UserUpdate(ctx context.Context, req *UserUpdateReq) (*None, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserUpdate not implemented")
+}
+func (*UnimplementedAuthServer) UserSuspend(ctx context.Context, req *UserSuspendReq) (*None, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserSuspend not implemented")
+}
+func (*UnimplementedAuthServer) UserAuth(ctx context.Context, req *UserAuthReq) (*UserView, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserAuth not implemented")
+}
+func (*UnimplementedAuthServer) CharacterShow(ctx context.Context, req *CharacterShowReq) (*UserView, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CharacterShow not implemented")
+}
+func (*UnimplementedAuthServer) CharacterList(ctx context.Context, req *CharacterListReq) (*CharacterListRep, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CharacterList not implemented")
+}
+func (*UnimplementedAuthServer) CharacterCreate(ctx context.Context, req *CharacterCreateReq) (*CharacterView, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CharacterCreate not implemented")
+}
+func (*UnimplementedAuthServer) CharacterUpdate(ctx context.Context, req *CharacterUpdateReq) (*CharacterView, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CharacterUpdate not implemented")
+}
+func (*UnimplementedAuthServer) CharacterDelete(ctx context.Context, req *CharacterDeleteReq) (*None, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CharacterDelete not implemented")
+}
+func (*UnimplementedAuthServer) TokenIssue(ctx context.Context, req *UserAuthReq) (*TokenView, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenIssue not implemented")
+}
+func (*UnimplementedAuthServer) TokenCheck(ctx context.Context, req *TokenReq) (*TokenInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenCheck not implemented")
+}
+func (*UnimplementedAuthServer) TokenRevoke(ctx context.Context, req *TokenReq) (*None, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenRevoke not implemented")
+}
+func (*UnimplementedAuthServer) ClientRegister(ctx context.Context, req *ClientRegisterReq) (*ClientView, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClientRegister not implemented")
+}
+func (*UnimplementedAuthServer) ClientShow(ctx context.Context, req *ClientShowReq) (*ClientView, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClientShow not implemented")
+}
+func (*UnimplementedAuthServer) ClientDelete(ctx context.Context, req *ClientDeleteReq) (*None, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClientDelete not implemented")
+}
+func (*UnimplementedAuthServer) Authorize(ctx context.Context, req *AuthorizeReq) (*AuthorizeRep, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Authorize not implemented")
+}
+func (*UnimplementedAuthServer) TokenExchange(ctx context.Context, req *TokenExchangeReq) (*TokenView, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenExchange not implemented")
+}
+func (*UnimplementedAuthServer) TokenRefresh(ctx context.Context, req *TokenRefreshReq) (*TokenView, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenRefresh not implemented")
+}
+func (*UnimplementedAuthServer) ClientTokenRevoke(ctx context.Context, req *ClientTokenRevokeReq) (*None, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClientTokenRevoke not implemented")
+}
+func (*UnimplementedAuthServer) SessionIssue(ctx context.Context, req *UserAuthReq) (*SessionView, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SessionIssue not implemented")
+}
+func (*UnimplementedAuthServer) SessionRefresh(ctx context.Context, req *SessionReq) (*SessionView, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SessionRefresh not implemented")
+}
+func (*UnimplementedAuthServer) SessionRevoke(ctx context.Context, req *SessionReq) (*None, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SessionRevoke not implemented")
+}
+func (*UnimplementedAuthServer) SessionKey(ctx context.Context, req *None) (*SessionKeyRep, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SessionKey not implemented")
+}
+func (*UnimplementedAuthServer) RegionRegister(ctx context.Context, req *RegionView) (*None, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegionRegister not implemented")
+}
+func (*UnimplementedAuthServer) RegionList(ctx context.Context, req *None) (*RegionListRep, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegionList not implemented")
+}
+
+func RegisterAuthServer(s *grpc.Server, srv AuthServer) {
+	s.RegisterService(&_Auth_serviceDesc, srv)
+}
+
+func _Auth_UserList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServer).UserList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hegemonie.auth.proto.Auth/UserList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).UserList(ctx, req.(*UserListReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Auth_UserShow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserShowReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServer).UserShow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hegemonie.auth.proto.Auth/UserShow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).UserShow(ctx, req.(*UserShowReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Auth_UserCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserCreateReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServer).UserCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hegemonie.auth.proto.Auth/UserCreate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).UserCreate(ctx, req.(*UserCreateReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Auth_UserUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserUpdateReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServer).UserUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hegemonie.auth.proto.Auth/UserUpdate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).UserUpdate(ctx, req.(*UserUpdateReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Auth_UserSuspend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserSuspendReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServer).UserSuspend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hegemonie.auth.proto.Auth/UserSuspend",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).UserSuspend(ctx, req.(*UserSuspendReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Auth_UserAuth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserAuthReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServer).UserAuth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hegemonie.auth.proto.Auth/UserAuth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).UserAuth(ctx, req.(*UserAuthReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Auth_CharacterShow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CharacterShowReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServer).CharacterShow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hegemonie.auth.proto.Auth/CharacterShow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).CharacterShow(ctx, req.(*CharacterShowReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Auth_CharacterList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CharacterListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServer).CharacterList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hegemonie.auth.proto.Auth/CharacterList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).CharacterList(ctx, req.(*CharacterListReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Auth_CharacterCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CharacterCreateReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServer).CharacterCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hegemonie.auth.proto.Auth/CharacterCreate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).CharacterCreate(ctx, req.(*CharacterCreateReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *authClient) UserList(ctx context.Context, in *UserListReq, opts ...grpc.CallOption) (*UserListRep, error) {
-	out := new(UserListRep)
-	err := c.cc.Invoke(ctx, "/hegemonie.auth.proto.Auth/UserList", in, out, opts...)
-	if err != nil {
+func _Auth_CharacterUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CharacterUpdateReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(AuthServer).CharacterUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hegemonie.auth.proto.Auth/CharacterUpdate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).CharacterUpdate(ctx, req.(*CharacterUpdateReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *authClient) UserShow(ctx context.Context, in *UserShowReq, opts ...grpc.CallOption) (*UserView, error) {
-	out := new(UserView)
-	err := c.cc.Invoke(ctx, "/hegemonie.auth.proto.Auth/UserShow", in, out, opts...)
-	if err != nil {
+func _Auth_CharacterDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CharacterDeleteReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(AuthServer).CharacterDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hegemonie.auth.proto.Auth/CharacterDelete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).CharacterDelete(ctx, req.(*CharacterDeleteReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *authClient) UserCreate(ctx context.Context, in *UserCreateReq, opts ...grpc.CallOption) (*UserView, error) {
-	out := new(UserView)
-	err := c.cc.Invoke(ctx, "/hegemonie.auth.proto.Auth/UserCreate", in, out, opts...)
-	if err != nil {
+func _Auth_TokenIssue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserAuthReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(AuthServer).TokenIssue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hegemonie.auth.proto.Auth/TokenIssue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).TokenIssue(ctx, req.(*UserAuthReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *authClient) UserUpdate(ctx context.Context, in *UserUpdateReq, opts ...grpc.CallOption) (*None, error) {
-	out := new(None)
-	err := c.cc.Invoke(ctx, "/hegemonie.auth.proto.Auth/UserUpdate", in, out, opts...)
-	if err != nil {
+func _Auth_TokenCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TokenReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(AuthServer).TokenCheck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hegemonie.auth.proto.Auth/TokenCheck",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).TokenCheck(ctx, req.(*TokenReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *authClient) UserSuspend(ctx context.Context, in *UserSuspendReq, opts ...grpc.CallOption) (*None, error) {
-	out := new(None)
-	err := c.cc.Invoke(ctx, "/hegemonie.auth.proto.Auth/UserSuspend", in, out, opts...)
-	if err != nil {
+func _Auth_TokenRevoke_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TokenReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(AuthServer).TokenRevoke(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hegemonie.auth.proto.Auth/TokenRevoke",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).TokenRevoke(ctx, req.(*TokenReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *authClient) UserAuth(ctx context.Context, in *UserAuthReq, opts ...grpc.CallOption) (*UserView, error) {
-	out := new(UserView)
-	err := c.cc.Invoke(ctx, "/hegemonie.auth.proto.Auth/UserAuth", in, out, opts...)
-	if err != nil {
+func _Auth_ClientRegister_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClientRegisterReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(AuthServer).ClientRegister(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hegemonie.auth.proto.Auth/ClientRegister",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).ClientRegister(ctx, req.(*ClientRegisterReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *authClient) CharacterShow(ctx context.Context, in *CharacterShowReq, opts ...grpc.CallOption) (*UserView, error) {
-	out := new(UserView)
-	err := c.cc.Invoke(ctx, "/hegemonie.auth.proto.Auth/CharacterShow", in, out, opts...)
-	if err != nil {
+func _Auth_ClientShow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClientShowReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(AuthServer).ClientShow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hegemonie.auth.proto.Auth/ClientShow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).ClientShow(ctx, req.(*ClientShowReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// AuthServer is the server API for Auth service.
-type AuthServer interface {
-	UserList(context.Context, *UserListReq) (*UserListRep, error)
-	UserShow(context.Context, *UserShowReq) (*UserView, error)
-	UserCreate(context.Context, *UserCreateReq) (*UserView, error)
-	UserUpdate(context.Context, *UserUpdateReq) (*None, error)
-	UserSuspend(context.Context, *UserSuspendReq) (*None, error)
-	UserAuth(context.Context, *UserAuthReq) (*UserView, error)
-	// Check the given Character can be managed by the given User and return
-	// an abstract of the Character information. The user information is also
-	// returned to save calls from the main service.
-	CharacterShow(context.Context, *CharacterShowReq) (*UserView, error)
+func _Auth_ClientDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClientDeleteReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServer).ClientDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hegemonie.auth.proto.Auth/ClientDelete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).ClientDelete(ctx, req.(*ClientDeleteReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// UnimplementedAuthServer can be embedded to have forward compatible implementations.
-type UnimplementedAuthServer struct {
+func _Auth_Authorize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthorizeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServer).Authorize(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hegemonie.auth.proto.Auth/Authorize",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).Authorize(ctx, req.(*AuthorizeReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (*UnimplementedAuthServer) UserList(ctx context.Context, req *UserListReq) (*UserListRep, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UserList not implemented")
-}
-func (*UnimplementedAuthServer) UserShow(ctx context.Context, req *UserShowReq) (*UserView, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UserShow not implemented")
-}
-func (*UnimplementedAuthServer) UserCreate(ctx context.Context, req *UserCreateReq) (*UserView, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UserCreate not implemented")
-}
-func (*UnimplementedAuthServer) UserUpdate(ctx context.Context, req *UserUpdateReq) (*None, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UserUpdate not implemented")
-}
-func (*UnimplementedAuthServer) UserSuspend(ctx context.Context, req *UserSuspendReq) (*None, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UserSuspend not implemented")
-}
-func (*UnimplementedAuthServer) UserAuth(ctx context.Context, req *UserAuthReq) (*UserView, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UserAuth not implemented")
-}
-func (*UnimplementedAuthServer) CharacterShow(ctx context.Context, req *CharacterShowReq) (*UserView, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CharacterShow not implemented")
+func _Auth_TokenExchange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TokenExchangeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServer).TokenExchange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hegemonie.auth.proto.Auth/TokenExchange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).TokenExchange(ctx, req.(*TokenExchangeReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterAuthServer(s *grpc.Server, srv AuthServer) {
-	s.RegisterService(&_Auth_serviceDesc, srv)
+func _Auth_TokenRefresh_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TokenRefreshReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServer).TokenRefresh(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hegemonie.auth.proto.Auth/TokenRefresh",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).TokenRefresh(ctx, req.(*TokenRefreshReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Auth_UserList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UserListReq)
+func _Auth_ClientTokenRevoke_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClientTokenRevokeReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AuthServer).UserList(ctx, in)
+		return srv.(AuthServer).ClientTokenRevoke(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/hegemonie.auth.proto.Auth/UserList",
+		FullMethod: "/hegemonie.auth.proto.Auth/ClientTokenRevoke",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AuthServer).UserList(ctx, req.(*UserListReq))
+		return srv.(AuthServer).ClientTokenRevoke(ctx, req.(*ClientTokenRevokeReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Auth_UserShow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UserShowReq)
+func _Auth_SessionIssue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserAuthReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AuthServer).UserShow(ctx, in)
+		return srv.(AuthServer).SessionIssue(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/hegemonie.auth.proto.Auth/UserShow",
+		FullMethod: "/hegemonie.auth.proto.Auth/SessionIssue",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AuthServer).UserShow(ctx, req.(*UserShowReq))
+		return srv.(AuthServer).SessionIssue(ctx, req.(*UserAuthReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Auth_UserCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UserCreateReq)
+func _Auth_SessionRefresh_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SessionReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AuthServer).UserCreate(ctx, in)
+		return srv.(AuthServer).SessionRefresh(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/hegemonie.auth.proto.Auth/UserCreate",
+		FullMethod: "/hegemonie.auth.proto.Auth/SessionRefresh",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AuthServer).UserCreate(ctx, req.(*UserCreateReq))
+		return srv.(AuthServer).SessionRefresh(ctx, req.(*SessionReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Auth_UserUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UserUpdateReq)
+func _Auth_SessionRevoke_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SessionReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AuthServer).UserUpdate(ctx, in)
+		return srv.(AuthServer).SessionRevoke(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/hegemonie.auth.proto.Auth/UserUpdate",
+		FullMethod: "/hegemonie.auth.proto.Auth/SessionRevoke",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AuthServer).UserUpdate(ctx, req.(*UserUpdateReq))
+		return srv.(AuthServer).SessionRevoke(ctx, req.(*SessionReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Auth_UserSuspend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UserSuspendReq)
+func _Auth_SessionKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(None)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AuthServer).UserSuspend(ctx, in)
+		return srv.(AuthServer).SessionKey(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/hegemonie.auth.proto.Auth/UserSuspend",
+		FullMethod: "/hegemonie.auth.proto.Auth/SessionKey",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AuthServer).UserSuspend(ctx, req.(*UserSuspendReq))
+		return srv.(AuthServer).SessionKey(ctx, req.(*None))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Auth_UserAuth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UserAuthReq)
+func _Auth_RegionRegister_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegionView)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AuthServer).UserAuth(ctx, in)
+		return srv.(AuthServer).RegionRegister(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/hegemonie.auth.proto.Auth/UserAuth",
+		FullMethod: "/hegemonie.auth.proto.Auth/RegionRegister",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AuthServer).UserAuth(ctx, req.(*UserAuthReq))
+		return srv.(AuthServer).RegionRegister(ctx, req.(*RegionView))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Auth_CharacterShow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CharacterShowReq)
+func _Auth_RegionList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(None)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AuthServer).CharacterShow(ctx, in)
+		return srv.(AuthServer).RegionList(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/hegemonie.auth.proto.Auth/CharacterShow",
+		FullMethod: "/hegemonie.auth.proto.Auth/RegionList",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AuthServer).CharacterShow(ctx, req.(*CharacterShowReq))
+		return srv.(AuthServer).RegionList(ctx, req.(*None))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -988,6 +2974,86 @@ var _Auth_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CharacterShow",
 			Handler:    _Auth_CharacterShow_Handler,
 		},
+		{
+			MethodName: "CharacterList",
+			Handler:    _Auth_CharacterList_Handler,
+		},
+		{
+			MethodName: "CharacterCreate",
+			Handler:    _Auth_CharacterCreate_Handler,
+		},
+		{
+			MethodName: "CharacterUpdate",
+			Handler:    _Auth_CharacterUpdate_Handler,
+		},
+		{
+			MethodName: "CharacterDelete",
+			Handler:    _Auth_CharacterDelete_Handler,
+		},
+		{
+			MethodName: "TokenIssue",
+			Handler:    _Auth_TokenIssue_Handler,
+		},
+		{
+			MethodName: "TokenCheck",
+			Handler:    _Auth_TokenCheck_Handler,
+		},
+		{
+			MethodName: "TokenRevoke",
+			Handler:    _Auth_TokenRevoke_Handler,
+		},
+		{
+			MethodName: "ClientRegister",
+			Handler:    _Auth_ClientRegister_Handler,
+		},
+		{
+			MethodName: "ClientShow",
+			Handler:    _Auth_ClientShow_Handler,
+		},
+		{
+			MethodName: "ClientDelete",
+			Handler:    _Auth_ClientDelete_Handler,
+		},
+		{
+			MethodName: "Authorize",
+			Handler:    _Auth_Authorize_Handler,
+		},
+		{
+			MethodName: "TokenExchange",
+			Handler:    _Auth_TokenExchange_Handler,
+		},
+		{
+			MethodName: "TokenRefresh",
+			Handler:    _Auth_TokenRefresh_Handler,
+		},
+		{
+			MethodName: "ClientTokenRevoke",
+			Handler:    _Auth_ClientTokenRevoke_Handler,
+		},
+		{
+			MethodName: "SessionIssue",
+			Handler:    _Auth_SessionIssue_Handler,
+		},
+		{
+			MethodName: "SessionRefresh",
+			Handler:    _Auth_SessionRefresh_Handler,
+		},
+		{
+			MethodName: "SessionRevoke",
+			Handler:    _Auth_SessionRevoke_Handler,
+		},
+		{
+			MethodName: "SessionKey",
+			Handler:    _Auth_SessionKey_Handler,
+		},
+		{
+			MethodName: "RegionRegister",
+			Handler:    _Auth_RegionRegister_Handler,
+		},
+		{
+			MethodName: "RegionList",
+			Handler:    _Auth_RegionList_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "auth.proto",
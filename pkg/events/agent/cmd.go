@@ -7,16 +7,236 @@ package hegemonie_events_agent
 
 import (
 	"errors"
+	"fmt"
+	"github.com/jfsmig/hegemonie/pkg/events/model"
+	proto "github.com/jfsmig/hegemonie/pkg/events/proto"
 	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"io"
+	"log"
+	"net"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"time"
 )
 
+type eventsConfig struct {
+	endpoint string
+	backlog  int
+	buffer   int
+
+	pathReports   string
+	periodSave    time.Duration
+	reportsMaxAge time.Duration
+	reportsMax    int
+
+	pathSinks       string
+	pathDeadLetters string
+}
+
+type eventsService struct {
+	cfg    *eventsConfig
+	broker *events.Broker
+	inbox  *events.Inbox
+	sinks  *events.Dispatcher
+}
+
+type reportsService struct {
+	cfg   *eventsConfig
+	inbox *events.Inbox
+}
+
 func Command() *cobra.Command {
-	return &cobra.Command{
+	cfg := eventsConfig{}
+
+	agent := &cobra.Command{
 		Use:     "agent",
 		Aliases: []string{"srv", "server", "service", "worker"},
 		Short:   "Events service",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return errors.New("Missing subcommand")
+			srv := eventsService{cfg: &cfg}
+			return srv.execute()
 		},
 	}
+
+	agent.Flags().StringVar(
+		&cfg.endpoint, "endpoint", "127.0.0.1:8083",
+		"IP:PORT endpoint for the TCP/IP server")
+	agent.Flags().IntVar(
+		&cfg.backlog, "backlog", 1024,
+		"Number of events kept per topic for the subscribers that resume")
+	agent.Flags().IntVar(
+		&cfg.buffer, "buffer", 256,
+		"Number of events buffered per subscriber before it is dropped")
+	agent.Flags().StringVar(
+		&cfg.pathReports, "reports", "",
+		"File where the reports are persisted (empty to keep them in memory)")
+	agent.Flags().DurationVar(
+		&cfg.periodSave, "period-save", time.Minute,
+		"Period of the saves and of the expiration of the reports")
+	agent.Flags().DurationVar(
+		&cfg.reportsMaxAge, "reports-max-age", 30*24*time.Hour,
+		"Age of the oldest reports kept (0 for no limit)")
+	agent.Flags().IntVar(
+		&cfg.reportsMax, "reports-max", 1000,
+		"Number of reports kept per character (0 for no limit)")
+	agent.Flags().StringVar(
+		&cfg.pathSinks, "sinks", "",
+		"JSON file with the configuration of the sinks the events are forwarded to")
+	agent.Flags().StringVar(
+		&cfg.pathDeadLetters, "dead-letters", "",
+		"File where the events the sinks failed to deliver are appended")
+
+	return agent
+}
+
+func e(format string, args ...interface{}) error {
+	return errors.New(fmt.Sprintf(format, args...))
+}
+
+func (service *eventsService) execute() error {
+	service.broker = events.NewBroker(service.cfg.backlog, service.cfg.buffer)
+	service.inbox = events.NewInbox(events.Retention{
+		MaxAge: service.cfg.reportsMaxAge, MaxPerCharacter: service.cfg.reportsMax})
+
+	if p := service.cfg.pathReports; p != "" {
+		if err := service.load(p); err != nil && !os.IsNotExist(err) {
+			return e("Failed to load the reports from [%s]: %s", p, err.Error())
+		}
+	}
+
+	if err := service.openSinks(); err != nil {
+		return err
+	}
+	defer service.sinks.Close()
+
+	lis, err := net.Listen("tcp", service.cfg.endpoint)
+	if err != nil {
+		return e("failed to listen: %v", err)
+	}
+
+	server := grpc.NewServer()
+	proto.RegisterEventsServer(server, service)
+	proto.RegisterReportsServer(server, &reportsService{cfg: service.cfg, inbox: service.inbox})
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+	go func() {
+		sig := <-signals
+		log.Printf("Caught %v, stopping", sig)
+		server.Stop()
+	}()
+
+	stop := make(chan struct{})
+	go service.saver(stop)
+	err = server.Serve(lis)
+	close(stop)
+	if err != nil {
+		return e("failed to serve: %v", err)
+	}
+
+	if err = service.save(); err != nil {
+		return e("Failed to save the reports at exit: %s", err.Error())
+	}
+	return nil
+}
+
+func (service *eventsService) openSinks() error {
+	var cfgs []events.SinkConfig
+	if p := service.cfg.pathSinks; p != "" {
+		in, err := os.Open(p)
+		if err != nil {
+			return e("Failed to open the sinks configuration [%s]: %s", p, err.Error())
+		}
+		cfgs, err = events.LoadSinkConfigs(in)
+		in.Close()
+		if err != nil {
+			return e("Invalid sinks configuration [%s]: %s", p, err.Error())
+		}
+	}
+
+	var dead io.WriteCloser
+	if p := service.cfg.pathDeadLetters; p != "" {
+		var err error
+		if dead, err = events.OpenDeadLetters(p); err != nil {
+			return e("Failed to open the dead letters [%s]: %s", p, err.Error())
+		}
+	}
+
+	var err error
+	service.sinks, err = events.NewDispatcher(cfgs, dead)
+	if err != nil {
+		if dead != nil {
+			dead.Close()
+		}
+		return e("Invalid sinks configuration: %s", err.Error())
+	}
+	return nil
+}
+
+func (service *eventsService) load(p string) error {
+	in, err := os.Open(p)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	return service.inbox.Load(in)
+}
+
+// Periodically expire the old reports and save the inbox, until 'stop' is
+// closed.
+func (service *eventsService) saver(stop <-chan struct{}) {
+	if service.cfg.periodSave <= 0 {
+		return
+	}
+	ticker := time.NewTicker(service.cfg.periodSave)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			if nb := service.inbox.Expire(time.Now()); nb > 0 {
+				log.Printf("Expired %d reports", nb)
+			}
+			if err := service.save(); err != nil {
+				log.Printf("Save error: %s", err.Error())
+			}
+		}
+	}
+}
+
+// Atomically replace the file of the reports, if they changed
+func (service *eventsService) save() error {
+	p := service.cfg.pathReports
+	if p == "" || !service.inbox.Dirty() {
+		return nil
+	}
+
+	tmp := p + ".tmp"
+	out, err := os.OpenFile(tmp, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	err = service.inbox.Save(out)
+	if err == nil {
+		err = out.Sync()
+	}
+	out.Close()
+	if err == nil {
+		err = os.Rename(tmp, p)
+	}
+	if err != nil {
+		_ = os.Remove(tmp)
+		return err
+	}
+
+	dir, err := os.Open(filepath.Dir(p))
+	if err != nil {
+		return err
+	}
+	defer dir.Close()
+	return dir.Sync()
 }
@@ -0,0 +1,116 @@
+// Copyright (C) 2018-2020 Hegemonie's AUTHORS
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package hegemonie_events_agent
+
+import (
+	"context"
+	"github.com/jfsmig/hegemonie/pkg/events/model"
+	proto "github.com/jfsmig/hegemonie/pkg/events/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+func eventView(evt events.Event) *proto.Event {
+	return &proto.Event{
+		Topic: evt.Topic, Seq: evt.Seq, When: evt.When.UnixNano(),
+		Kind: evt.Kind, Payload: evt.Payload,
+	}
+}
+
+func (srv *eventsService) Publish(ctx context.Context, req *proto.PublishReq) (*proto.PublishRep, error) {
+	evt, err := srv.broker.Publish(req.Topic, req.Kind, req.Payload, time.Now())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Publish error: %s", err.Error())
+	}
+	srv.sinks.Forward(evt)
+	return &proto.PublishRep{Seq: evt.Seq}, nil
+}
+
+func (srv *eventsService) Subscribe(req *proto.SubscribeReq, stream proto.Events_SubscribeServer) error {
+	sub, err := srv.broker.Subscribe(req.Topic, req.After)
+	switch err {
+	case nil:
+	case events.ErrInvalidTopic:
+		return status.Errorf(codes.InvalidArgument, "Subscribe error: %s", err.Error())
+	default:
+		return status.Errorf(codes.OutOfRange, "Subscribe error: %s", err.Error())
+	}
+	defer sub.Close()
+
+	ctx := stream.Context()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case evt, ok := <-sub.Events:
+			if !ok {
+				if err = sub.Err(); err != nil {
+					return status.Errorf(codes.ResourceExhausted, "Subscription ended: %s", err.Error())
+				}
+				return nil
+			}
+			if err = stream.Send(eventView(evt)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (srv *eventsService) ListTopics(ctx context.Context, req *proto.ListTopicsReq) (*proto.ListOfTopics, error) {
+	rep := &proto.ListOfTopics{}
+	for _, t := range srv.broker.ListTopics(req.Prefix) {
+		rep.Items = append(rep.Items, &proto.TopicView{
+			Name: t.Name, First: t.First, Last: t.Last,
+			Subscribers: uint32(t.Subscribers),
+		})
+	}
+	return rep, nil
+}
+
+func (srv *reportsService) Deliver(ctx context.Context, req *proto.DeliverReq) (*proto.DeliverRep, error) {
+	if req.Character == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Missing character")
+	}
+	id := srv.inbox.Deliver(events.Report{
+		Character: req.Character, Region: req.Region, City: req.City,
+		When: time.Now(), Kind: req.Kind, Payload: req.Payload,
+	})
+	return &proto.DeliverRep{Id: id}, nil
+}
+
+func (srv *reportsService) List(ctx context.Context, req *proto.ListReportsReq) (*proto.ListOfReports, error) {
+	max := req.Max
+	if max < 1 {
+		max = 1
+	} else if max > 1000 {
+		max = 1000
+	}
+	f := events.ReportFilter{Region: req.Region, City: req.City, Unread: req.Unread}
+	tab, unread := srv.inbox.List(req.Character, f, req.Marker, int(max))
+
+	rep := &proto.ListOfReports{Unread: uint32(unread)}
+	for _, r := range tab {
+		rep.Items = append(rep.Items, &proto.Report{
+			Id: r.Id, Character: r.Character, Region: r.Region, City: r.City,
+			When: r.When.UnixNano(), Kind: r.Kind, Payload: r.Payload, Read: r.Read,
+		})
+	}
+	return rep, nil
+}
+
+func (srv *reportsService) MarkRead(ctx context.Context, req *proto.MarkReadReq) (*proto.MarkReadRep, error) {
+	if !req.All && len(req.Reports) <= 0 {
+		return &proto.MarkReadRep{}, nil
+	}
+	var nb int
+	if req.All {
+		nb = srv.inbox.MarkRead(req.Character)
+	} else {
+		nb = srv.inbox.MarkRead(req.Character, req.Reports...)
+	}
+	return &proto.MarkReadRep{Marked: uint32(nb)}, nil
+}
@@ -0,0 +1,146 @@
+// Copyright (C) 2018-2020 Hegemonie's AUTHORS
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package hegemonie_events_client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	proto "github.com/jfsmig/hegemonie/pkg/events/proto"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"io"
+	"os"
+	"strconv"
+)
+
+func dial(cfg *eventsConfig) (*grpc.ClientConn, proto.EventsClient, error) {
+	cnx, err := grpc.Dial(cfg.endpoint, grpc.WithInsecure(), grpc.WithBlock())
+	if err != nil {
+		return nil, nil, err
+	}
+	return cnx, proto.NewEventsClient(cnx), nil
+}
+
+func doPublish(cmd *cobra.Command, args []string, cfg *eventsConfig) error {
+	cnx, client, err := dial(cfg)
+	if err != nil {
+		return err
+	}
+	defer cnx.Close()
+
+	req := &proto.PublishReq{Topic: args[0], Kind: args[1]}
+	if len(args) > 2 {
+		req.Payload = []byte(args[2])
+	}
+	rep, err := client.Publish(context.Background(), req)
+	if err != nil {
+		return err
+	}
+	return json.NewEncoder(os.Stdout).Encode(rep)
+}
+
+func doSubscribe(cmd *cobra.Command, args []string, cfg *eventsConfig, after uint64) error {
+	cnx, client, err := dial(cfg)
+	if err != nil {
+		return err
+	}
+	defer cnx.Close()
+
+	stream, err := client.Subscribe(context.Background(),
+		&proto.SubscribeReq{Topic: args[0], After: after})
+	if err != nil {
+		return err
+	}
+	enc := json.NewEncoder(os.Stdout)
+	for {
+		evt, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		enc.Encode(evt)
+	}
+}
+
+func doListTopics(cmd *cobra.Command, args []string, cfg *eventsConfig) error {
+	cnx, client, err := dial(cfg)
+	if err != nil {
+		return err
+	}
+	defer cnx.Close()
+
+	req := &proto.ListTopicsReq{}
+	if len(args) > 0 {
+		req.Prefix = args[0]
+	}
+	rep, err := client.ListTopics(context.Background(), req)
+	if err != nil {
+		return err
+	}
+	enc := json.NewEncoder(os.Stdout)
+	for _, t := range rep.Items {
+		enc.Encode(t)
+	}
+	return nil
+}
+
+func doListReports(cmd *cobra.Command, args []string, cfg *eventsConfig, filter proto.ListReportsReq) error {
+	character, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return errors.New("Invalid character ID")
+	}
+	cnx, err := grpc.Dial(cfg.endpoint, grpc.WithInsecure(), grpc.WithBlock())
+	if err != nil {
+		return err
+	}
+	defer cnx.Close()
+	client := proto.NewReportsClient(cnx)
+
+	filter.Character = character
+	filter.Max = 100
+	enc := json.NewEncoder(os.Stdout)
+	for {
+		rep, err := client.List(context.Background(), &filter)
+		if err != nil {
+			return err
+		}
+		if len(rep.Items) <= 0 {
+			return nil
+		}
+		for _, r := range rep.Items {
+			filter.Marker = r.Id
+			enc.Encode(r)
+		}
+	}
+}
+
+func doMarkRead(cmd *cobra.Command, args []string, cfg *eventsConfig) error {
+	req := &proto.MarkReadReq{All: len(args) == 1}
+	for i, a := range args {
+		id, err := strconv.ParseUint(a, 10, 64)
+		if err != nil {
+			return errors.New("Invalid ID: " + a)
+		}
+		if i == 0 {
+			req.Character = id
+		} else {
+			req.Reports = append(req.Reports, id)
+		}
+	}
+
+	cnx, err := grpc.Dial(cfg.endpoint, grpc.WithInsecure(), grpc.WithBlock())
+	if err != nil {
+		return err
+	}
+	defer cnx.Close()
+	rep, err := proto.NewReportsClient(cnx).MarkRead(context.Background(), req)
+	if err != nil {
+		return err
+	}
+	return json.NewEncoder(os.Stdout).Encode(rep)
+}
@@ -7,25 +7,82 @@ package hegemonie_events_client
 
 import (
 	"errors"
+	proto "github.com/jfsmig/hegemonie/pkg/events/proto"
 	"github.com/spf13/cobra"
 )
 
-type authConfig struct {
+type eventsConfig struct {
 	endpoint string
 }
 
 func Command() *cobra.Command {
-	cfg := authConfig{}
+	cfg := eventsConfig{}
+	var after uint64
+	var filter proto.ListReportsReq
 
 	cmd := &cobra.Command{
 		Use:     "client",
 		Aliases: []string{"cli"},
-		Short:   "API service client",
+		Short:   "Events service client",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return errors.New("Missing subcommand")
 		},
 	}
 
-	cmd.Flags().StringVar(&cfg.endpoint, "endpoint", "127.0.0.1:8080", "IP:PORT endpoint for the TCP/IP server")
+	publish := &cobra.Command{
+		Use:     "publish TOPIC KIND [PAYLOAD]",
+		Aliases: []string{"pub", "push"},
+		Short:   "Publish an event",
+		Args:    cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return doPublish(cmd, args, &cfg)
+		},
+	}
+
+	subscribe := &cobra.Command{
+		Use:     "subscribe TOPIC",
+		Aliases: []string{"sub", "follow"},
+		Short:   "Print the events of a topic as they come",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return doSubscribe(cmd, args, &cfg, after)
+		},
+	}
+	subscribe.Flags().Uint64Var(&after, "after", 0, "Sequence number of the last event already received")
+
+	topics := &cobra.Command{
+		Use:     "topics [PREFIX]",
+		Aliases: []string{"ls", "list"},
+		Short:   "List the topics",
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return doListTopics(cmd, args, &cfg)
+		},
+	}
+
+	reports := &cobra.Command{
+		Use:     "reports CHARACTER",
+		Aliases: []string{"inbox"},
+		Short:   "List the reports of a character, from the newest",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return doListReports(cmd, args, &cfg, filter)
+		},
+	}
+	reports.Flags().StringVar(&filter.Region, "region", "", "Only the reports about the region")
+	reports.Flags().Uint64Var(&filter.City, "city", 0, "Only the reports about the city")
+	reports.Flags().BoolVar(&filter.Unread, "unread", false, "Only the unread reports")
+
+	read := &cobra.Command{
+		Use:   "read CHARACTER [REPORT...]",
+		Short: "Mark reports as read, all of them if none is given",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return doMarkRead(cmd, args, &cfg)
+		},
+	}
+
+	cmd.PersistentFlags().StringVar(&cfg.endpoint, "endpoint", "127.0.0.1:8083", "IP:PORT endpoint for the TCP/IP server")
+	cmd.AddCommand(publish, subscribe, topics, reports, read)
 	return cmd
 }
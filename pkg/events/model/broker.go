@@ -0,0 +1,231 @@
+// Copyright (C) 2018-2020 Hegemonie's AUTHORS
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package events
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	ErrInvalidTopic = errors.New("Invalid topic")
+	// The events after the requested sequence number are not in the backlog anymore
+	ErrTruncated = errors.New("Backlog truncated")
+	// The subscriber didn't consume its events fast enough
+	ErrLagging = errors.New("Subscriber lagging")
+)
+
+const (
+	TopicPrefixRegion    = "region/"
+	TopicPrefixCity      = "city/"
+	TopicPrefixCharacter = "character/"
+)
+
+func TopicRegion(region string) string {
+	return TopicPrefixRegion + region
+}
+
+func TopicCity(region string, city uint64) string {
+	return fmt.Sprintf("%s%s/%d", TopicPrefixCity, region, city)
+}
+
+func TopicCharacter(character uint64) string {
+	return fmt.Sprintf("%s%d", TopicPrefixCharacter, character)
+}
+
+// Tell if the topic is named after one of the known kinds of entities
+func ValidTopic(topic string) bool {
+	for _, prefix := range []string{TopicPrefixRegion, TopicPrefixCity, TopicPrefixCharacter} {
+		if strings.HasPrefix(topic, prefix) && len(topic) > len(prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+type Event struct {
+	Topic   string
+	Seq     uint64
+	When    time.Time
+	Kind    string
+	Payload []byte
+}
+
+type TopicInfo struct {
+	Name        string
+	First       uint64
+	Last        uint64
+	Subscribers int
+}
+
+// Route the events to the subscribers of their topic. Each topic keeps a
+// bounded backlog of its most recent events, so that a subscriber can
+// resume after a disconnection.
+type Broker struct {
+	// Maximum number of events kept per topic
+	Backlog int
+	// Number of events buffered per subscriber, beyond which it is dropped
+	Buffer int
+
+	lock   sync.Mutex
+	topics map[string]*topic
+}
+
+type topic struct {
+	name    string
+	last    uint64
+	backlog []Event
+	subs    map[*Subscription]struct{}
+}
+
+// The flow of events of a topic for one subscriber. The channel is closed
+// when the subscription ends, then Err() tells why.
+type Subscription struct {
+	Events <-chan Event
+
+	events chan Event
+	broker *Broker
+	topic  *topic
+	err    error
+}
+
+func NewBroker(backlog, buffer int) *Broker {
+	if backlog <= 0 {
+		backlog = 1
+	}
+	if buffer <= 0 {
+		buffer = 1
+	}
+	return &Broker{Backlog: backlog, Buffer: buffer, topics: make(map[string]*topic)}
+}
+
+// Return the topic, possibly new. A new topic is only registered by its first
+// event or its first subscriber, see register().
+func (b *Broker) getTopic(name string) *topic {
+	t, ok := b.topics[name]
+	if !ok {
+		t = &topic{name: name, subs: make(map[*Subscription]struct{})}
+	}
+	return t
+}
+
+func (b *Broker) register(t *topic) {
+	b.topics[t.name] = t
+}
+
+// Append the event to its topic and push it to the subscribers. The
+// subscribers whose buffer is full are dropped.
+func (b *Broker) Publish(name, kind string, payload []byte, when time.Time) (Event, error) {
+	if !ValidTopic(name) {
+		return Event{}, ErrInvalidTopic
+	}
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	t := b.getTopic(name)
+	b.register(t)
+	t.last++
+	evt := Event{Topic: name, Seq: t.last, When: when, Kind: kind, Payload: payload}
+	t.backlog = append(t.backlog, evt)
+	if len(t.backlog) > b.Backlog {
+		t.backlog = append(t.backlog[:0], t.backlog[len(t.backlog)-b.Backlog:]...)
+	}
+
+	for s := range t.subs {
+		select {
+		case s.events <- evt:
+		default:
+			s.end(ErrLagging)
+		}
+	}
+	return evt, nil
+}
+
+// Subscribe to the topic. The events of the backlog whose sequence number is
+// greater than 'after' are delivered first.
+func (b *Broker) Subscribe(name string, after uint64) (*Subscription, error) {
+	if !ValidTopic(name) {
+		return nil, ErrInvalidTopic
+	}
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	t := b.getTopic(name)
+	idx := sort.Search(len(t.backlog), func(i int) bool { return t.backlog[i].Seq > after })
+	if after > t.last {
+		// The sequence comes from a previous life of the broker
+		return nil, ErrTruncated
+	}
+	if after > 0 && after < t.last && (idx >= len(t.backlog) || t.backlog[idx].Seq != after+1) {
+		return nil, ErrTruncated
+	}
+
+	replay := t.backlog[idx:]
+	events := make(chan Event, len(replay)+b.Buffer)
+	for _, evt := range replay {
+		events <- evt
+	}
+	s := &Subscription{Events: events, events: events, broker: b, topic: t}
+	t.subs[s] = struct{}{}
+	b.register(t)
+	return s, nil
+}
+
+// End the subscription. Idempotent.
+func (s *Subscription) Close() {
+	s.broker.lock.Lock()
+	defer s.broker.lock.Unlock()
+	s.end(nil)
+}
+
+// Return why the subscription ended, nil if it was closed by the subscriber
+func (s *Subscription) Err() error {
+	s.broker.lock.Lock()
+	defer s.broker.lock.Unlock()
+	return s.err
+}
+
+// The caller must hold the lock of the broker
+func (s *Subscription) end(err error) {
+	if _, ok := s.topic.subs[s]; !ok {
+		return
+	}
+	delete(s.topic.subs, s)
+	s.err = err
+	close(s.events)
+
+	// A topic without any event is only kept for its subscribers, otherwise
+	// any subscription to a made-up topic would be kept forever
+	if len(s.topic.subs) == 0 && len(s.topic.backlog) == 0 {
+		delete(s.broker.topics, s.topic.name)
+	}
+}
+
+// Return the topics whose name starts with the prefix, sorted by name
+func (b *Broker) ListTopics(prefix string) []TopicInfo {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	out := make([]TopicInfo, 0)
+	for name, t := range b.topics {
+		if !strings.HasPrefix(name, prefix) {
+			continue
+		}
+		ti := TopicInfo{Name: name, Last: t.last, Subscribers: len(t.subs)}
+		if len(t.backlog) > 0 {
+			ti.First = t.backlog[0].Seq
+		}
+		out = append(out, ti)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out
+}
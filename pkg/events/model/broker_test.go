@@ -0,0 +1,162 @@
+// Copyright (C) 2018-2020 Hegemonie's AUTHORS
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package events
+
+import (
+	"testing"
+	"time"
+)
+
+func publishN(t *testing.T, b *Broker, topic string, nb int) {
+	for i := 0; i < nb; i++ {
+		if _, err := b.Publish(topic, "test", nil, time.Now()); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func expectSeq(t *testing.T, s *Subscription, seqs ...uint64) {
+	for _, seq := range seqs {
+		evt, ok := <-s.Events
+		if !ok {
+			t.Fatal("closed", s.Err())
+		}
+		if evt.Seq != seq {
+			t.Fatal("expected", seq, "got", evt.Seq)
+		}
+	}
+}
+
+func TestTopics(t *testing.T) {
+	for _, tc := range []struct {
+		topic string
+		ok    bool
+	}{
+		{TopicRegion("r"), true},
+		{TopicCity("r", 1), true},
+		{TopicCharacter(1), true},
+		{"region/", false},
+		{"plop", false},
+		{"", false},
+	} {
+		if ValidTopic(tc.topic) != tc.ok {
+			t.Fatal(tc.topic)
+		}
+	}
+
+	b := NewBroker(4, 4)
+	if _, err := b.Publish("plop", "test", nil, time.Now()); err != ErrInvalidTopic {
+		t.Fatal(err)
+	}
+	publishN(t, b, TopicCity("r", 1), 1)
+	publishN(t, b, TopicCity("r", 2), 2)
+	publishN(t, b, TopicRegion("r"), 1)
+	if l := b.ListTopics(TopicPrefixCity); len(l) != 2 || l[1].Last != 2 {
+		t.Fatal(l)
+	}
+}
+
+func TestSubscribeResume(t *testing.T) {
+	topic := TopicRegion("r")
+	for _, tc := range []struct {
+		name      string
+		published int
+		after     uint64
+		expected  []uint64
+		err       error
+	}{
+		{"empty", 0, 0, nil, nil},
+		{"backlog", 3, 0, []uint64{1, 2, 3}, nil},
+		{"resume", 3, 1, []uint64{2, 3}, nil},
+		{"uptodate", 3, 3, nil, nil},
+		{"rotated", 6, 0, []uint64{3, 4, 5, 6}, nil},
+		{"resume-rotated", 6, 2, []uint64{3, 4, 5, 6}, nil},
+		{"truncated", 6, 1, nil, ErrTruncated},
+		{"future", 3, 4, nil, ErrTruncated},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			b := NewBroker(4, 4)
+			publishN(t, b, topic, tc.published)
+			s, err := b.Subscribe(topic, tc.after)
+			if err != tc.err {
+				t.Fatal("unexpected", err)
+			}
+			if err != nil {
+				return
+			}
+			defer s.Close()
+			expectSeq(t, s, tc.expected...)
+
+			// Then the live events follow
+			publishN(t, b, topic, 1)
+			expectSeq(t, s, uint64(tc.published+1))
+		})
+	}
+}
+
+func TestSubscribeLagging(t *testing.T) {
+	topic := TopicCharacter(1)
+	b := NewBroker(8, 2)
+	fast, _ := b.Subscribe(topic, 0)
+	slow, _ := b.Subscribe(topic, 0)
+
+	publishN(t, b, topic, 2)
+	expectSeq(t, fast, 1, 2)
+	publishN(t, b, topic, 1)
+	expectSeq(t, fast, 3)
+
+	// The slow subscriber got its buffer full at the 3rd event
+	expectSeq(t, slow, 1, 2)
+	if _, ok := <-slow.Events; ok || slow.Err() != ErrLagging {
+		t.Fatal("not dropped", slow.Err())
+	}
+
+	// It resumes where it stopped
+	slow, err := b.Subscribe(topic, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectSeq(t, slow, 3)
+
+	fast.Close()
+	fast.Close()
+	if _, ok := <-fast.Events; ok || fast.Err() != nil {
+		t.Fatal("not closed")
+	}
+	if l := b.ListTopics(""); len(l) != 1 || l[0].Subscribers != 1 {
+		t.Fatal(l)
+	}
+}
+
+func TestTopicsDropped(t *testing.T) {
+	b := NewBroker(4, 4)
+
+	// A topic without any event lives as long as its subscribers
+	s0, err := b.Subscribe(TopicCharacter(1), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s1, _ := b.Subscribe(TopicCharacter(1), 0)
+	if _, err = b.Subscribe(TopicCharacter(2), 5); err != ErrTruncated {
+		t.Fatal(err)
+	}
+	if l := b.ListTopics(""); len(l) != 1 || l[0].Subscribers != 2 {
+		t.Fatal(l)
+	}
+	s0.Close()
+	s1.Close()
+	if l := b.ListTopics(""); len(l) != 0 {
+		t.Fatal("empty topic kept", l)
+	}
+
+	// A topic with events is kept for the subscribers to come
+	s0, _ = b.Subscribe(TopicCharacter(1), 0)
+	publishN(t, b, TopicCharacter(1), 1)
+	s0.Close()
+	if l := b.ListTopics(""); len(l) != 1 || l[0].Last != 1 {
+		t.Fatal("topic dropped", l)
+	}
+}
@@ -0,0 +1,214 @@
+// Copyright (C) 2018-2020 Hegemonie's AUTHORS
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package events
+
+import (
+	"encoding/json"
+	"io"
+	"sort"
+	"sync"
+	"time"
+)
+
+type Report struct {
+	Id        uint64
+	Character uint64
+	Region    string `json:",omitempty"`
+	City      uint64 `json:",omitempty"`
+	When      time.Time
+	Kind      string
+	Payload   []byte `json:",omitempty"`
+	Read      bool   `json:",omitempty"`
+}
+
+// Select the reports of a Character
+type ReportFilter struct {
+	// Only the reports about the Region, or about the City in the Region
+	Region string
+	City   uint64
+	// Only the unread reports
+	Unread bool
+}
+
+// How long the reports are kept. Zero disables the limit.
+type Retention struct {
+	MaxAge          time.Duration
+	MaxPerCharacter int
+}
+
+// The reports of each Character, sorted by increasing Id, i.e. from the
+// oldest to the newest.
+type Inbox struct {
+	NextId  uint64
+	Reports map[uint64][]*Report
+
+	Retention Retention `json:"-"`
+
+	lock  sync.Mutex
+	dirty bool
+}
+
+func NewInbox(r Retention) *Inbox {
+	return &Inbox{NextId: 1, Reports: make(map[uint64][]*Report), Retention: r}
+}
+
+func (f *ReportFilter) match(r *Report) bool {
+	if f.Unread && r.Read {
+		return false
+	}
+	if f.Region != "" && f.Region != r.Region {
+		return false
+	}
+	return f.City == 0 || f.City == r.City
+}
+
+// File the report in the inbox of its Character and return its Id. The
+// oldest reports beyond the limit of the Character are dropped.
+func (in *Inbox) Deliver(r Report) uint64 {
+	in.lock.Lock()
+	defer in.lock.Unlock()
+
+	r.Id = in.NextId
+	in.NextId++
+	r.Read = false
+	tab := append(in.Reports[r.Character], &r)
+	if max := in.Retention.MaxPerCharacter; max > 0 && len(tab) > max {
+		tab = append(tab[:0], tab[len(tab)-max:]...)
+	}
+	in.Reports[r.Character] = tab
+	in.dirty = true
+	return r.Id
+}
+
+// Return at most 'max' reports of the Character matching the filter, from the
+// newest to the oldest, starting before the marker (zero for the newest).
+// Also return the number of unread reports matching the filter.
+func (in *Inbox) List(character uint64, f ReportFilter, marker uint64, max int) ([]Report, int) {
+	in.lock.Lock()
+	defer in.lock.Unlock()
+
+	out := make([]Report, 0)
+	unread := 0
+	tab := in.Reports[character]
+	for i := len(tab) - 1; i >= 0; i-- {
+		r := tab[i]
+		if !f.match(r) {
+			continue
+		}
+		if !r.Read {
+			unread++
+		}
+		if (marker == 0 || r.Id < marker) && (max <= 0 || len(out) < max) {
+			out = append(out, *r)
+		}
+	}
+	return out, unread
+}
+
+// Mark as read the given reports of the Character, or all of them when no
+// report is given. Return how many reports changed.
+func (in *Inbox) MarkRead(character uint64, ids ...uint64) int {
+	in.lock.Lock()
+	defer in.lock.Unlock()
+
+	tab := in.Reports[character]
+	mark := func(r *Report) int {
+		if r.Read {
+			return 0
+		}
+		r.Read = true
+		return 1
+	}
+
+	nb := 0
+	if len(ids) <= 0 {
+		for _, r := range tab {
+			nb += mark(r)
+		}
+	} else {
+		for _, id := range ids {
+			i := sort.Search(len(tab), func(i int) bool { return tab[i].Id >= id })
+			if i < len(tab) && tab[i].Id == id {
+				nb += mark(tab[i])
+			}
+		}
+	}
+	if nb > 0 {
+		in.dirty = true
+	}
+	return nb
+}
+
+// Drop the reports older than the retention period. Return how many were
+// dropped.
+func (in *Inbox) Expire(now time.Time) int {
+	if in.Retention.MaxAge <= 0 {
+		return 0
+	}
+
+	in.lock.Lock()
+	defer in.lock.Unlock()
+
+	limit := now.Add(-in.Retention.MaxAge)
+	nb := 0
+	for c, tab := range in.Reports {
+		i := sort.Search(len(tab), func(i int) bool { return tab[i].When.After(limit) })
+		if i <= 0 {
+			continue
+		}
+		nb += i
+		if i >= len(tab) {
+			delete(in.Reports, c)
+		} else {
+			in.Reports[c] = append(tab[:0], tab[i:]...)
+		}
+	}
+	if nb > 0 {
+		in.dirty = true
+	}
+	return nb
+}
+
+// Tell if the Inbox changed since the last call to Save
+func (in *Inbox) Dirty() bool {
+	in.lock.Lock()
+	defer in.lock.Unlock()
+	return in.dirty
+}
+
+func (in *Inbox) Save(out io.Writer) error {
+	in.lock.Lock()
+	defer in.lock.Unlock()
+
+	if err := json.NewEncoder(out).Encode(in); err != nil {
+		return err
+	}
+	in.dirty = false
+	return nil
+}
+
+func (in *Inbox) Load(src io.Reader) error {
+	in.lock.Lock()
+	defer in.lock.Unlock()
+
+	if err := json.NewDecoder(src).Decode(in); err != nil {
+		return err
+	}
+	if in.Reports == nil {
+		in.Reports = make(map[uint64][]*Report)
+	}
+	if in.NextId == 0 {
+		in.NextId = 1
+	}
+	for _, tab := range in.Reports {
+		sort.Slice(tab, func(i, j int) bool { return tab[i].Id < tab[j].Id })
+		if len(tab) > 0 && tab[len(tab)-1].Id >= in.NextId {
+			in.NextId = tab[len(tab)-1].Id + 1
+		}
+	}
+	in.dirty = false
+	return nil
+}
@@ -0,0 +1,129 @@
+// Copyright (C) 2018-2020 Hegemonie's AUTHORS
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package events
+
+import (
+	"bytes"
+	"testing"
+	"time"
+)
+
+func ids(reports []Report) []uint64 {
+	out := make([]uint64, 0, len(reports))
+	for _, r := range reports {
+		out = append(out, r.Id)
+	}
+	return out
+}
+
+func sameIds(a, b []uint64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestInboxList(t *testing.T) {
+	in := NewInbox(Retention{})
+	now := time.Now()
+	// Ids 1 to 6 for the Character 1, alternating two cities, then a report
+	// for another Character.
+	for i := 0; i < 6; i++ {
+		in.Deliver(Report{Character: 1, Region: "r", City: uint64(1 + i%2), When: now, Kind: "k"})
+	}
+	in.Deliver(Report{Character: 2, Region: "r", City: 1, When: now, Kind: "k"})
+	in.MarkRead(1, 2, 5, 42)
+
+	for _, tc := range []struct {
+		filter   ReportFilter
+		marker   uint64
+		max      int
+		expected []uint64
+		unread   int
+	}{
+		{ReportFilter{}, 0, 0, []uint64{6, 5, 4, 3, 2, 1}, 4},
+		{ReportFilter{}, 0, 2, []uint64{6, 5}, 4},
+		{ReportFilter{}, 5, 2, []uint64{4, 3}, 4},
+		{ReportFilter{}, 1, 2, []uint64{}, 4},
+		{ReportFilter{Unread: true}, 0, 0, []uint64{6, 4, 3, 1}, 4},
+		{ReportFilter{Region: "r", City: 1}, 0, 0, []uint64{5, 3, 1}, 2},
+		{ReportFilter{Region: "other"}, 0, 0, []uint64{}, 0},
+	} {
+		got, unread := in.List(1, tc.filter, tc.marker, tc.max)
+		if !sameIds(ids(got), tc.expected) || unread != tc.unread {
+			t.Fatal(tc.filter, tc.marker, tc.max, "expected", tc.expected, tc.unread, "got", ids(got), unread)
+		}
+	}
+
+	if nb := in.MarkRead(1); nb != 4 {
+		t.Fatal("expected 4 marked, got", nb)
+	}
+	if _, unread := in.List(1, ReportFilter{}, 0, 0); unread != 0 {
+		t.Fatal(unread)
+	}
+	if _, unread := in.List(2, ReportFilter{}, 0, 0); unread != 1 {
+		t.Fatal(unread)
+	}
+}
+
+func TestInboxRetention(t *testing.T) {
+	in := NewInbox(Retention{MaxAge: time.Hour, MaxPerCharacter: 3})
+	now := time.Now()
+	for i := 0; i < 5; i++ {
+		in.Deliver(Report{Character: 1, When: now.Add(time.Duration(i-5) * time.Hour / 2)})
+	}
+	in.Deliver(Report{Character: 2, When: now.Add(-2 * time.Hour)})
+
+	if got, _ := in.List(1, ReportFilter{}, 0, 0); !sameIds(ids(got), []uint64{5, 4, 3}) {
+		t.Fatal(ids(got))
+	}
+	// Ids 3 and 4 were delivered 1h and 1h30 ago, 5 only 30 minutes ago.
+	// Character 2 has no more report.
+	if nb := in.Expire(now); nb != 3 {
+		t.Fatal("expected 3 expired, got", nb)
+	}
+	if got, _ := in.List(1, ReportFilter{}, 0, 0); !sameIds(ids(got), []uint64{5}) {
+		t.Fatal(ids(got))
+	}
+	if _, ok := in.Reports[2]; ok {
+		t.Fatal("empty inbox kept")
+	}
+}
+
+func TestInboxPersistence(t *testing.T) {
+	in := NewInbox(Retention{})
+	in.Deliver(Report{Character: 1, Kind: "a", Payload: []byte("{}")})
+	in.Deliver(Report{Character: 1, Kind: "b"})
+	in.MarkRead(1, 1)
+	if !in.Dirty() {
+		t.Fatal("not dirty")
+	}
+
+	var buf bytes.Buffer
+	if err := in.Save(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if in.Dirty() {
+		t.Fatal("dirty after save")
+	}
+
+	loaded := NewInbox(Retention{})
+	if err := loaded.Load(&buf); err != nil {
+		t.Fatal(err)
+	}
+	got, unread := loaded.List(1, ReportFilter{}, 0, 0)
+	if !sameIds(ids(got), []uint64{2, 1}) || unread != 1 || string(got[1].Payload) != "{}" {
+		t.Fatal(got, unread)
+	}
+	if id := loaded.Deliver(Report{Character: 1}); id != 3 {
+		t.Fatal("id reused", id)
+	}
+}
@@ -0,0 +1,324 @@
+// Copyright (C) 2018-2020 Hegemonie's AUTHORS
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package events
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"path"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+)
+
+const (
+	// POST the event as a JSON object, with the rendered text
+	SinkWebhook = "webhook"
+	// POST a Slack-compatible message, also understood by Mattermost,
+	// RocketChat and the Slack-compatible endpoints of Discord
+	SinkSlack = "slack"
+)
+
+const defaultTemplate = "[{{.Topic}}] {{.Kind}} {{.Text}}"
+
+// How to forward a selection of the events to an external service
+type SinkConfig struct {
+	Name string
+	Kind string
+	URL  string
+
+	// Prefixes of the topics forwarded, all the topics if empty
+	Topics []string `json:",omitempty"`
+	// Patterns (see path.Match) of the kinds of events forwarded, all the
+	// kinds if empty
+	Kinds []string `json:",omitempty"`
+
+	// text/template rendering the message body, applied to a SinkMessage
+	Template string `json:",omitempty"`
+
+	// Number of attempts after the first failure, before the event goes to the
+	// dead-letter file
+	Retries int `json:",omitempty"`
+	// Delay before the first retry, doubled at each attempt, e.g. "1s"
+	Backoff string `json:",omitempty"`
+	// Maximum delay between two attempts, e.g. "1m"
+	MaxBackoff string `json:",omitempty"`
+	// Number of events waiting for their delivery, beyond which the new
+	// events go straight to the dead-letter file
+	Queue int `json:",omitempty"`
+}
+
+// What the template of a sink is applied to
+type SinkMessage struct {
+	Topic string
+	Seq   uint64
+	When  time.Time
+	Kind  string
+	// The payload as a string
+	Text string
+	// The payload decoded from JSON, nil if it is not JSON
+	Payload interface{}
+}
+
+// An event that could not be delivered, as written in the dead-letter file
+type DeadLetter struct {
+	Sink  string
+	At    time.Time
+	Error string
+	Event Event
+}
+
+// Forward the events to the sinks whose filters match them. Each sink has its
+// own queue and delivers its events in order.
+type Dispatcher struct {
+	sinks []*sink
+
+	lock sync.Mutex
+	dead io.WriteCloser
+}
+
+type sink struct {
+	cfg        SinkConfig
+	tpl        *template.Template
+	backoff    time.Duration
+	maxBackoff time.Duration
+	queue      chan Event
+	done       chan struct{}
+	client     *http.Client
+	dispatcher *Dispatcher
+}
+
+// Load the configuration of the sinks from a JSON array
+func LoadSinkConfigs(in io.Reader) ([]SinkConfig, error) {
+	var cfgs []SinkConfig
+	if err := json.NewDecoder(in).Decode(&cfgs); err != nil {
+		return nil, err
+	}
+	return cfgs, nil
+}
+
+func parseDuration(s string, dflt time.Duration) (time.Duration, error) {
+	if s == "" {
+		return dflt, nil
+	}
+	return time.ParseDuration(s)
+}
+
+func newSink(cfg SinkConfig, d *Dispatcher) (*sink, error) {
+	if cfg.Name == "" {
+		return nil, errors.New("Missing name")
+	}
+	switch cfg.Kind {
+	case SinkWebhook, SinkSlack:
+	default:
+		return nil, errors.New(fmt.Sprintf("Unknown kind of sink [%s]", cfg.Kind))
+	}
+	if !strings.HasPrefix(cfg.URL, "http://") && !strings.HasPrefix(cfg.URL, "https://") {
+		return nil, errors.New("Invalid URL")
+	}
+	for _, k := range cfg.Kinds {
+		if _, err := path.Match(k, ""); err != nil {
+			return nil, errors.New(fmt.Sprintf("Invalid kind pattern [%s]", k))
+		}
+	}
+
+	s := &sink{cfg: cfg, dispatcher: d, done: make(chan struct{})}
+	var err error
+	if s.backoff, err = parseDuration(cfg.Backoff, time.Second); err != nil {
+		return nil, err
+	}
+	if s.maxBackoff, err = parseDuration(cfg.MaxBackoff, time.Minute); err != nil {
+		return nil, err
+	}
+	tpl := cfg.Template
+	if tpl == "" {
+		tpl = defaultTemplate
+	}
+	if s.tpl, err = template.New(cfg.Name).Parse(tpl); err != nil {
+		return nil, err
+	}
+	if cfg.Queue <= 0 {
+		cfg.Queue = 1024
+	}
+	s.queue = make(chan Event, cfg.Queue)
+	s.client = &http.Client{Timeout: 10 * time.Second}
+	return s, nil
+}
+
+// Start a goroutine per sink. The events that cannot be delivered are
+// appended, as JSON lines, to the dead-letter writer (if not nil).
+func NewDispatcher(cfgs []SinkConfig, dead io.WriteCloser) (*Dispatcher, error) {
+	d := &Dispatcher{dead: dead}
+	names := make(map[string]bool)
+	for _, cfg := range cfgs {
+		if names[cfg.Name] {
+			return nil, errors.New(fmt.Sprintf("Duplicated sink [%s]", cfg.Name))
+		}
+		names[cfg.Name] = true
+		s, err := newSink(cfg, d)
+		if err != nil {
+			return nil, errors.New(fmt.Sprintf("Sink [%s]: %s", cfg.Name, err.Error()))
+		}
+		d.sinks = append(d.sinks, s)
+	}
+	for _, s := range d.sinks {
+		go s.run()
+	}
+	return d, nil
+}
+
+// Open the dead-letter file in append mode
+func OpenDeadLetters(p string) (io.WriteCloser, error) {
+	return os.OpenFile(p, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+}
+
+// Queue the event in each sink that accepts it. Never blocks.
+func (d *Dispatcher) Forward(evt Event) {
+	for _, s := range d.sinks {
+		if !s.accept(evt) {
+			continue
+		}
+		select {
+		case s.queue <- evt:
+		default:
+			d.bury(s.cfg.Name, evt, errors.New("Queue full"))
+		}
+	}
+}
+
+// Deliver the queued events then stop the sinks
+func (d *Dispatcher) Close() {
+	for _, s := range d.sinks {
+		close(s.queue)
+	}
+	for _, s := range d.sinks {
+		<-s.done
+	}
+	if d.dead != nil {
+		d.dead.Close()
+	}
+}
+
+func (d *Dispatcher) bury(name string, evt Event, err error) {
+	log.Printf("Sink [%s]: event %s #%d dropped: %s", name, evt.Topic, evt.Seq, err.Error())
+	if d.dead == nil {
+		return
+	}
+	encoded, _ := json.Marshal(DeadLetter{Sink: name, At: time.Now(), Error: err.Error(), Event: evt})
+
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	if _, err = d.dead.Write(append(encoded, '\n')); err != nil {
+		log.Printf("Dead-letter error: %s", err.Error())
+	}
+}
+
+func (s *sink) accept(evt Event) bool {
+	if len(s.cfg.Topics) > 0 {
+		ok := false
+		for _, prefix := range s.cfg.Topics {
+			if strings.HasPrefix(evt.Topic, prefix) {
+				ok = true
+				break
+			}
+		}
+		if !ok {
+			return false
+		}
+	}
+	if len(s.cfg.Kinds) > 0 {
+		for _, pattern := range s.cfg.Kinds {
+			if ok, _ := path.Match(pattern, evt.Kind); ok {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+func (s *sink) run() {
+	defer close(s.done)
+	for evt := range s.queue {
+		body, err := s.render(evt)
+		if err == nil {
+			err = s.deliver(body)
+		}
+		if err != nil {
+			s.dispatcher.bury(s.cfg.Name, evt, err)
+		}
+	}
+}
+
+// Build the body of the HTTP request for the event
+func (s *sink) render(evt Event) ([]byte, error) {
+	msg := SinkMessage{Topic: evt.Topic, Seq: evt.Seq, When: evt.When, Kind: evt.Kind, Text: string(evt.Payload)}
+	if len(evt.Payload) > 0 {
+		if err := json.Unmarshal(evt.Payload, &msg.Payload); err != nil {
+			msg.Payload = nil
+		}
+	}
+
+	var text bytes.Buffer
+	if err := s.tpl.Execute(&text, msg); err != nil {
+		return nil, err
+	}
+
+	switch s.cfg.Kind {
+	case SinkSlack:
+		return json.Marshal(struct {
+			Text string `json:"text"`
+		}{text.String()})
+	default:
+		return json.Marshal(struct {
+			Topic   string      `json:"topic"`
+			Seq     uint64      `json:"seq"`
+			When    time.Time   `json:"when"`
+			Kind    string      `json:"kind"`
+			Payload interface{} `json:"payload,omitempty"`
+			Text    string      `json:"text"`
+		}{evt.Topic, evt.Seq, evt.When, evt.Kind, msg.Payload, text.String()})
+	}
+}
+
+// POST the body, retrying with an exponential backoff on failure
+func (s *sink) deliver(body []byte) error {
+	delay := s.backoff
+	var err error
+	for attempt := 0; ; attempt++ {
+		if err = s.post(body); err == nil {
+			return nil
+		}
+		if attempt >= s.cfg.Retries {
+			return err
+		}
+		time.Sleep(delay)
+		if delay *= 2; delay > s.maxBackoff {
+			delay = s.maxBackoff
+		}
+	}
+}
+
+func (s *sink) post(body []byte) error {
+	rep, err := s.client.Post(s.cfg.URL, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer rep.Body.Close()
+	io.Copy(ioutil.Discard, rep.Body)
+	if rep.StatusCode < 200 || rep.StatusCode >= 300 {
+		return errors.New(fmt.Sprintf("HTTP status %d", rep.StatusCode))
+	}
+	return nil
+}
@@ -0,0 +1,176 @@
+// Copyright (C) 2018-2020 Hegemonie's AUTHORS
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package events
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// A local stand-in for the webhook endpoints, failing the first requests
+type standIn struct {
+	sync.Mutex
+	failures int
+	bodies   []string
+}
+
+func (s *standIn) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := ioutil.ReadAll(r.Body)
+	s.Lock()
+	defer s.Unlock()
+	if s.failures > 0 {
+		s.failures--
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+	s.bodies = append(s.bodies, string(body))
+}
+
+func (s *standIn) received() []string {
+	s.Lock()
+	defer s.Unlock()
+	return append([]string{}, s.bodies...)
+}
+
+type deadBuffer struct {
+	bytes.Buffer
+}
+
+func (b *deadBuffer) Close() error { return nil }
+
+func TestSinkConfig(t *testing.T) {
+	for _, tc := range []struct {
+		cfg SinkConfig
+		ok  bool
+	}{
+		{SinkConfig{Name: "a", Kind: SinkWebhook, URL: "http://localhost/"}, true},
+		{SinkConfig{Name: "a", Kind: SinkSlack, URL: "https://localhost/"}, true},
+		{SinkConfig{Kind: SinkWebhook, URL: "http://localhost/"}, false},
+		{SinkConfig{Name: "a", Kind: "irc", URL: "http://localhost/"}, false},
+		{SinkConfig{Name: "a", Kind: SinkWebhook, URL: "localhost"}, false},
+		{SinkConfig{Name: "a", Kind: SinkWebhook, URL: "http://localhost/", Kinds: []string{"["}}, false},
+		{SinkConfig{Name: "a", Kind: SinkWebhook, URL: "http://localhost/", Template: "{{.Nope"}, false},
+		{SinkConfig{Name: "a", Kind: SinkWebhook, URL: "http://localhost/", Backoff: "soon"}, false},
+	} {
+		d, err := NewDispatcher([]SinkConfig{tc.cfg}, nil)
+		if (err == nil) != tc.ok {
+			t.Fatal(tc.cfg, err)
+		}
+		if d != nil {
+			d.Close()
+		}
+	}
+
+	cfgs, err := LoadSinkConfigs(strings.NewReader(`[{"Name": "a", "Kind": "slack", "Kinds": ["city.*"]}]`))
+	if err != nil || len(cfgs) != 1 || cfgs[0].Kinds[0] != "city.*" {
+		t.Fatal(cfgs, err)
+	}
+}
+
+func TestSinkFilters(t *testing.T) {
+	srv := &standIn{}
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	d, err := NewDispatcher([]SinkConfig{{
+		Name: "s", Kind: SinkSlack, URL: ts.URL,
+		Topics:   []string{"region/", "city/r/1"},
+		Kinds:    []string{"city.*", "fight.won"},
+		Template: "{{.Kind}} by {{.Payload.Other}}",
+	}}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, evt := range []Event{
+		{Topic: "region/r", Kind: "city.conquered", Payload: []byte(`{"Other": 7}`)},
+		{Topic: "region/r", Kind: "unit.trained"},
+		{Topic: "character/1", Kind: "city.conquered"},
+		{Topic: "city/r/1", Kind: "fight.won", Payload: []byte(`{"Other": 8}`)},
+	} {
+		d.Forward(evt)
+	}
+	d.Close()
+
+	got := srv.received()
+	expected := []string{`{"text":"city.conquered by 7"}`, `{"text":"fight.won by 8"}`}
+	if len(got) != len(expected) {
+		t.Fatal(got)
+	}
+	for i := range expected {
+		if got[i] != expected[i] {
+			t.Fatal("expected", expected[i], "got", got[i])
+		}
+	}
+}
+
+func TestSinkRetry(t *testing.T) {
+	srv := &standIn{failures: 2}
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	dead := &deadBuffer{}
+	d, err := NewDispatcher([]SinkConfig{
+		{Name: "ok", Kind: SinkWebhook, URL: ts.URL, Retries: 2, Backoff: "1ms"},
+	}, dead)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d.Forward(Event{Topic: "region/r", Seq: 3, When: time.Now(), Kind: "k", Payload: []byte(`{"a":1}`)})
+	d.Close()
+
+	got := srv.received()
+	if len(got) != 1 || dead.Len() != 0 {
+		t.Fatal(got, dead.String())
+	}
+	var body map[string]interface{}
+	if err = json.Unmarshal([]byte(got[0]), &body); err != nil {
+		t.Fatal(err)
+	}
+	if body["topic"] != "region/r" || body["seq"] != 3.0 || body["payload"].(map[string]interface{})["a"] != 1.0 {
+		t.Fatal(body)
+	}
+}
+
+func TestSinkDeadLetter(t *testing.T) {
+	srv := &standIn{failures: 3}
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	dead := &deadBuffer{}
+	d, err := NewDispatcher([]SinkConfig{
+		{Name: "ko", Kind: SinkWebhook, URL: ts.URL, Retries: 1, Backoff: "1ms"},
+	}, dead)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d.Forward(Event{Topic: "region/r", Seq: 1, Kind: "k"})
+	d.Forward(Event{Topic: "region/r", Seq: 2, Kind: "k"})
+	d.Close()
+
+	// 2 attempts for the first event, then the 3rd failure and a success for
+	// the second one.
+	if got := srv.received(); len(got) != 1 {
+		t.Fatal(got)
+	}
+	lines := strings.Split(strings.TrimSpace(dead.String()), "\n")
+	if len(lines) != 1 {
+		t.Fatal(lines)
+	}
+	var dl DeadLetter
+	if err = json.Unmarshal([]byte(lines[0]), &dl); err != nil {
+		t.Fatal(err)
+	}
+	if dl.Sink != "ko" || dl.Event.Seq != 1 || dl.Error != "HTTP status 503" {
+		t.Fatal(dl)
+	}
+}
@@ -0,0 +1,1220 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: events.proto
+
+package hegemonie_events_proto
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+// An event, as delivered to the subscribers of its topic
+type Event struct {
+	Topic string `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	// Sequence number of the event in its topic, assigned by the broker.
+	// Strictly increasing, starting at 1.
+	Seq uint64 `protobuf:"varint,2,opt,name=seq,proto3" json:"seq,omitempty"`
+	// UNIX timestamp in nanoseconds, assigned by the broker
+	When int64 `protobuf:"varint,3,opt,name=when,proto3" json:"when,omitempty"`
+	// The type of the event, e.g. "city.conquered"
+	Kind string `protobuf:"bytes,4,opt,name=kind,proto3" json:"kind,omitempty"`
+	// Opaque payload, whose format depends on the kind of the event
+	Payload              []byte   `protobuf:"bytes,5,opt,name=payload,proto3" json:"payload,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Event) Reset()         { *m = Event{} }
+func (m *Event) String() string { return proto.CompactTextString(m) }
+func (*Event) ProtoMessage()    {}
+func (*Event) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f22242cb04491f9, []int{0}
+}
+
+func (m *Event) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Event.Unmarshal(m, b)
+}
+func (m *Event) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Event.Marshal(b, m, deterministic)
+}
+func (m *Event) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Event.Merge(m, src)
+}
+func (m *Event) XXX_Size() int {
+	return xxx_messageInfo_Event.Size(m)
+}
+func (m *Event) XXX_DiscardUnknown() {
+	xxx_messageInfo_Event.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Event proto.InternalMessageInfo
+
+func (m *Event) GetTopic() string {
+	if m != nil {
+		return m.Topic
+	}
+	return ""
+}
+
+func (m *Event) GetSeq() uint64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+func (m *Event) GetWhen() int64 {
+	if m != nil {
+		return m.When
+	}
+	return 0
+}
+
+func (m *Event) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+func (m *Event) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+type PublishReq struct {
+	Topic                string   `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	Kind                 string   `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Payload              []byte   `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PublishReq) Reset()         { *m = PublishReq{} }
+func (m *PublishReq) String() string { return proto.CompactTextString(m) }
+func (*PublishReq) ProtoMessage()    {}
+func (*PublishReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f22242cb04491f9, []int{1}
+}
+
+func (m *PublishReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PublishReq.Unmarshal(m, b)
+}
+func (m *PublishReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PublishReq.Marshal(b, m, deterministic)
+}
+func (m *PublishReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PublishReq.Merge(m, src)
+}
+func (m *PublishReq) XXX_Size() int {
+	return xxx_messageInfo_PublishReq.Size(m)
+}
+func (m *PublishReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_PublishReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PublishReq proto.InternalMessageInfo
+
+func (m *PublishReq) GetTopic() string {
+	if m != nil {
+		return m.Topic
+	}
+	return ""
+}
+
+func (m *PublishReq) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+func (m *PublishReq) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+type PublishRep struct {
+	Seq                  uint64   `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PublishRep) Reset()         { *m = PublishRep{} }
+func (m *PublishRep) String() string { return proto.CompactTextString(m) }
+func (*PublishRep) ProtoMessage()    {}
+func (*PublishRep) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f22242cb04491f9, []int{2}
+}
+
+func (m *PublishRep) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PublishRep.Unmarshal(m, b)
+}
+func (m *PublishRep) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PublishRep.Marshal(b, m, deterministic)
+}
+func (m *PublishRep) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PublishRep.Merge(m, src)
+}
+func (m *PublishRep) XXX_Size() int {
+	return xxx_messageInfo_PublishRep.Size(m)
+}
+func (m *PublishRep) XXX_DiscardUnknown() {
+	xxx_messageInfo_PublishRep.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PublishRep proto.InternalMessageInfo
+
+func (m *PublishRep) GetSeq() uint64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+type SubscribeReq struct {
+	Topic string `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	// Sequence number of the last event already received. The events after it
+	// still in the backlog are delivered first. Zero to get the whole backlog.
+	After                uint64   `protobuf:"varint,2,opt,name=after,proto3" json:"after,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SubscribeReq) Reset()         { *m = SubscribeReq{} }
+func (m *SubscribeReq) String() string { return proto.CompactTextString(m) }
+func (*SubscribeReq) ProtoMessage()    {}
+func (*SubscribeReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f22242cb04491f9, []int{3}
+}
+
+func (m *SubscribeReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubscribeReq.Unmarshal(m, b)
+}
+func (m *SubscribeReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubscribeReq.Marshal(b, m, deterministic)
+}
+func (m *SubscribeReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeReq.Merge(m, src)
+}
+func (m *SubscribeReq) XXX_Size() int {
+	return xxx_messageInfo_SubscribeReq.Size(m)
+}
+func (m *SubscribeReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeReq proto.InternalMessageInfo
+
+func (m *SubscribeReq) GetTopic() string {
+	if m != nil {
+		return m.Topic
+	}
+	return ""
+}
+
+func (m *SubscribeReq) GetAfter() uint64 {
+	if m != nil {
+		return m.After
+	}
+	return 0
+}
+
+type ListTopicsReq struct {
+	// Only list the topics starting with the prefix
+	Prefix               string   `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListTopicsReq) Reset()         { *m = ListTopicsReq{} }
+func (m *ListTopicsReq) String() string { return proto.CompactTextString(m) }
+func (*ListTopicsReq) ProtoMessage()    {}
+func (*ListTopicsReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f22242cb04491f9, []int{4}
+}
+
+func (m *ListTopicsReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListTopicsReq.Unmarshal(m, b)
+}
+func (m *ListTopicsReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListTopicsReq.Marshal(b, m, deterministic)
+}
+func (m *ListTopicsReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListTopicsReq.Merge(m, src)
+}
+func (m *ListTopicsReq) XXX_Size() int {
+	return xxx_messageInfo_ListTopicsReq.Size(m)
+}
+func (m *ListTopicsReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListTopicsReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListTopicsReq proto.InternalMessageInfo
+
+func (m *ListTopicsReq) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
+}
+
+type TopicView struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Sequence numbers of the oldest and the newest events in the backlog
+	First                uint64   `protobuf:"varint,2,opt,name=first,proto3" json:"first,omitempty"`
+	Last                 uint64   `protobuf:"varint,3,opt,name=last,proto3" json:"last,omitempty"`
+	Subscribers          uint32   `protobuf:"varint,4,opt,name=subscribers,proto3" json:"subscribers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TopicView) Reset()         { *m = TopicView{} }
+func (m *TopicView) String() string { return proto.CompactTextString(m) }
+func (*TopicView) ProtoMessage()    {}
+func (*TopicView) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f22242cb04491f9, []int{5}
+}
+
+func (m *TopicView) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TopicView.Unmarshal(m, b)
+}
+func (m *TopicView) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TopicView.Marshal(b, m, deterministic)
+}
+func (m *TopicView) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopicView.Merge(m, src)
+}
+func (m *TopicView) XXX_Size() int {
+	return xxx_messageInfo_TopicView.Size(m)
+}
+func (m *TopicView) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopicView.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TopicView proto.InternalMessageInfo
+
+func (m *TopicView) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *TopicView) GetFirst() uint64 {
+	if m != nil {
+		return m.First
+	}
+	return 0
+}
+
+func (m *TopicView) GetLast() uint64 {
+	if m != nil {
+		return m.Last
+	}
+	return 0
+}
+
+func (m *TopicView) GetSubscribers() uint32 {
+	if m != nil {
+		return m.Subscribers
+	}
+	return 0
+}
+
+type ListOfTopics struct {
+	Items                []*TopicView `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ListOfTopics) Reset()         { *m = ListOfTopics{} }
+func (m *ListOfTopics) String() string { return proto.CompactTextString(m) }
+func (*ListOfTopics) ProtoMessage()    {}
+func (*ListOfTopics) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f22242cb04491f9, []int{6}
+}
+
+func (m *ListOfTopics) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListOfTopics.Unmarshal(m, b)
+}
+func (m *ListOfTopics) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListOfTopics.Marshal(b, m, deterministic)
+}
+func (m *ListOfTopics) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListOfTopics.Merge(m, src)
+}
+func (m *ListOfTopics) XXX_Size() int {
+	return xxx_messageInfo_ListOfTopics.Size(m)
+}
+func (m *ListOfTopics) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListOfTopics.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListOfTopics proto.InternalMessageInfo
+
+func (m *ListOfTopics) GetItems() []*TopicView {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+// A report kept in the inbox of a Character
+type Report struct {
+	Id        uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Character uint64 `protobuf:"varint,2,opt,name=character,proto3" json:"character,omitempty"`
+	// The Region and the City the report is about, if any
+	Region string `protobuf:"bytes,3,opt,name=region,proto3" json:"region,omitempty"`
+	City   uint64 `protobuf:"varint,4,opt,name=city,proto3" json:"city,omitempty"`
+	// UNIX timestamp in nanoseconds, assigned at the delivery
+	When                 int64    `protobuf:"varint,5,opt,name=when,proto3" json:"when,omitempty"`
+	Kind                 string   `protobuf:"bytes,6,opt,name=kind,proto3" json:"kind,omitempty"`
+	Payload              []byte   `protobuf:"bytes,7,opt,name=payload,proto3" json:"payload,omitempty"`
+	Read                 bool     `protobuf:"varint,8,opt,name=read,proto3" json:"read,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Report) Reset()         { *m = Report{} }
+func (m *Report) String() string { return proto.CompactTextString(m) }
+func (*Report) ProtoMessage()    {}
+func (*Report) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f22242cb04491f9, []int{7}
+}
+
+func (m *Report) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Report.Unmarshal(m, b)
+}
+func (m *Report) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Report.Marshal(b, m, deterministic)
+}
+func (m *Report) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Report.Merge(m, src)
+}
+func (m *Report) XXX_Size() int {
+	return xxx_messageInfo_Report.Size(m)
+}
+func (m *Report) XXX_DiscardUnknown() {
+	xxx_messageInfo_Report.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Report proto.InternalMessageInfo
+
+func (m *Report) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Report) GetCharacter() uint64 {
+	if m != nil {
+		return m.Character
+	}
+	return 0
+}
+
+func (m *Report) GetRegion() string {
+	if m != nil {
+		return m.Region
+	}
+	return ""
+}
+
+func (m *Report) GetCity() uint64 {
+	if m != nil {
+		return m.City
+	}
+	return 0
+}
+
+func (m *Report) GetWhen() int64 {
+	if m != nil {
+		return m.When
+	}
+	return 0
+}
+
+func (m *Report) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+func (m *Report) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (m *Report) GetRead() bool {
+	if m != nil {
+		return m.Read
+	}
+	return false
+}
+
+type DeliverReq struct {
+	Character            uint64   `protobuf:"varint,1,opt,name=character,proto3" json:"character,omitempty"`
+	Region               string   `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	City                 uint64   `protobuf:"varint,3,opt,name=city,proto3" json:"city,omitempty"`
+	Kind                 string   `protobuf:"bytes,4,opt,name=kind,proto3" json:"kind,omitempty"`
+	Payload              []byte   `protobuf:"bytes,5,opt,name=payload,proto3" json:"payload,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeliverReq) Reset()         { *m = DeliverReq{} }
+func (m *DeliverReq) String() string { return proto.CompactTextString(m) }
+func (*DeliverReq) ProtoMessage()    {}
+func (*DeliverReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f22242cb04491f9, []int{8}
+}
+
+func (m *DeliverReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeliverReq.Unmarshal(m, b)
+}
+func (m *DeliverReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeliverReq.Marshal(b, m, deterministic)
+}
+func (m *DeliverReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeliverReq.Merge(m, src)
+}
+func (m *DeliverReq) XXX_Size() int {
+	return xxx_messageInfo_DeliverReq.Size(m)
+}
+func (m *DeliverReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeliverReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeliverReq proto.InternalMessageInfo
+
+func (m *DeliverReq) GetCharacter() uint64 {
+	if m != nil {
+		return m.Character
+	}
+	return 0
+}
+
+func (m *DeliverReq) GetRegion() string {
+	if m != nil {
+		return m.Region
+	}
+	return ""
+}
+
+func (m *DeliverReq) GetCity() uint64 {
+	if m != nil {
+		return m.City
+	}
+	return 0
+}
+
+func (m *DeliverReq) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+func (m *DeliverReq) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+type DeliverRep struct {
+	Id                   uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeliverRep) Reset()         { *m = DeliverRep{} }
+func (m *DeliverRep) String() string { return proto.CompactTextString(m) }
+func (*DeliverRep) ProtoMessage()    {}
+func (*DeliverRep) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f22242cb04491f9, []int{9}
+}
+
+func (m *DeliverRep) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeliverRep.Unmarshal(m, b)
+}
+func (m *DeliverRep) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeliverRep.Marshal(b, m, deterministic)
+}
+func (m *DeliverRep) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeliverRep.Merge(m, src)
+}
+func (m *DeliverRep) XXX_Size() int {
+	return xxx_messageInfo_DeliverRep.Size(m)
+}
+func (m *DeliverRep) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeliverRep.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeliverRep proto.InternalMessageInfo
+
+func (m *DeliverRep) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// The reports are listed from the newest to the oldest. Only the reports older
+// than the marker are returned, zero to start with the newest.
+type ListReportsReq struct {
+	Character uint64 `protobuf:"varint,1,opt,name=character,proto3" json:"character,omitempty"`
+	// Only list the reports about the Region, or about the City of the Region
+	Region               string   `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	City                 uint64   `protobuf:"varint,3,opt,name=city,proto3" json:"city,omitempty"`
+	Unread               bool     `protobuf:"varint,4,opt,name=unread,proto3" json:"unread,omitempty"`
+	Marker               uint64   `protobuf:"varint,5,opt,name=marker,proto3" json:"marker,omitempty"`
+	Max                  uint32   `protobuf:"varint,6,opt,name=max,proto3" json:"max,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListReportsReq) Reset()         { *m = ListReportsReq{} }
+func (m *ListReportsReq) String() string { return proto.CompactTextString(m) }
+func (*ListReportsReq) ProtoMessage()    {}
+func (*ListReportsReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f22242cb04491f9, []int{10}
+}
+
+func (m *ListReportsReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListReportsReq.Unmarshal(m, b)
+}
+func (m *ListReportsReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListReportsReq.Marshal(b, m, deterministic)
+}
+func (m *ListReportsReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListReportsReq.Merge(m, src)
+}
+func (m *ListReportsReq) XXX_Size() int {
+	return xxx_messageInfo_ListReportsReq.Size(m)
+}
+func (m *ListReportsReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListReportsReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListReportsReq proto.InternalMessageInfo
+
+func (m *ListReportsReq) GetCharacter() uint64 {
+	if m != nil {
+		return m.Character
+	}
+	return 0
+}
+
+func (m *ListReportsReq) GetRegion() string {
+	if m != nil {
+		return m.Region
+	}
+	return ""
+}
+
+func (m *ListReportsReq) GetCity() uint64 {
+	if m != nil {
+		return m.City
+	}
+	return 0
+}
+
+func (m *ListReportsReq) GetUnread() bool {
+	if m != nil {
+		return m.Unread
+	}
+	return false
+}
+
+func (m *ListReportsReq) GetMarker() uint64 {
+	if m != nil {
+		return m.Marker
+	}
+	return 0
+}
+
+func (m *ListReportsReq) GetMax() uint32 {
+	if m != nil {
+		return m.Max
+	}
+	return 0
+}
+
+type ListOfReports struct {
+	Items []*Report `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	// Number of unread reports of the Character matching the filter
+	Unread               uint32   `protobuf:"varint,2,opt,name=unread,proto3" json:"unread,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListOfReports) Reset()         { *m = ListOfReports{} }
+func (m *ListOfReports) String() string { return proto.CompactTextString(m) }
+func (*ListOfReports) ProtoMessage()    {}
+func (*ListOfReports) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f22242cb04491f9, []int{11}
+}
+
+func (m *ListOfReports) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListOfReports.Unmarshal(m, b)
+}
+func (m *ListOfReports) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListOfReports.Marshal(b, m, deterministic)
+}
+func (m *ListOfReports) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListOfReports.Merge(m, src)
+}
+func (m *ListOfReports) XXX_Size() int {
+	return xxx_messageInfo_ListOfReports.Size(m)
+}
+func (m *ListOfReports) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListOfReports.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListOfReports proto.InternalMessageInfo
+
+func (m *ListOfReports) GetItems() []*Report {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+func (m *ListOfReports) GetUnread() uint32 {
+	if m != nil {
+		return m.Unread
+	}
+	return 0
+}
+
+type MarkReadReq struct {
+	Character uint64   `protobuf:"varint,1,opt,name=character,proto3" json:"character,omitempty"`
+	Reports   []uint64 `protobuf:"varint,2,rep,packed,name=reports,proto3" json:"reports,omitempty"`
+	// Mark all the reports of the Character
+	All                  bool     `protobuf:"varint,3,opt,name=all,proto3" json:"all,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MarkReadReq) Reset()         { *m = MarkReadReq{} }
+func (m *MarkReadReq) String() string { return proto.CompactTextString(m) }
+func (*MarkReadReq) ProtoMessage()    {}
+func (*MarkReadReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f22242cb04491f9, []int{12}
+}
+
+func (m *MarkReadReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MarkReadReq.Unmarshal(m, b)
+}
+func (m *MarkReadReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MarkReadReq.Marshal(b, m, deterministic)
+}
+func (m *MarkReadReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarkReadReq.Merge(m, src)
+}
+func (m *MarkReadReq) XXX_Size() int {
+	return xxx_messageInfo_MarkReadReq.Size(m)
+}
+func (m *MarkReadReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarkReadReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarkReadReq proto.InternalMessageInfo
+
+func (m *MarkReadReq) GetCharacter() uint64 {
+	if m != nil {
+		return m.Character
+	}
+	return 0
+}
+
+func (m *MarkReadReq) GetReports() []uint64 {
+	if m != nil {
+		return m.Reports
+	}
+	return nil
+}
+
+func (m *MarkReadReq) GetAll() bool {
+	if m != nil {
+		return m.All
+	}
+	return false
+}
+
+type MarkReadRep struct {
+	Marked               uint32   `protobuf:"varint,1,opt,name=marked,proto3" json:"marked,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MarkReadRep) Reset()         { *m = MarkReadRep{} }
+func (m *MarkReadRep) String() string { return proto.CompactTextString(m) }
+func (*MarkReadRep) ProtoMessage()    {}
+func (*MarkReadRep) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f22242cb04491f9, []int{13}
+}
+
+func (m *MarkReadRep) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MarkReadRep.Unmarshal(m, b)
+}
+func (m *MarkReadRep) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MarkReadRep.Marshal(b, m, deterministic)
+}
+func (m *MarkReadRep) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarkReadRep.Merge(m, src)
+}
+func (m *MarkReadRep) XXX_Size() int {
+	return xxx_messageInfo_MarkReadRep.Size(m)
+}
+func (m *MarkReadRep) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarkReadRep.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarkReadRep proto.InternalMessageInfo
+
+func (m *MarkReadRep) GetMarked() uint32 {
+	if m != nil {
+		return m.Marked
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Event)(nil), "hegemonie.events.proto.Event")
+	proto.RegisterType((*PublishReq)(nil), "hegemonie.events.proto.PublishReq")
+	proto.RegisterType((*PublishRep)(nil), "hegemonie.events.proto.PublishRep")
+	proto.RegisterType((*SubscribeReq)(nil), "hegemonie.events.proto.SubscribeReq")
+	proto.RegisterType((*ListTopicsReq)(nil), "hegemonie.events.proto.ListTopicsReq")
+	proto.RegisterType((*TopicView)(nil), "hegemonie.events.proto.TopicView")
+	proto.RegisterType((*ListOfTopics)(nil), "hegemonie.events.proto.ListOfTopics")
+	proto.RegisterType((*Report)(nil), "hegemonie.events.proto.Report")
+	proto.RegisterType((*DeliverReq)(nil), "hegemonie.events.proto.DeliverReq")
+	proto.RegisterType((*DeliverRep)(nil), "hegemonie.events.proto.DeliverRep")
+	proto.RegisterType((*ListReportsReq)(nil), "hegemonie.events.proto.ListReportsReq")
+	proto.RegisterType((*ListOfReports)(nil), "hegemonie.events.proto.ListOfReports")
+	proto.RegisterType((*MarkReadReq)(nil), "hegemonie.events.proto.MarkReadReq")
+	proto.RegisterType((*MarkReadRep)(nil), "hegemonie.events.proto.MarkReadRep")
+}
+
+func init() { proto.RegisterFile("events.proto", fileDescriptor_8f22242cb04491f9) }
+
+var fileDescriptor_8f22242cb04491f9 = []byte{
+	// 659 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xce, 0xda, 0xce, 0xdf, 0x34, 0xa9, 0xd0, 0xaa, 0xaa, 0xac, 0xa8, 0x54, 0x66, 0x69, 0xc1,
+	0xa7, 0x08, 0x15, 0x24, 0x24, 0xce, 0x20, 0x2e, 0xa0, 0x56, 0xdb, 0xaa, 0x3d, 0x20, 0x0e, 0x9b,
+	0x78, 0xd3, 0xac, 0x92, 0xd8, 0xee, 0xae, 0xfb, 0x77, 0xe7, 0x0e, 0x0f, 0xc0, 0x83, 0xf0, 0x78,
+	0x68, 0xd7, 0xeb, 0xd8, 0x46, 0x71, 0x0b, 0x12, 0xb7, 0x99, 0xd1, 0x37, 0xb3, 0xdf, 0x7c, 0x33,
+	0x3b, 0x30, 0xe0, 0x37, 0x3c, 0xce, 0xd4, 0x38, 0x95, 0x49, 0x96, 0xe0, 0xdd, 0x39, 0xbf, 0xe4,
+	0xab, 0x24, 0x16, 0x7c, 0x5c, 0x8d, 0x93, 0x2b, 0x68, 0x7f, 0xd0, 0x3e, 0xde, 0x81, 0x76, 0x96,
+	0xa4, 0x62, 0xea, 0xa3, 0x00, 0x85, 0x7d, 0x9a, 0x3b, 0xf8, 0x09, 0xb8, 0x8a, 0x5f, 0xf9, 0x4e,
+	0x80, 0x42, 0x8f, 0x6a, 0x13, 0x63, 0xf0, 0x6e, 0xe7, 0x3c, 0xf6, 0xdd, 0x00, 0x85, 0x2e, 0x35,
+	0xb6, 0x8e, 0x2d, 0x44, 0x1c, 0xf9, 0x9e, 0x49, 0x35, 0x36, 0xf6, 0xa1, 0x9b, 0xb2, 0xfb, 0x65,
+	0xc2, 0x22, 0xbf, 0x1d, 0xa0, 0x70, 0x40, 0x0b, 0x97, 0x9c, 0x00, 0x9c, 0x5c, 0x4f, 0x96, 0x42,
+	0xcd, 0x29, 0xbf, 0x6a, 0x78, 0xb7, 0xa8, 0xe8, 0x6c, 0xae, 0xe8, 0xd6, 0x2b, 0xee, 0x57, 0x2a,
+	0xa6, 0x05, 0x67, 0xb4, 0xe6, 0x4c, 0xde, 0xc1, 0xe0, 0xf4, 0x7a, 0xa2, 0xa6, 0x52, 0x4c, 0x78,
+	0xf3, 0x9b, 0x3b, 0xd0, 0x66, 0xb3, 0x8c, 0x4b, 0xdb, 0x6d, 0xee, 0x90, 0x97, 0x30, 0xfc, 0x24,
+	0x54, 0x76, 0xa6, 0x21, 0x4a, 0x27, 0xef, 0x42, 0x27, 0x95, 0x7c, 0x26, 0xee, 0x6c, 0xb6, 0xf5,
+	0xc8, 0x02, 0xfa, 0x06, 0x74, 0x2e, 0xf8, 0xad, 0xe6, 0x1f, 0xb3, 0x15, 0xb7, 0x10, 0x63, 0xeb,
+	0xfa, 0x33, 0x21, 0x55, 0x56, 0xd4, 0x37, 0x8e, 0x46, 0x2e, 0x99, 0xca, 0x4c, 0x4b, 0x1e, 0x35,
+	0x36, 0x0e, 0x60, 0x4b, 0x15, 0x7c, 0xa5, 0x32, 0xb2, 0x0e, 0x69, 0x35, 0x44, 0x3e, 0xc2, 0x40,
+	0xb3, 0x3a, 0x9e, 0xe5, 0xbc, 0xf0, 0x5b, 0x68, 0x8b, 0x8c, 0xaf, 0x94, 0x8f, 0x02, 0x37, 0xdc,
+	0x3a, 0x7a, 0x36, 0xde, 0x3c, 0xee, 0xf1, 0x9a, 0x21, 0xcd, 0xf1, 0xe4, 0x17, 0x82, 0x0e, 0xe5,
+	0x69, 0x22, 0x33, 0xbc, 0x0d, 0x8e, 0x88, 0xac, 0x6c, 0x8e, 0x88, 0xf0, 0x1e, 0xf4, 0xa7, 0x73,
+	0x26, 0xd9, 0xb4, 0xd4, 0xa4, 0x0c, 0x68, 0x19, 0x24, 0xbf, 0x14, 0x49, 0xbe, 0x09, 0x7d, 0x6a,
+	0x3d, 0xdd, 0xcf, 0x54, 0x64, 0xf7, 0x86, 0xb4, 0x47, 0x8d, 0xbd, 0xde, 0x99, 0xf6, 0x86, 0x9d,
+	0xe9, 0x6c, 0x9e, 0x70, 0xb7, 0x36, 0x61, 0x8d, 0x96, 0x9c, 0x45, 0x7e, 0x2f, 0x40, 0x61, 0x8f,
+	0x1a, 0x9b, 0x7c, 0x43, 0x00, 0xef, 0xf9, 0x52, 0xdc, 0x70, 0xa9, 0xe7, 0x52, 0xa3, 0x8b, 0x9a,
+	0xe9, 0x3a, 0x1b, 0xe9, 0xba, 0x75, 0xba, 0xff, 0xb0, 0xce, 0x7b, 0x15, 0x16, 0xe9, 0x9f, 0x22,
+	0x92, 0x9f, 0x08, 0xb6, 0xf5, 0xa4, 0x72, 0x8d, 0xd5, 0xff, 0x25, 0xba, 0x0b, 0x9d, 0xeb, 0xd8,
+	0xe8, 0xe2, 0x19, 0x5d, 0xac, 0xa7, 0xe3, 0x2b, 0x26, 0x17, 0x5c, 0x1a, 0xae, 0x1e, 0xb5, 0x9e,
+	0xfe, 0x19, 0x2b, 0x76, 0x67, 0x24, 0x1f, 0x52, 0x6d, 0x92, 0xaf, 0xf9, 0x76, 0x1f, 0xcf, 0x2c,
+	0x3f, 0xfc, 0xa6, 0xbe, 0x48, 0xfb, 0x4d, 0x8b, 0x94, 0xe3, 0xed, 0x16, 0x55, 0x88, 0x38, 0xa6,
+	0xb6, 0xf5, 0xc8, 0x05, 0x6c, 0x7d, 0x66, 0x72, 0x41, 0x39, 0x8b, 0x1e, 0xef, 0xdc, 0x87, 0xae,
+	0xcc, 0x59, 0xf8, 0x4e, 0xe0, 0x86, 0x1e, 0x2d, 0x5c, 0xcd, 0x9b, 0x2d, 0x97, 0xa6, 0xf5, 0x1e,
+	0xd5, 0x26, 0x39, 0xac, 0x16, 0x4e, 0xd7, 0x0d, 0xe7, 0xca, 0x0f, 0x6d, 0xc3, 0xd1, 0xd1, 0x0f,
+	0x07, 0x3a, 0xe6, 0xbc, 0x29, 0x7c, 0x0a, 0x5d, 0x7b, 0x23, 0x30, 0x69, 0x6a, 0xaa, 0x3c, 0x4b,
+	0xa3, 0xc7, 0x31, 0x29, 0x69, 0xe1, 0x33, 0xe8, 0xaf, 0x0f, 0x0b, 0x3e, 0x68, 0x4a, 0xa9, 0xde,
+	0x9e, 0xd1, 0xd3, 0x26, 0x94, 0xe1, 0x49, 0x5a, 0xaf, 0x10, 0xfe, 0x02, 0x50, 0x9e, 0x1c, 0x7c,
+	0xd8, 0x94, 0x50, 0x3b, 0x4b, 0xa3, 0x83, 0x87, 0x60, 0xc5, 0x9d, 0x20, 0xad, 0xa3, 0xef, 0x0e,
+	0x74, 0x8b, 0x61, 0x9f, 0x42, 0xd7, 0xae, 0x6e, 0xb3, 0x26, 0xe5, 0x0f, 0x1b, 0x3d, 0x8e, 0xd1,
+	0x9a, 0x5c, 0x80, 0xa7, 0x9f, 0xc4, 0x2f, 0x1e, 0x22, 0x54, 0x7e, 0x87, 0xd1, 0xe1, 0xc3, 0xc4,
+	0x2d, 0x92, 0xb4, 0xf0, 0x39, 0xf4, 0x8a, 0x99, 0xe3, 0xe7, 0x4d, 0x49, 0x95, 0x75, 0x1b, 0xfd,
+	0x05, 0x28, 0x25, 0xad, 0x49, 0xc7, 0x04, 0x5f, 0xff, 0x1e, 0x00, 0x2f, 0x16, 0xd9, 0x41, 0x31,
+	0x07, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// EventsClient is the client API for Events service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type EventsClient interface {
+	// Append an event to its topic and deliver it to the current subscribers
+	Publish(ctx context.Context, in *PublishReq, opts ...grpc.CallOption) (*PublishRep, error)
+	// Stream the events of the topic, in order, starting with those of the
+	// backlog after the given sequence number. A subscriber too slow to
+	// consume its events is disconnected, and may resume from the last
+	// sequence number it received.
+	Subscribe(ctx context.Context, in *SubscribeReq, opts ...grpc.CallOption) (Events_SubscribeClient, error)
+	ListTopics(ctx context.Context, in *ListTopicsReq, opts ...grpc.CallOption) (*ListOfTopics, error)
+}
+
+type eventsClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewEventsClient(cc *grpc.ClientConn) EventsClient {
+	return &eventsClient{cc}
+}
+
+func (c *eventsClient) Publish(ctx context.Context, in *PublishReq, opts ...grpc.CallOption) (*PublishRep, error) {
+	out := new(PublishRep)
+	err := c.cc.Invoke(ctx, "/hegemonie.events.proto.Events/Publish", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventsClient) Subscribe(ctx context.Context, in *SubscribeReq, opts ...grpc.CallOption) (Events_SubscribeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Events_serviceDesc.Streams[0], "/hegemonie.events.proto.Events/Subscribe", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &eventsSubscribeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Events_SubscribeClient interface {
+	Recv() (*Event, error)
+	grpc.ClientStream
+}
+
+type eventsSubscribeClient struct {
+	grpc.ClientStream
+}
+
+func (x *eventsSubscribeClient) Recv() (*Event, error) {
+	m := new(Event)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *eventsClient) ListTopics(ctx context.Context, in *ListTopicsReq, opts ...grpc.CallOption) (*ListOfTopics, error) {
+	out := new(ListOfTopics)
+	err := c.cc.Invoke(ctx, "/hegemonie.events.proto.Events/ListTopics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// EventsServer is the server API for Events service.
+type EventsServer interface {
+	// Append an event to its topic and deliver it to the current subscribers
+	Publish(context.Context, *PublishReq) (*PublishRep, error)
+	// Stream the events of the topic, in order, starting with those of the
+	// backlog after the given sequence number. A subscriber too slow to
+	// consume its events is disconnected, and may resume from the last
+	// sequence number it received.
+	Subscribe(*SubscribeReq, Events_SubscribeServer) error
+	ListTopics(context.Context, *ListTopicsReq) (*ListOfTopics, error)
+}
+
+// UnimplementedEventsServer can be embedded to have forward compatible implementations.
+type UnimplementedEventsServer struct {
+}
+
+func (*UnimplementedEventsServer) Publish(ctx context.Context, req *PublishReq) (*PublishRep, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Publish not implemented")
+}
+func (*UnimplementedEventsServer) Subscribe(req *SubscribeReq, srv Events_SubscribeServer) error {
+	return status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
+}
+func (*UnimplementedEventsServer) ListTopics(ctx context.Context, req *ListTopicsReq) (*ListOfTopics, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTopics not implemented")
+}
+
+func RegisterEventsServer(s *grpc.Server, srv EventsServer) {
+	s.RegisterService(&_Events_serviceDesc, srv)
+}
+
+func _Events_Publish_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventsServer).Publish(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hegemonie.events.proto.Events/Publish",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventsServer).Publish(ctx, req.(*PublishReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Events_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(EventsServer).Subscribe(m, &eventsSubscribeServer{stream})
+}
+
+type Events_SubscribeServer interface {
+	Send(*Event) error
+	grpc.ServerStream
+}
+
+type eventsSubscribeServer struct {
+	grpc.ServerStream
+}
+
+func (x *eventsSubscribeServer) Send(m *Event) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Events_ListTopics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTopicsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventsServer).ListTopics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hegemonie.events.proto.Events/ListTopics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventsServer).ListTopics(ctx, req.(*ListTopicsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Events_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "hegemonie.events.proto.Events",
+	HandlerType: (*EventsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Publish",
+			Handler:    _Events_Publish_Handler,
+		},
+		{
+			MethodName: "ListTopics",
+			Handler:    _Events_ListTopics_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Subscribe",
+			Handler:       _Events_Subscribe_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "events.proto",
+}
+
+// ReportsClient is the client API for Reports service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ReportsClient interface {
+	Deliver(ctx context.Context, in *DeliverReq, opts ...grpc.CallOption) (*DeliverRep, error)
+	List(ctx context.Context, in *ListReportsReq, opts ...grpc.CallOption) (*ListOfReports, error)
+	MarkRead(ctx context.Context, in *MarkReadReq, opts ...grpc.CallOption) (*MarkReadRep, error)
+}
+
+type reportsClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewReportsClient(cc *grpc.ClientConn) ReportsClient {
+	return &reportsClient{cc}
+}
+
+func (c *reportsClient) Deliver(ctx context.Context, in *DeliverReq, opts ...grpc.CallOption) (*DeliverRep, error) {
+	out := new(DeliverRep)
+	err := c.cc.Invoke(ctx, "/hegemonie.events.proto.Reports/Deliver", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reportsClient) List(ctx context.Context, in *ListReportsReq, opts ...grpc.CallOption) (*ListOfReports, error) {
+	out := new(ListOfReports)
+	err := c.cc.Invoke(ctx, "/hegemonie.events.proto.Reports/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reportsClient) MarkRead(ctx context.Context, in *MarkReadReq, opts ...grpc.CallOption) (*MarkReadRep, error) {
+	out := new(MarkReadRep)
+	err := c.cc.Invoke(ctx, "/hegemonie.events.proto.Reports/MarkRead", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ReportsServer is the server API for Reports service.
+type ReportsServer interface {
+	Deliver(context.Context, *DeliverReq) (*DeliverRep, error)
+	List(context.Context, *ListReportsReq) (*ListOfReports, error)
+	MarkRead(context.Context, *MarkReadReq) (*MarkReadRep, error)
+}
+
+// UnimplementedReportsServer can be embedded to have forward compatible implementations.
+type UnimplementedReportsServer struct {
+}
+
+func (*UnimplementedReportsServer) Deliver(ctx context.Context, req *DeliverReq) (*DeliverRep, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Deliver not implemented")
+}
+func (*UnimplementedReportsServer) List(ctx context.Context, req *ListReportsReq) (*ListOfReports, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (*UnimplementedReportsServer) MarkRead(ctx context.Context, req *MarkReadReq) (*MarkReadRep, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkRead not implemented")
+}
+
+func RegisterReportsServer(s *grpc.Server, srv ReportsServer) {
+	s.RegisterService(&_Reports_serviceDesc, srv)
+}
+
+func _Reports_Deliver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeliverReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReportsServer).Deliver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hegemonie.events.proto.Reports/Deliver",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReportsServer).Deliver(ctx, req.(*DeliverReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Reports_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReportsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReportsServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hegemonie.events.proto.Reports/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReportsServer).List(ctx, req.(*ListReportsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Reports_MarkRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkReadReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReportsServer).MarkRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hegemonie.events.proto.Reports/MarkRead",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReportsServer).MarkRead(ctx, req.(*MarkReadReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Reports_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "hegemonie.events.proto.Reports",
+	HandlerType: (*ReportsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Deliver",
+			Handler:    _Reports_Deliver_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _Reports_List_Handler,
+		},
+		{
+			MethodName: "MarkRead",
+			Handler:    _Reports_MarkRead_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "events.proto",
+}
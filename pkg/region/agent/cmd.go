@@ -12,9 +12,13 @@ import (
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc"
 	"io"
+	"log"
 	"net"
 	"os"
+	"os/signal"
 	"path/filepath"
+	"sort"
+	"syscall"
 	"time"
 
 	"github.com/jfsmig/hegemonie/pkg/region/model"
@@ -22,9 +26,27 @@ import (
 )
 
 type regionConfig struct {
+	name     string
 	endpoint string
+	events   string
+	queue    int
+
+	auth           string
+	advertise      string
+	periodRegister time.Duration
+	periodHandoff  time.Duration
+
 	pathLoad string
 	pathSave string
+	saveKeep int
+	restore  string
+
+	periodMove     time.Duration
+	periodProduce  time.Duration
+	periodSnapshot time.Duration
+	maxCatchUp     int
+
+	journal *journal
 }
 
 func Command() *cobra.Command {
@@ -40,16 +62,42 @@ func Command() *cobra.Command {
 	}
 	agent.Flags().StringVar(&cfg.endpoint,
 		"endpoint", "127.0.0.1:8080", "IP:PORT endpoint for the TCP/IP server")
+	agent.Flags().StringVar(&cfg.name,
+		"name", "region", "Name of the Region, used in the topics of its events")
+	agent.Flags().StringVar(&cfg.events,
+		"events", "", "IP:PORT endpoint of the events service (empty to disable the notifications)")
+	agent.Flags().StringVar(&cfg.auth,
+		"auth", "", "IP:PORT endpoint of the auth service where the Region registers (empty to disable)")
+	agent.Flags().StringVar(&cfg.advertise,
+		"advertise", "", "IP:PORT endpoint registered for the Region (default: --endpoint)")
+	agent.Flags().DurationVar(&cfg.periodRegister,
+		"period-register", 20*time.Second, "Period of the registrations of the Region")
+	agent.Flags().DurationVar(&cfg.periodHandoff,
+		"period-handoff", 5*time.Second, "Period of the transfers of the Armies to the other Regions (0 to disable)")
+	agent.Flags().IntVar(&cfg.queue,
+		"events-queue", 1024, "Number of events queued before their publication, beyond which they are dropped")
 	agent.Flags().StringVar(&cfg.pathLoad,
-		"load", "/data/defs", "File to be loaded")
+		"load", "/data/defs", "Directory of the bootstrap sections, or file with a full dump, to be loaded")
+	agent.Flags().StringVar(&cfg.restore,
+		"restore", "auto", "Save to restore instead of loading: 'latest', 'none', a path, or 'auto' for the latest save if any")
 	agent.Flags().StringVar(&cfg.pathSave,
 		"save", "/data/dump", "Directory for persistent")
+	agent.Flags().IntVar(&cfg.saveKeep,
+		"save-keep", 5, "Number of previous saves kept aside the latest one (negative to keep them all)")
+	agent.Flags().DurationVar(&cfg.periodMove,
+		"period-move", time.Minute, "Period of the movement ticks (0 to disable)")
+	agent.Flags().DurationVar(&cfg.periodProduce,
+		"period-produce", 10*time.Minute, "Period of the production ticks (0 to disable)")
+	agent.Flags().IntVar(&cfg.maxCatchUp,
+		"catchup-max", 0, "Maximum number of missed ticks played at startup (0 for no limit)")
+	agent.Flags().DurationVar(&cfg.periodSnapshot,
+		"period-snapshot", 10*time.Minute, "Period of the snapshots compacting the journal (0 to disable)")
 
 	return agent
 }
 
 func e(format string, args ...interface{}) error {
-	return errors.New(fmt.Sprintf(format, args))
+	return errors.New(fmt.Sprintf(format, args...))
 }
 
 func (self *regionConfig) execute() error {
@@ -57,6 +105,7 @@ func (self *regionConfig) execute() error {
 
 	w := region.World{}
 	w.Init()
+	w.SetName(self.name)
 
 	if self.pathSave != "" {
 		err = os.MkdirAll(self.pathSave, 0755)
@@ -65,32 +114,30 @@ func (self *regionConfig) execute() error {
 		}
 	}
 
-	if self.pathLoad != "" {
-		type cfgSection struct {
-			suffix string
-			obj    interface{}
+	restore := ""
+	latest := filepath.Join(self.pathSave, "latest")
+	switch self.restore {
+	case "auto":
+		if _, err = os.Stat(latest); self.pathSave != "" && err == nil {
+			restore = latest
 		}
-		cfgSections := []cfgSection{
-			{"defs.json", &w.Definitions},
-			{"map.json", &w.Places},
-			{"live.json", &w.Live},
+	case "latest":
+		if self.pathSave == "" {
+			return e("No save path configured")
 		}
-		for _, section := range cfgSections {
-			var in io.ReadCloser
-			p := self.pathLoad + "/" + section.suffix
-			in, err = os.Open(p)
-			if err != nil {
-				return e("Failed to load the World from [%s]: %s", p, err.Error())
-			}
-			err = json.NewDecoder(in).Decode(section.obj)
-			in.Close()
-			if err != nil {
-				return e("Failed to load the World from [%s]: %s", p, err.Error())
-			}
+		restore = latest
+	case "none", "":
+	default:
+		restore = self.restore
+	}
+
+	if restore != "" {
+		if err = self.load(&w, restore); err != nil {
+			return e("Failed to restore the World from [%s]: %s", restore, err.Error())
 		}
-		err = w.PostLoad()
-		if err != nil {
-			return e("Inconsistent World from [%s]: %s", self.pathLoad, err.Error())
+	} else if self.pathLoad != "" {
+		if err = self.load(&w, self.pathLoad); err != nil {
+			return e("Failed to load the World from [%s]: %s", self.pathLoad, err.Error())
 		}
 	}
 
@@ -99,23 +146,87 @@ func (self *regionConfig) execute() error {
 		return e("Inconsistent World: %s", err.Error())
 	}
 
+	srvCity := &srvCity{cfg: self, w: &w}
+	srvArmy := &srvArmy{cfg: self, w: &w}
+	srvAdmin := &srvAdmin{cfg: self, w: &w}
+	srvHandoff := &srvHandoff{cfg: self, w: &w}
+	srvMarket := &srvMarket{cfg: self, w: &w}
+	srvDiplomacy := &srvDiplomacy{cfg: self, w: &w}
+	replayers := makeReplayers(srvCity, srvArmy, srvAdmin, srvHandoff, srvMarket, srvDiplomacy)
+
+	var opts []grpc.ServerOption
+	if self.pathSave != "" {
+		p := filepath.Join(self.pathSave, "journal")
+		self.journal, err = openJournal(p)
+		if err != nil {
+			return e("Failed to open the journal [%s]: %s", p, err.Error())
+		}
+		defer self.journal.Close()
+
+		if restore == "" && self.restore == "none" {
+			// A fresh start, the journal belongs to a previous game
+			if err = self.journal.reset(); err != nil {
+				return e("Failed to reset the journal [%s]: %s", p, err.Error())
+			}
+		}
+		nb, err := self.journal.replay(&w, replayers)
+		if err != nil {
+			return e("Failed to replay the journal [%s]: %s", p, err.Error())
+		}
+		if nb > 0 {
+			log.Printf("Replayed %d journal entries", nb)
+		}
+		opts = append(opts, grpc.UnaryInterceptor(self.journal.intercept(&w, replayers)))
+	}
+
+	// Installed after the replay, the events of the journal were already sent
+	if self.events != "" {
+		p, err := newPublisher(self.events, self.name, self.queue)
+		if err != nil {
+			return e("Failed to connect the events service [%s]: %s", self.events, err.Error())
+		}
+		defer p.Close()
+		w.SetNotifier(p)
+	}
+
 	lis, err := net.Listen("tcp", self.endpoint)
 	if err != nil {
 		return e("failed to listen: %v", err)
 	}
 
-	srv := grpc.NewServer()
+	srv := grpc.NewServer(opts...)
 
-	proto.RegisterCityServer(srv, &srvCity{cfg: self, w: &w})
+	proto.RegisterCityServer(srv, srvCity)
 	proto.RegisterDefinitionsServer(srv, &srvDefinitions{cfg: self, w: &w})
-	proto.RegisterAdminServer(srv, &srvAdmin{cfg: self, w: &w})
-	proto.RegisterArmyServer(srv, &srvArmy{cfg: self, w: &w})
-	if err := srv.Serve(lis); err != nil {
+	proto.RegisterAdminServer(srv, srvAdmin)
+	proto.RegisterArmyServer(srv, srvArmy)
+	proto.RegisterHandoffServer(srv, srvHandoff)
+	proto.RegisterMapServer(srv, &srvMap{cfg: self, w: &w})
+	proto.RegisterMarketServer(srv, srvMarket)
+	proto.RegisterDiplomacyServer(srv, srvDiplomacy)
+
+	// Stop gracefully on a signal, the final snapshot happens below
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+	go func() {
+		sig := <-signals
+		log.Printf("Caught %v, stopping", sig)
+		srv.GracefulStop()
+	}()
+
+	stop := make(chan struct{})
+	go self.schedule(&w, stop)
+	go self.compact(&w, stop)
+	go self.register(stop)
+	go self.handoff(&w, srvHandoff, stop)
+	err = srv.Serve(lis)
+	close(stop)
+	if err != nil {
 		return e("failed to serve: %v", err)
 	}
 
 	if self.pathSave != "" {
-		err = self.save(&w)
+		err = self.snapshot(&w)
 		if err != nil {
 			return e("Failed to save the World at exit: %s", err.Error())
 		}
@@ -124,30 +235,152 @@ func (self *regionConfig) execute() error {
 	return nil
 }
 
+// Load the World from either a directory with the bootstrap sections or a
+// file with a full dump.
+func (self *regionConfig) load(w *region.World, p string) error {
+	st, err := os.Stat(p)
+	if err != nil {
+		return err
+	}
+
+	if !st.IsDir() {
+		in, err := os.Open(p)
+		if err != nil {
+			return err
+		}
+		defer in.Close()
+		return w.LoadJSON(in)
+	}
+
+	type cfgSection struct {
+		suffix string
+		obj    interface{}
+	}
+	cfgSections := []cfgSection{
+		{"defs.json", &w.Definitions},
+		{"map.json", &w.Places},
+		{"live.json", &w.Live},
+	}
+	for _, section := range cfgSections {
+		var in io.ReadCloser
+		p := filepath.Join(p, section.suffix)
+		in, err = os.Open(p)
+		if err != nil {
+			return err
+		}
+		err = json.NewDecoder(in).Decode(section.obj)
+		in.Close()
+		if err != nil {
+			return e("[%s]: %s", p, err.Error())
+		}
+	}
+	return w.PostLoad()
+}
+
+// Periodically replace the journal by a snapshot of the World, until 'stop'
+// is closed.
+func (self *regionConfig) compact(w *region.World, stop <-chan struct{}) {
+	if self.journal == nil || self.periodSnapshot <= 0 {
+		return
+	}
+	ticker := time.NewTicker(self.periodSnapshot)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			if err := self.snapshot(w); err != nil {
+				log.Printf("Snapshot error: %s", err.Error())
+			}
+		}
+	}
+}
+
+// Save the World then drop the journal entries it contains. The mutations
+// are blocked meanwhile.
+func (self *regionConfig) snapshot(w *region.World) error {
+	if self.journal == nil {
+		w.RLock()
+		defer w.RUnlock()
+		return self.save(w)
+	}
+
+	self.journal.Lock()
+	defer self.journal.Unlock()
+
+	w.RLock()
+	err := self.save(w)
+	w.RUnlock()
+	if err != nil {
+		return err
+	}
+	return self.journal.reset()
+}
+
 func (self *regionConfig) save(w *region.World) error {
 	if self.pathSave == "" {
 		return errors.New("No save path configured")
 	}
-	p := self.pathSave + "/" + makeSaveFilename()
-	p = filepath.Clean(p)
+	name := makeSaveFilename()
+	p := filepath.Join(self.pathSave, name)
 	out, err := os.OpenFile(p, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0644)
 	if err != nil {
 		return err
 	}
 	err = w.DumpJSON(out)
+	if err == nil {
+		err = out.Sync()
+	}
 	out.Close()
 	if err != nil {
 		_ = os.Remove(p)
 		return err
 	}
 
-	latest := self.pathSave + "/latest"
-	_ = os.Remove(latest)
-	_ = os.Symlink(p, latest)
-	return nil
+	// Atomically replace the link to the latest snapshot
+	latest := filepath.Join(self.pathSave, "latest")
+	tmp := latest + ".tmp"
+	_ = os.Remove(tmp)
+	if err = os.Symlink(name, tmp); err != nil {
+		return err
+	}
+	if err = os.Rename(tmp, latest); err != nil {
+		return err
+	}
+	self.prune()
+
+	// Persist the new entries of the directory
+	dir, err := os.Open(self.pathSave)
+	if err != nil {
+		return err
+	}
+	err = dir.Sync()
+	dir.Close()
+	return err
+}
+
+// Remove the oldest saves, beyond the latest one and the number of previous
+// saves to keep. The timestamps in the names sort them chronologically.
+func (self *regionConfig) prune() {
+	if self.saveKeep < 0 {
+		return
+	}
+	saves, err := filepath.Glob(filepath.Join(self.pathSave, "save-*"))
+	if err != nil {
+		log.Printf("Failed to list the saves: %s", err.Error())
+		return
+	}
+	sort.Strings(saves)
+	for len(saves) > self.saveKeep+1 {
+		if err = os.Remove(saves[0]); err != nil {
+			log.Printf("Failed to remove [%s]: %s", saves[0], err.Error())
+		}
+		saves = saves[1:]
+	}
 }
 
 func makeSaveFilename() string {
-	now := time.Now().Round(1 * time.Second)
-	return "save-" + now.Format("20060102_030405")
+	now := time.Now().UTC().Round(1 * time.Millisecond)
+	return "save-" + now.Format("20060102_150405.000")
 }
@@ -0,0 +1,54 @@
+// Copyright (C) 2018-2020 Hegemonie's AUTHORS
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package hegemonie_region_agent
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"google.golang.org/grpc"
+
+	auth "github.com/jfsmig/hegemonie/pkg/auth/proto"
+)
+
+// Periodically register the Region in the directory of the auth service, so
+// that the front services route the requests of its Characters here, until
+// 'stop' is closed.
+func (self *regionConfig) register(stop <-chan struct{}) {
+	if self.auth == "" || self.periodRegister <= 0 {
+		return
+	}
+	cnx, err := grpc.Dial(self.auth, grpc.WithInsecure())
+	if err != nil {
+		log.Printf("Registration disabled: %s", err.Error())
+		return
+	}
+	defer cnx.Close()
+
+	advertised := self.advertise
+	if advertised == "" {
+		advertised = self.endpoint
+	}
+	req := &auth.RegionView{Name: self.name, Endpoint: advertised}
+	cli := auth.NewAuthClient(cnx)
+
+	ticker := time.NewTicker(self.periodRegister)
+	defer ticker.Stop()
+	for {
+		ctx, cancel := context.WithTimeout(context.Background(), self.periodRegister)
+		_, err := cli.RegionRegister(ctx, req)
+		cancel()
+		if err != nil {
+			log.Printf("Registration error: %s", err.Error())
+		}
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
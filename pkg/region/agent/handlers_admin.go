@@ -10,6 +10,7 @@ import (
 	"github.com/jfsmig/hegemonie/pkg/region/model"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"time"
 
 	proto "github.com/jfsmig/hegemonie/pkg/region/proto"
 )
@@ -20,25 +21,70 @@ type srvAdmin struct {
 }
 
 func (s *srvAdmin) Produce(ctx context.Context, req *proto.None) (*proto.None, error) {
-	return nil, status.Errorf(codes.Unimplemented, "NYI")
+	s.w.Produce()
+	return &proto.None{}, nil
 }
 
 func (s *srvAdmin) Move(ctx context.Context, req *proto.None) (*proto.None, error) {
-	return nil, status.Errorf(codes.Unimplemented, "NYI")
+	s.w.Move()
+	return &proto.None{}, nil
 }
 
 func (s *srvAdmin) GetScores(ctx context.Context, req *proto.None) (*proto.ScoreBoard, error) {
-	return nil, status.Errorf(codes.Unimplemented, "NYI")
-}
-
-func (s *srvAdmin) Save(ctx context.Context, req *proto.None) (*proto.None, error) {
 	s.w.RLock()
 	defer s.w.RUnlock()
 
-	err := s.cfg.save(s.w)
+	sb := &proto.ScoreBoard{}
+	for _, cs := range s.w.ScoreBoard() {
+		sb.Items = append(sb.Items, &proto.ScoredCity{
+			City: cs.City.Id, Name: cs.City.Name, Score: cs.Score})
+	}
+	return sb, nil
+}
+
+func (s *srvAdmin) Save(ctx context.Context, req *proto.None) (*proto.None, error) {
+	err := s.cfg.snapshot(s.w)
 	if err != nil {
 		return nil, err
 	} else {
 		return &proto.None{}, nil
 	}
 }
+
+func (s *srvAdmin) GetClock(ctx context.Context, req *proto.None) (*proto.ClockView, error) {
+	return showClock(s.w.ClockGet()), nil
+}
+
+func (s *srvAdmin) Pause(ctx context.Context, req *proto.None) (*proto.None, error) {
+	s.w.Pause()
+	return &proto.None{}, nil
+}
+
+func (s *srvAdmin) Resume(ctx context.Context, req *proto.None) (*proto.None, error) {
+	s.w.Resume(now(ctx), s.cfg.sched())
+	return &proto.None{}, nil
+}
+
+func (s *srvAdmin) CatchUp(ctx context.Context, req *proto.CatchUpReq) (*proto.ClockView, error) {
+	err := s.w.CatchUp(int(req.Ticks), now(ctx), s.cfg.sched())
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "Catch-up error: %s", err.Error())
+	}
+	return showClock(s.w.ClockGet()), nil
+}
+
+func showClock(c region.Clock) *proto.ClockView {
+	unix := func(t time.Time) int64 {
+		if t.IsZero() {
+			return 0
+		}
+		return t.Unix()
+	}
+	return &proto.ClockView{
+		Tick:        c.Tick,
+		Production:  c.Production,
+		NextMove:    unix(c.NextMove),
+		NextProduce: unix(c.NextProduce),
+		Paused:      c.Paused,
+	}
+}
@@ -20,13 +20,15 @@ type srvArmy struct {
 }
 
 func (s *srvArmy) getAndCheckArmy(req *proto.ArmyId) (*region.City, *region.Army, error) {
-	city, err := s.w.CityGetAndCheck(req.Character, req.City)
+	city, err := cityGetAndCheck(s.w, req.Character, req.City)
 	if err != nil {
 		return nil, nil, err
 	}
 	if army := s.w.ArmyGet(req.Army); army == nil {
 		return nil, nil, status.Errorf(codes.NotFound, "Army Not found")
-	} else if army.City != city.Id {
+	} else if army.City != city.Id || army.Region != "" {
+		// An Army abroad is controlled by a City of another Region, it
+		// heads back there once its commands are done
 		return nil, nil, status.Errorf(codes.PermissionDenied, "Army not controlled")
 	} else {
 		return city, army, err
@@ -82,6 +84,12 @@ func (s *srvArmy) Command(ctx context.Context, req *proto.ArmyCommandReq) (*prot
 	if err != nil {
 		return nil, err
 	}
+	if req.Region != "" && req.Region != s.w.Name() {
+		if err = army.DeferAbroad(s.w, req.Region, req.Cell, uint(req.Action)); err != nil {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return &proto.None{}, nil
+	}
 	target := s.w.CityGet(req.Target)
 	if target == nil {
 		return nil, status.Errorf(codes.NotFound, "Target Not found")
@@ -114,3 +122,75 @@ func (s *srvArmy) Command(ctx context.Context, req *proto.ArmyCommandReq) (*prot
 		return &proto.None{}, nil
 	}
 }
+
+func (s *srvArmy) ListCommands(ctx context.Context, req *proto.ArmyId) (*proto.ListOfArmyCommands, error) {
+	s.w.RLock()
+	defer s.w.RUnlock()
+
+	_, army, err := s.getAndCheckArmy(req)
+	if err != nil {
+		return nil, err
+	}
+
+	rep := &proto.ListOfArmyCommands{}
+	for idx, cmd := range army.Targets {
+		view := &proto.ArmyCommandView{
+			Index: uint32(idx), Cell: cmd.Cell, Action: uint64(cmd.Action), Region: cmd.Region,
+		}
+		if cmd.Region != "" {
+			// The Cell is in another Region
+		} else if c := s.w.CityAt(cmd.Cell); c != nil {
+			view.City = c.Id
+		}
+		rep.Items = append(rep.Items, view)
+	}
+	return rep, nil
+}
+
+func (s *srvArmy) ReorderCommand(ctx context.Context, req *proto.ArmyCommandReorderReq) (*proto.None, error) {
+	s.w.WLock()
+	defer s.w.WUnlock()
+
+	_, army, err := s.getAndCheckArmy(req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	err = army.ReorderCommand(s.w, int(req.From), int(req.To))
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "%s", err.Error())
+	}
+	return &proto.None{}, nil
+}
+
+func (s *srvArmy) CancelCommand(ctx context.Context, req *proto.ArmyCommandCancelReq) (*proto.None, error) {
+	s.w.WLock()
+	defer s.w.WUnlock()
+
+	_, army, err := s.getAndCheckArmy(req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	err = army.CancelCommand(s.w, int(req.Index))
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "%s", err.Error())
+	}
+	return &proto.None{}, nil
+}
+
+func (s *srvArmy) SetPosture(ctx context.Context, req *proto.ArmyPostureReq) (*proto.None, error) {
+	s.w.WLock()
+	defer s.w.WUnlock()
+
+	_, army, err := s.getAndCheckArmy(req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	err = army.SetPosture(s.w, req.Target, req.Posture)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "%s", err.Error())
+	}
+	return &proto.None{}, nil
+}
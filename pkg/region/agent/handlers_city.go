@@ -19,11 +19,26 @@ type srvCity struct {
 	w   *region.World
 }
 
+// Fetch the City managed by the Character, with the failures as gRPC statuses
+func cityGetAndCheck(w *region.World, idChar, idCity uint64) (*region.City, error) {
+	city, err := w.CityGetAndCheck(idChar, idCity)
+	switch err {
+	case nil:
+		return city, nil
+	case region.ErrCityNotFound:
+		return nil, status.Error(codes.NotFound, err.Error())
+	case region.ErrCityForbidden:
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	default:
+		return nil, err
+	}
+}
+
 func (s *srvCity) ListArmies(ctx context.Context, req *proto.CityId) (*proto.ListOfNamedItems, error) {
 	s.w.RLock()
 	defer s.w.RUnlock()
 
-	city, err := s.w.CityGetAndCheck(req.Character, req.City)
+	city, err := cityGetAndCheck(s.w, req.Character, req.City)
 	if err != nil {
 		return nil, err
 	}
@@ -52,7 +67,7 @@ func (s *srvCity) Show(ctx context.Context, req *proto.CityId) (*proto.CityView,
 	s.w.RLock()
 	defer s.w.RUnlock()
 
-	city, err := s.w.CityGetAndCheck(req.Character, req.City)
+	city, err := cityGetAndCheck(s.w, req.Character, req.City)
 	if err != nil {
 		return nil, err
 	}
@@ -65,7 +80,7 @@ func (s *srvCity) Study(ctx context.Context, req *proto.StudyReq) (*proto.None,
 	s.w.RLock()
 	defer s.w.RUnlock()
 
-	city, err := s.w.CityGetAndCheck(req.Character, req.City)
+	city, err := cityGetAndCheck(s.w, req.Character, req.City)
 	if err != nil {
 		return nil, err
 	}
@@ -78,7 +93,7 @@ func (s *srvCity) Build(ctx context.Context, req *proto.BuildReq) (*proto.None,
 	s.w.RLock()
 	defer s.w.RUnlock()
 
-	city, err := s.w.CityGetAndCheck(req.Character, req.City)
+	city, err := cityGetAndCheck(s.w, req.Character, req.City)
 	if err != nil {
 		return nil, err
 	}
@@ -91,7 +106,7 @@ func (s *srvCity) Train(ctx context.Context, req *proto.TrainReq) (*proto.None,
 	s.w.RLock()
 	defer s.w.RUnlock()
 
-	city, err := s.w.CityGetAndCheck(req.Character, req.City)
+	city, err := cityGetAndCheck(s.w, req.Character, req.City)
 	if err != nil {
 		return nil, err
 	}
@@ -104,7 +119,7 @@ func (s *srvCity) CreateArmy(ctx context.Context, req *proto.CreateArmyReq) (*pr
 	s.w.WLock()
 	defer s.w.WUnlock()
 
-	city, err := s.w.CityGetAndCheck(req.Character, req.City)
+	city, err := cityGetAndCheck(s.w, req.Character, req.City)
 	if err != nil {
 		return nil, err
 	}
@@ -130,7 +145,7 @@ func (s *srvCity) TransferUnit(ctx context.Context, req *proto.TransferUnitReq)
 	s.w.WLock()
 	defer s.w.WUnlock()
 
-	city, err := s.w.CityGetAndCheck(req.Character, req.City)
+	city, err := cityGetAndCheck(s.w, req.Character, req.City)
 	if err != nil {
 		return nil, err
 	}
@@ -152,18 +167,19 @@ func (s *srvCity) CreateTransport(ctx context.Context, req *proto.CreateTranspor
 	s.w.WLock()
 	defer s.w.WUnlock()
 
-	city, err := s.w.CityGetAndCheck(req.Character, req.City)
+	city, err := cityGetAndCheck(s.w, req.Character, req.City)
 	if err != nil {
 		return nil, err
 	}
 
-	r := resAbsP2M(req.Stock)
-	if !city.Stock.GreaterOrEqualTo(r) {
-		return nil, status.Errorf(codes.FailedPrecondition, "Insufficient resources")
+	target := s.w.CityGet(req.Target)
+	if target == nil {
+		return nil, status.Errorf(codes.NotFound, "Target not found (id %v)", req.Target)
+	}
+	_, err = city.SendResourcesTo(s.w, req.Name, target, resAbsP2M(req.Stock))
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "Transport error: %s", err.Error())
 	}
-	army, err := s.w.ArmyCreate(city, req.Name)
-	city.Stock.Remove(r)
-	army.Stock.Add(r)
 	return &proto.None{}, nil
 }
 
@@ -171,7 +187,7 @@ func (s *srvCity) TransferResources(ctx context.Context, req *proto.TransferReso
 	s.w.WLock()
 	defer s.w.WUnlock()
 
-	city, err := s.w.CityGetAndCheck(req.Character, req.City)
+	city, err := cityGetAndCheck(s.w, req.Character, req.City)
 	if err != nil {
 		return nil, err
 	}
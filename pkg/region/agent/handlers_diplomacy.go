@@ -0,0 +1,88 @@
+// Copyright (C) 2018-2020 Hegemonie's AUTHORS
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package hegemonie_region_agent
+
+import (
+	"context"
+	"github.com/jfsmig/hegemonie/pkg/region/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	proto "github.com/jfsmig/hegemonie/pkg/region/proto"
+)
+
+type srvDiplomacy struct {
+	cfg *regionConfig
+	w   *region.World
+}
+
+func (s *srvDiplomacy) Propose(ctx context.Context, req *proto.TreatyReq) (*proto.TreatyId, error) {
+	s.w.WLock()
+	defer s.w.WUnlock()
+
+	city, err := cityGetAndCheck(s.w, req.Character, req.City)
+	if err != nil {
+		return nil, err
+	}
+	target := s.w.CityGet(req.Target)
+	if target == nil {
+		return nil, status.Error(codes.NotFound, "Target not found")
+	}
+	t, err := city.TreatyPropose(s.w, target, req.Kind)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "Treaty error: %s", err.Error())
+	}
+	return &proto.TreatyId{Character: req.Character, City: req.City, Treaty: t.Id}, nil
+}
+
+func (s *srvDiplomacy) Accept(ctx context.Context, req *proto.TreatyId) (*proto.None, error) {
+	s.w.WLock()
+	defer s.w.WUnlock()
+
+	city, err := cityGetAndCheck(s.w, req.Character, req.City)
+	if err != nil {
+		return nil, err
+	}
+	if err = city.TreatyAccept(s.w, req.Treaty); err == region.ErrTreatyNotFound {
+		return nil, status.Error(codes.NotFound, err.Error())
+	} else if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "Treaty error: %s", err.Error())
+	}
+	return &proto.None{}, nil
+}
+
+func (s *srvDiplomacy) Break(ctx context.Context, req *proto.TreatyId) (*proto.None, error) {
+	s.w.WLock()
+	defer s.w.WUnlock()
+
+	city, err := cityGetAndCheck(s.w, req.Character, req.City)
+	if err != nil {
+		return nil, err
+	}
+	if err = city.TreatyBreak(s.w, req.Treaty); err == region.ErrTreatyNotFound {
+		return nil, status.Error(codes.NotFound, err.Error())
+	} else if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "Treaty error: %s", err.Error())
+	}
+	return &proto.None{}, nil
+}
+
+func (s *srvDiplomacy) List(ctx context.Context, req *proto.CityId) (*proto.ListOfTreaties, error) {
+	s.w.RLock()
+	defer s.w.RUnlock()
+
+	city, err := cityGetAndCheck(s.w, req.Character, req.City)
+	if err != nil {
+		return nil, err
+	}
+	rep := &proto.ListOfTreaties{}
+	for _, t := range city.Treaties(s.w) {
+		rep.Items = append(rep.Items, &proto.TreatyView{
+			Id: t.Id, Kind: t.Kind, Proposer: t.Proposer, Target: t.Target, Accepted: t.Accepted,
+		})
+	}
+	return rep, nil
+}
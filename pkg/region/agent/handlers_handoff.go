@@ -0,0 +1,116 @@
+// Copyright (C) 2018-2020 Hegemonie's AUTHORS
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package hegemonie_region_agent
+
+import (
+	"context"
+	"github.com/jfsmig/hegemonie/pkg/region/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	proto "github.com/jfsmig/hegemonie/pkg/region/proto"
+)
+
+// The pseudo-methods of the journal entries for the outcomes of the
+// transfers of Armies to other Regions
+const (
+	methodHandoffRelease = "handoff/release"
+	methodHandoffAbort   = "handoff/abort"
+	methodHandoffDone    = "handoff/done"
+)
+
+type srvHandoff struct {
+	cfg *regionConfig
+	w   *region.World
+}
+
+func (s *srvHandoff) Accept(ctx context.Context, req *proto.HandoffReq) (*proto.HandoffRep, error) {
+	if req.Id == nil || req.Army == nil {
+		return nil, status.Error(codes.InvalidArgument, "Malformed request")
+	}
+
+	s.w.WLock()
+	defer s.w.WUnlock()
+
+	id, err := s.w.HandoffAccept(req.Id.Region, req.Id.Id, req.Cell, armyP2M(req.Army))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &proto.HandoffRep{Army: id}, nil
+}
+
+func (s *srvHandoff) Commit(ctx context.Context, req *proto.HandoffId) (*proto.None, error) {
+	s.w.WLock()
+	defer s.w.WUnlock()
+
+	if err := s.w.HandoffCommit(req.Region, req.Id); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &proto.None{}, nil
+}
+
+// The outcomes of the transfers, applied by the sender loop and replayed
+// from the journal.
+
+func (s *srvHandoff) release(ctx context.Context, req *proto.HandoffStatus) error {
+	s.w.WLock()
+	defer s.w.WUnlock()
+	return s.w.HandoffRelease(req.Id, req.Army)
+}
+
+func (s *srvHandoff) abort(ctx context.Context, req *proto.HandoffStatus) error {
+	s.w.WLock()
+	defer s.w.WUnlock()
+	return s.w.HandoffAbort(req.Id)
+}
+
+func (s *srvHandoff) done(ctx context.Context, req *proto.HandoffStatus) error {
+	s.w.WLock()
+	defer s.w.WUnlock()
+	return s.w.HandoffDone(req.Id)
+}
+
+func armyM2P(a *region.Army) *proto.HandoffArmy {
+	out := &proto.HandoffArmy{
+		Name:     a.Name,
+		City:     a.City,
+		Region:   a.Region,
+		Stock:    resAbsM2P(a.Stock),
+		Postures: a.Postures,
+
+		DefaultPosture: a.DefaultPosture,
+	}
+	for _, u := range a.Units {
+		out.Units = append(out.Units, &proto.HandoffUnit{IdType: u.Type, Ticks: u.Ticks, Health: u.Health})
+	}
+	for _, cmd := range a.Targets {
+		out.Targets = append(out.Targets, &proto.HandoffCommand{
+			Region: cmd.Region, Cell: cmd.Cell, Action: uint64(cmd.Action)})
+	}
+	return out
+}
+
+func armyP2M(a *proto.HandoffArmy) *region.Army {
+	out := &region.Army{
+		Name:     a.Name,
+		City:     a.City,
+		Region:   a.Region,
+		Postures: a.Postures,
+
+		DefaultPosture: a.DefaultPosture,
+	}
+	if a.Stock != nil {
+		out.Stock = resAbsP2M(a.Stock)
+	}
+	for _, u := range a.Units {
+		out.Units = append(out.Units, &region.Unit{Type: u.IdType, Ticks: u.Ticks, Health: u.Health})
+	}
+	for _, cmd := range a.Targets {
+		out.Targets = append(out.Targets, region.Command{
+			Region: cmd.Region, Cell: cmd.Cell, Action: uint(cmd.Action)})
+	}
+	return out
+}
@@ -0,0 +1,73 @@
+// Copyright (C) 2018-2020 Hegemonie's AUTHORS
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package hegemonie_region_agent
+
+import (
+	"context"
+	"github.com/jfsmig/hegemonie/pkg/region/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	proto "github.com/jfsmig/hegemonie/pkg/region/proto"
+)
+
+type srvMap struct {
+	cfg *regionConfig
+	w   *region.World
+}
+
+func (s *srvMap) Cells(ctx context.Context, req *proto.MapReq) (*proto.MapView, error) {
+	s.w.RLock()
+	defer s.w.RUnlock()
+
+	cells := s.w.Places.Cells
+	if req.Center != 0 {
+		var err error
+		cells, err = s.w.Places.CellsAround(req.Center, req.Radius)
+		if err != nil {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+	}
+
+	rep := &proto.MapView{}
+	for _, c := range cells.Slice(req.Marker, ClampU32(req.Max, 1, 1000)) {
+		view := &proto.CellView{Id: c.Id, Biome: c.Biome, X: c.X, Y: c.Y, City: c.City}
+		if c.Link != nil {
+			view.Link = c.Link.Region
+		}
+		rep.Cells = append(rep.Cells, view)
+
+		for i := s.w.Places.Roads.First(c.Id); i < len(s.w.Places.Roads); i++ {
+			r := s.w.Places.Roads[i]
+			if r.S != c.Id {
+				break
+			}
+			if !r.Deleted && cells.Has(r.D) {
+				cost, _ := s.w.Places.StepCost(r.S, r.D)
+				rep.Roads = append(rep.Roads, &proto.RoadView{Src: r.S, Dst: r.D, Cost: cost})
+			}
+		}
+	}
+	return rep, nil
+}
+
+func (s *srvMap) Cities(ctx context.Context, req *proto.PaginatedQuery) (*proto.ListOfCityPositions, error) {
+	s.w.RLock()
+	defer s.w.RUnlock()
+
+	rep := &proto.ListOfCityPositions{}
+	for _, c := range s.w.Live.Cities.Slice(req.Marker, ClampU32(req.Max, 1, 1000)) {
+		if c.Deleted {
+			continue
+		}
+		view := &proto.CityPosition{Id: c.Id, Name: c.Name, Cell: c.Cell}
+		if cell := s.w.Places.CellGet(c.Cell); cell != nil {
+			view.X, view.Y = cell.X, cell.Y
+		}
+		rep.Items = append(rep.Items, view)
+	}
+	return rep, nil
+}
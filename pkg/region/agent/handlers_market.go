@@ -0,0 +1,63 @@
+// Copyright (C) 2018-2020 Hegemonie's AUTHORS
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package hegemonie_region_agent
+
+import (
+	"context"
+	"github.com/jfsmig/hegemonie/pkg/region/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	proto "github.com/jfsmig/hegemonie/pkg/region/proto"
+)
+
+type srvMarket struct {
+	cfg *regionConfig
+	w   *region.World
+}
+
+func (s *srvMarket) Place(ctx context.Context, req *proto.MarketOrderReq) (*proto.MarketOrderId, error) {
+	s.w.WLock()
+	defer s.w.WUnlock()
+
+	city, err := cityGetAndCheck(s.w, req.Character, req.City)
+	if err != nil {
+		return nil, err
+	}
+	o, err := city.OrderPlace(s.w, req.Resource, req.Bid, req.Quantity, req.Price)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "Order error: %s", err.Error())
+	}
+	return &proto.MarketOrderId{Character: req.Character, City: req.City, Order: o.Id}, nil
+}
+
+func (s *srvMarket) Cancel(ctx context.Context, req *proto.MarketOrderId) (*proto.None, error) {
+	s.w.WLock()
+	defer s.w.WUnlock()
+
+	city, err := cityGetAndCheck(s.w, req.Character, req.City)
+	if err != nil {
+		return nil, err
+	}
+	if err = city.OrderCancel(s.w, req.Order); err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	return &proto.None{}, nil
+}
+
+func (s *srvMarket) List(ctx context.Context, req *proto.PaginatedQuery) (*proto.ListOfMarketOrders, error) {
+	s.w.RLock()
+	defer s.w.RUnlock()
+
+	rep := &proto.ListOfMarketOrders{Currency: s.w.Definitions.MarketCurrency}
+	for _, o := range s.w.OrderSlice(req.Marker, ClampU32(req.Max, 1, 1000)) {
+		rep.Items = append(rep.Items, &proto.MarketOrderView{
+			Id: o.Id, City: o.City, Resource: o.Resource, Bid: o.Bid,
+			Quantity: o.Quantity, Price: o.Price,
+		})
+	}
+	return rep, nil
+}
@@ -0,0 +1,164 @@
+// Copyright (C) 2018-2020 Hegemonie's AUTHORS
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package hegemonie_region_agent
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	auth "github.com/jfsmig/hegemonie/pkg/auth/proto"
+	"github.com/jfsmig/hegemonie/pkg/region/model"
+	pb "github.com/jfsmig/hegemonie/pkg/region/proto"
+)
+
+// A transfer of the Outbox, copied out of the World
+type pendingHandoff struct {
+	id     uint64
+	region string
+	cell   uint64
+	remote uint64
+	army   *pb.HandoffArmy
+}
+
+// Periodically send the Armies standing on the borders to the Regions beyond,
+// until 'stop' is closed. The peers are found in the directory of the auth
+// service. A transfer is retried until the peer either accepts or refuses it.
+func (self *regionConfig) handoff(w *region.World, srv *srvHandoff, stop <-chan struct{}) {
+	if self.auth == "" || self.periodHandoff <= 0 {
+		return
+	}
+	cnx, err := grpc.Dial(self.auth, grpc.WithInsecure())
+	if err != nil {
+		log.Printf("Handoff disabled: %s", err.Error())
+		return
+	}
+	defer cnx.Close()
+
+	peers := make(map[string]*grpc.ClientConn)
+	defer func() {
+		for _, c := range peers {
+			c.Close()
+		}
+	}()
+
+	ticker := time.NewTicker(self.periodHandoff)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+
+		pending := outbox(w)
+		if len(pending) == 0 {
+			continue
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), self.periodHandoff)
+		rep, err := auth.NewAuthClient(cnx).RegionList(ctx, &auth.None{})
+		cancel()
+		if err != nil {
+			log.Printf("Handoff error (directory): %s", err.Error())
+			continue
+		}
+		endpoints := make(map[string]string)
+		for _, r := range rep.Items {
+			endpoints[r.Name] = r.Endpoint
+		}
+
+		for _, h := range pending {
+			endpoint, ok := endpoints[h.region]
+			if !ok {
+				log.Printf("Handoff %d: region %s unavailable", h.id, h.region)
+				continue
+			}
+			peer, ok := peers[endpoint]
+			if !ok {
+				if peer, err = grpc.Dial(endpoint, grpc.WithInsecure()); err != nil {
+					log.Printf("Handoff %d: %s", h.id, err.Error())
+					continue
+				}
+				peers[endpoint] = peer
+			}
+			if err = self.send(w, srv, pb.NewHandoffClient(peer), h); err != nil {
+				log.Printf("Handoff %d: %s", h.id, err.Error())
+			}
+		}
+	}
+}
+
+// Play both phases of the transfer, each outcome journaled before it is
+// applied. The Army is released once accepted, and restored if refused.
+func (self *regionConfig) send(w *region.World, srv *srvHandoff, cli pb.HandoffClient, h pendingHandoff) error {
+	if h.army != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), self.periodHandoff)
+		rep, err := cli.Accept(ctx, &pb.HandoffReq{
+			Id:   &pb.HandoffId{Region: self.name, Id: h.id},
+			Cell: h.cell, Army: h.army,
+		})
+		cancel()
+		switch status.Code(err) {
+		case codes.OK:
+		case codes.InvalidArgument, codes.FailedPrecondition, codes.NotFound, codes.Unimplemented:
+			log.Printf("Handoff %d refused by %s: %s", h.id, h.region, err.Error())
+			return self.local(w, methodHandoffAbort, &pb.HandoffStatus{Id: h.id}, func(ctx context.Context) error {
+				return srv.abort(ctx, &pb.HandoffStatus{Id: h.id})
+			})
+		default:
+			// Retried at the next period
+			return err
+		}
+		h.remote = rep.Army
+		st := &pb.HandoffStatus{Id: h.id, Army: h.remote}
+		err = self.local(w, methodHandoffRelease, st, func(ctx context.Context) error {
+			return srv.release(ctx, st)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), self.periodHandoff)
+	_, err := cli.Commit(ctx, &pb.HandoffId{Region: self.name, Id: h.id})
+	cancel()
+	if err != nil {
+		return err
+	}
+	st := &pb.HandoffStatus{Id: h.id, Army: h.remote}
+	return self.local(w, methodHandoffDone, st, func(ctx context.Context) error {
+		return srv.done(ctx, st)
+	})
+}
+
+// Apply an operation decided by the Region, journaled if there is a journal
+func (self *regionConfig) local(w *region.World, method string, req proto.Message, call func(ctx context.Context) error) error {
+	if self.journal == nil {
+		return call(context.Background())
+	}
+	return self.journal.local(w, method, req, call)
+}
+
+func outbox(w *region.World) []pendingHandoff {
+	w.RLock()
+	defer w.RUnlock()
+
+	out := make([]pendingHandoff, 0, len(w.Live.Outbox))
+	for _, h := range w.Live.Outbox {
+		p := pendingHandoff{id: h.Id, region: h.Region, cell: h.Cell, remote: h.Remote}
+		if a := w.HandoffExport(h); a != nil {
+			p.army = armyM2P(a)
+		}
+		out = append(out, p)
+	}
+	return out
+}
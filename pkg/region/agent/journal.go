@@ -0,0 +1,397 @@
+// Copyright (C) 2018-2020 Hegemonie's AUTHORS
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package hegemonie_region_agent
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/jfsmig/hegemonie/pkg/region/model"
+	pb "github.com/jfsmig/hegemonie/pkg/region/proto"
+)
+
+// The pseudo-method of the journal entries for the scheduled ticks
+const methodTick = "tick"
+
+// One operation applied on the World: either a mutating RPC, with its
+// marshalled request, or a scheduled tick.
+type journalEntry struct {
+	Seq      uint64
+	At       time.Time
+	Method   string
+	Req      []byte           `json:",omitempty"`
+	Schedule *region.Schedule `json:",omitempty"`
+}
+
+// The write-ahead log of the operations applied on the World since its last
+// snapshot. Each entry is written and synced before its operation is applied.
+// The lock serializes the mutations so that the journal reflects the order of
+// their application.
+type journal struct {
+	sync.Mutex
+	path string
+	out  *os.File
+}
+
+type replayer struct {
+	req  func() proto.Message
+	call func(ctx context.Context, req proto.Message) error
+}
+
+type ctxKeyNow struct{}
+
+// Return the moment the current operation happens: the time of the journal
+// entry during a replay, the current time otherwise.
+func now(ctx context.Context) time.Time {
+	if t, ok := ctx.Value(ctxKeyNow{}).(time.Time); ok {
+		return t
+	}
+	return time.Now()
+}
+
+func openJournal(path string) (*journal, error) {
+	out, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, err
+	}
+	return &journal{path: path, out: out}, nil
+}
+
+func (j *journal) Close() error {
+	return j.out.Close()
+}
+
+// Write the entry and flush it on the storage. The caller must hold the lock.
+func (j *journal) append(w *region.World, e *journalEntry) error {
+	w.WLock()
+	e.Seq = w.JournalSeq + 1
+	w.WUnlock()
+
+	encoded, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	if _, err = j.out.Write(append(encoded, '\n')); err != nil {
+		return err
+	}
+	if err = j.out.Sync(); err != nil {
+		return err
+	}
+
+	w.WLock()
+	w.JournalSeq = e.Seq
+	w.WUnlock()
+	return nil
+}
+
+// Drop all the entries, once they are part of a snapshot. The caller must
+// hold the lock.
+func (j *journal) reset() error {
+	if err := j.out.Truncate(0); err != nil {
+		return err
+	}
+	return j.out.Sync()
+}
+
+// Journal the mutating RPC before applying it
+func (j *journal) intercept(w *region.World, replayers map[string]replayer) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if _, ok := replayers[info.FullMethod]; !ok {
+			return handler(ctx, req)
+		}
+
+		j.Lock()
+		defer j.Unlock()
+
+		e := journalEntry{At: time.Now(), Method: info.FullMethod}
+		encoded, err := proto.Marshal(req.(proto.Message))
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Malformed request: %s", err.Error())
+		}
+		e.Req = encoded
+		if err = j.append(w, &e); err != nil {
+			return nil, status.Errorf(codes.Unavailable, "Journal error: %s", err.Error())
+		}
+		return handler(context.WithValue(ctx, ctxKeyNow{}, e.At), req)
+	}
+}
+
+// Journal and play the ticks due at the given moment
+func (j *journal) tick(w *region.World, at time.Time, s region.Schedule) (int, error) {
+	j.Lock()
+	defer j.Unlock()
+
+	if !w.Due(at, s) {
+		return 0, nil
+	}
+	e := journalEntry{At: at, Method: methodTick, Schedule: &s}
+	if err := j.append(w, &e); err != nil {
+		return 0, err
+	}
+	return w.Advance(at, s), nil
+}
+
+// Journal and apply an operation decided by the Region itself, replayed
+// like the RPC of the same method.
+func (j *journal) local(w *region.World, method string, req proto.Message, call func(ctx context.Context) error) error {
+	j.Lock()
+	defer j.Unlock()
+
+	e := journalEntry{At: time.Now(), Method: method}
+	encoded, err := proto.Marshal(req)
+	if err != nil {
+		return err
+	}
+	e.Req = encoded
+	if err = j.append(w, &e); err != nil {
+		return err
+	}
+	return call(context.WithValue(context.Background(), ctxKeyNow{}, e.At))
+}
+
+// Apply on the World the entries of the journal it doesn't contain yet.
+// A torn entry at the end of the journal, e.g. after a power loss, is dropped.
+func (j *journal) replay(w *region.World, replayers map[string]replayer) (int, error) {
+	j.Lock()
+	defer j.Unlock()
+
+	in, err := os.Open(j.path)
+	if err != nil {
+		return 0, err
+	}
+	defer in.Close()
+
+	var offset int64
+	nb := 0
+	r := bufio.NewReader(in)
+	for {
+		line, err := r.ReadBytes('\n')
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nb, err
+		}
+
+		var e journalEntry
+		if err = json.Unmarshal(bytes.TrimSpace(line), &e); err != nil {
+			break
+		}
+		offset += int64(len(line))
+		if e.Seq <= w.JournalSeq {
+			continue
+		}
+		if e.Seq != w.JournalSeq+1 {
+			return nb, errors.New(fmt.Sprintf("Gap between the World (%d) and the journal (%d)", w.JournalSeq, e.Seq))
+		}
+
+		if e.Method == methodTick {
+			if e.Schedule != nil {
+				w.Advance(e.At, *e.Schedule)
+			}
+		} else if rp, ok := replayers[e.Method]; !ok {
+			log.Printf("Journal entry %d: unknown method %s", e.Seq, e.Method)
+		} else {
+			req := rp.req()
+			if err = proto.Unmarshal(e.Req, req); err != nil {
+				log.Printf("Journal entry %d: malformed request: %s", e.Seq, err.Error())
+			} else if err = rp.call(context.WithValue(context.Background(), ctxKeyNow{}, e.At), req); err != nil {
+				log.Printf("Journal entry %d: %s", e.Seq, err.Error())
+			}
+		}
+		w.WLock()
+		w.JournalSeq = e.Seq
+		w.WUnlock()
+		nb++
+	}
+
+	return nb, j.out.Truncate(offset)
+}
+
+// The mutating methods of the region services, with the way to replay them
+func makeReplayers(city *srvCity, army *srvArmy, admin *srvAdmin, handoff *srvHandoff, market *srvMarket, diplomacy *srvDiplomacy) map[string]replayer {
+	const prefix = "/hegemonie.region.proto."
+	return map[string]replayer{
+		prefix + "City/Study": {
+			func() proto.Message { return &pb.StudyReq{} },
+			func(ctx context.Context, req proto.Message) error {
+				_, err := city.Study(ctx, req.(*pb.StudyReq))
+				return err
+			}},
+		prefix + "City/Build": {
+			func() proto.Message { return &pb.BuildReq{} },
+			func(ctx context.Context, req proto.Message) error {
+				_, err := city.Build(ctx, req.(*pb.BuildReq))
+				return err
+			}},
+		prefix + "City/Train": {
+			func() proto.Message { return &pb.TrainReq{} },
+			func(ctx context.Context, req proto.Message) error {
+				_, err := city.Train(ctx, req.(*pb.TrainReq))
+				return err
+			}},
+		prefix + "City/CreateArmy": {
+			func() proto.Message { return &pb.CreateArmyReq{} },
+			func(ctx context.Context, req proto.Message) error {
+				_, err := city.CreateArmy(ctx, req.(*pb.CreateArmyReq))
+				return err
+			}},
+		prefix + "City/CreateTransport": {
+			func() proto.Message { return &pb.CreateTransportReq{} },
+			func(ctx context.Context, req proto.Message) error {
+				_, err := city.CreateTransport(ctx, req.(*pb.CreateTransportReq))
+				return err
+			}},
+		prefix + "City/TransferUnit": {
+			func() proto.Message { return &pb.TransferUnitReq{} },
+			func(ctx context.Context, req proto.Message) error {
+				_, err := city.TransferUnit(ctx, req.(*pb.TransferUnitReq))
+				return err
+			}},
+		prefix + "City/TransferResources": {
+			func() proto.Message { return &pb.TransferResourcesReq{} },
+			func(ctx context.Context, req proto.Message) error {
+				_, err := city.TransferResources(ctx, req.(*pb.TransferResourcesReq))
+				return err
+			}},
+		prefix + "Army/Flea": {
+			func() proto.Message { return &pb.ArmyId{} },
+			func(ctx context.Context, req proto.Message) error {
+				_, err := army.Flea(ctx, req.(*pb.ArmyId))
+				return err
+			}},
+		prefix + "Army/Flip": {
+			func() proto.Message { return &pb.ArmyId{} },
+			func(ctx context.Context, req proto.Message) error {
+				_, err := army.Flip(ctx, req.(*pb.ArmyId))
+				return err
+			}},
+		prefix + "Army/Command": {
+			func() proto.Message { return &pb.ArmyCommandReq{} },
+			func(ctx context.Context, req proto.Message) error {
+				_, err := army.Command(ctx, req.(*pb.ArmyCommandReq))
+				return err
+			}},
+		prefix + "Army/ReorderCommand": {
+			func() proto.Message { return &pb.ArmyCommandReorderReq{} },
+			func(ctx context.Context, req proto.Message) error {
+				_, err := army.ReorderCommand(ctx, req.(*pb.ArmyCommandReorderReq))
+				return err
+			}},
+		prefix + "Army/CancelCommand": {
+			func() proto.Message { return &pb.ArmyCommandCancelReq{} },
+			func(ctx context.Context, req proto.Message) error {
+				_, err := army.CancelCommand(ctx, req.(*pb.ArmyCommandCancelReq))
+				return err
+			}},
+		prefix + "Army/SetPosture": {
+			func() proto.Message { return &pb.ArmyPostureReq{} },
+			func(ctx context.Context, req proto.Message) error {
+				_, err := army.SetPosture(ctx, req.(*pb.ArmyPostureReq))
+				return err
+			}},
+		prefix + "Admin/Produce": {
+			func() proto.Message { return &pb.None{} },
+			func(ctx context.Context, req proto.Message) error {
+				_, err := admin.Produce(ctx, req.(*pb.None))
+				return err
+			}},
+		prefix + "Admin/Move": {
+			func() proto.Message { return &pb.None{} },
+			func(ctx context.Context, req proto.Message) error {
+				_, err := admin.Move(ctx, req.(*pb.None))
+				return err
+			}},
+		prefix + "Admin/Pause": {
+			func() proto.Message { return &pb.None{} },
+			func(ctx context.Context, req proto.Message) error {
+				_, err := admin.Pause(ctx, req.(*pb.None))
+				return err
+			}},
+		prefix + "Admin/Resume": {
+			func() proto.Message { return &pb.None{} },
+			func(ctx context.Context, req proto.Message) error {
+				_, err := admin.Resume(ctx, req.(*pb.None))
+				return err
+			}},
+		prefix + "Admin/CatchUp": {
+			func() proto.Message { return &pb.CatchUpReq{} },
+			func(ctx context.Context, req proto.Message) error {
+				_, err := admin.CatchUp(ctx, req.(*pb.CatchUpReq))
+				return err
+			}},
+		prefix + "Market/Place": {
+			func() proto.Message { return &pb.MarketOrderReq{} },
+			func(ctx context.Context, req proto.Message) error {
+				_, err := market.Place(ctx, req.(*pb.MarketOrderReq))
+				return err
+			}},
+		prefix + "Market/Cancel": {
+			func() proto.Message { return &pb.MarketOrderId{} },
+			func(ctx context.Context, req proto.Message) error {
+				_, err := market.Cancel(ctx, req.(*pb.MarketOrderId))
+				return err
+			}},
+		prefix + "Diplomacy/Propose": {
+			func() proto.Message { return &pb.TreatyReq{} },
+			func(ctx context.Context, req proto.Message) error {
+				_, err := diplomacy.Propose(ctx, req.(*pb.TreatyReq))
+				return err
+			}},
+		prefix + "Diplomacy/Accept": {
+			func() proto.Message { return &pb.TreatyId{} },
+			func(ctx context.Context, req proto.Message) error {
+				_, err := diplomacy.Accept(ctx, req.(*pb.TreatyId))
+				return err
+			}},
+		prefix + "Diplomacy/Break": {
+			func() proto.Message { return &pb.TreatyId{} },
+			func(ctx context.Context, req proto.Message) error {
+				_, err := diplomacy.Break(ctx, req.(*pb.TreatyId))
+				return err
+			}},
+		prefix + "Handoff/Accept": {
+			func() proto.Message { return &pb.HandoffReq{} },
+			func(ctx context.Context, req proto.Message) error {
+				_, err := handoff.Accept(ctx, req.(*pb.HandoffReq))
+				return err
+			}},
+		prefix + "Handoff/Commit": {
+			func() proto.Message { return &pb.HandoffId{} },
+			func(ctx context.Context, req proto.Message) error {
+				_, err := handoff.Commit(ctx, req.(*pb.HandoffId))
+				return err
+			}},
+		methodHandoffRelease: {
+			func() proto.Message { return &pb.HandoffStatus{} },
+			func(ctx context.Context, req proto.Message) error {
+				return handoff.release(ctx, req.(*pb.HandoffStatus))
+			}},
+		methodHandoffAbort: {
+			func() proto.Message { return &pb.HandoffStatus{} },
+			func(ctx context.Context, req proto.Message) error {
+				return handoff.abort(ctx, req.(*pb.HandoffStatus))
+			}},
+		methodHandoffDone: {
+			func() proto.Message { return &pb.HandoffStatus{} },
+			func(ctx context.Context, req proto.Message) error {
+				return handoff.done(ctx, req.(*pb.HandoffStatus))
+			}},
+	}
+}
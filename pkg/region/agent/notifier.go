@@ -0,0 +1,128 @@
+// Copyright (C) 2018-2020 Hegemonie's AUTHORS
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package hegemonie_region_agent
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"time"
+
+	"google.golang.org/grpc"
+
+	events "github.com/jfsmig/hegemonie/pkg/events/model"
+	pb "github.com/jfsmig/hegemonie/pkg/events/proto"
+	"github.com/jfsmig/hegemonie/pkg/region/model"
+)
+
+// The events also published on the topic of the whole Region
+var regionWide = map[region.EventKind]bool{
+	region.EvtCityConquered: true,
+	region.EvtCityLiberated: true,
+	region.EvtCityFreed:     true,
+}
+
+// Forward the events of the World to the events service, both on the topics
+// and in the inbox of the Characters concerned. The World calls Notify with
+// its lock held, so the events are queued and published by a background
+// goroutine. When the queue is full the events are dropped.
+type publisher struct {
+	region  string
+	queue   chan region.Event
+	done    chan struct{}
+	client  pb.EventsClient
+	reports pb.ReportsClient
+	cnx     *grpc.ClientConn
+}
+
+func newPublisher(endpoint, regionName string, size int) (*publisher, error) {
+	cnx, err := grpc.Dial(endpoint, grpc.WithInsecure())
+	if err != nil {
+		return nil, err
+	}
+	p := &publisher{
+		region:  regionName,
+		queue:   make(chan region.Event, size),
+		done:    make(chan struct{}),
+		client:  pb.NewEventsClient(cnx),
+		reports: pb.NewReportsClient(cnx),
+		cnx:     cnx,
+	}
+	go p.run()
+	return p, nil
+}
+
+func (p *publisher) Notify(evt region.Event) {
+	select {
+	case p.queue <- evt:
+	default:
+		log.Printf("Event dropped, queue full: %s city %d", evt.Kind, evt.City)
+	}
+}
+
+// Publish the queued events then release the connection
+func (p *publisher) Close() {
+	close(p.queue)
+	<-p.done
+	p.cnx.Close()
+}
+
+func (p *publisher) run() {
+	defer close(p.done)
+	for evt := range p.queue {
+		payload, err := json.Marshal(evt)
+		if err != nil {
+			log.Printf("Event dropped, %s", err.Error())
+			continue
+		}
+		for _, topic := range p.topics(evt) {
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			_, err = p.client.Publish(ctx, &pb.PublishReq{
+				Topic: topic, Kind: string(evt.Kind), Payload: payload,
+			})
+			cancel()
+			if err != nil {
+				log.Printf("Event dropped, publication to [%s] failed: %s", topic, err.Error())
+			}
+		}
+		for _, character := range p.characters(evt) {
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			_, err = p.reports.Deliver(ctx, &pb.DeliverReq{
+				Character: character, Region: p.region, City: evt.City,
+				Kind: string(evt.Kind), Payload: payload,
+			})
+			cancel()
+			if err != nil {
+				log.Printf("Report dropped, delivery to %d failed: %s", character, err.Error())
+			}
+		}
+	}
+}
+
+// Return the Characters in charge of the City notified
+func (p *publisher) characters(evt region.Event) []uint64 {
+	out := make([]uint64, 0, 2)
+	if evt.Owner != 0 {
+		out = append(out, evt.Owner)
+	}
+	if evt.Deputy != 0 && evt.Deputy != evt.Owner {
+		out = append(out, evt.Deputy)
+	}
+	return out
+}
+
+// Return the topics an event is published on: the City, the Characters in
+// charge of it and, for the major events, the Region.
+func (p *publisher) topics(evt region.Event) []string {
+	out := []string{events.TopicCity(p.region, evt.City)}
+	for _, character := range p.characters(evt) {
+		out = append(out, events.TopicCharacter(character))
+	}
+	if regionWide[evt.Kind] {
+		out = append(out, events.TopicRegion(p.region))
+	}
+	return out
+}
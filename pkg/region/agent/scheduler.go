@@ -0,0 +1,59 @@
+// Copyright (C) 2018-2020 Hegemonie's AUTHORS
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package hegemonie_region_agent
+
+import (
+	"log"
+	"time"
+
+	"github.com/jfsmig/hegemonie/pkg/region/model"
+)
+
+// Play the ticks of the World as they become due, until 'stop' is closed.
+// The ticks missed while the agent was down are caught up at the first
+// iteration, within the limit of the schedule.
+func (self *regionConfig) schedule(w *region.World, stop <-chan struct{}) {
+	if self.periodMove <= 0 && self.periodProduce <= 0 {
+		return
+	}
+
+	period := time.Second
+	for _, p := range []time.Duration{self.periodMove, self.periodProduce} {
+		if p > 0 && p < period {
+			period = p
+		}
+	}
+	ticker := time.NewTicker(period)
+	defer ticker.Stop()
+
+	for {
+		if n, err := self.tick(w, time.Now()); err != nil {
+			log.Printf("Tick error: %s", err.Error())
+		} else if n > 1 {
+			log.Printf("Caught up %d movement ticks", n)
+		}
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (self *regionConfig) sched() region.Schedule {
+	return region.Schedule{
+		Move:       self.periodMove,
+		Produce:    self.periodProduce,
+		MaxCatchUp: self.maxCatchUp,
+	}
+}
+
+func (self *regionConfig) tick(w *region.World, now time.Time) (int, error) {
+	if self.journal == nil {
+		return w.Advance(now, self.sched()), nil
+	}
+	return self.journal.tick(w, now, self.sched())
+}
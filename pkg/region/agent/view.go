@@ -134,7 +134,7 @@ func ShowAssets(w *region.World, c *region.City) *proto.CityAssets {
 
 	for _, a := range c.Armies() {
 		v.Armies = append(v.Armies, &proto.ArmyView{
-			Id: a.Id, Name: a.Name})
+			Id: a.Id, Name: a.Name, Transport: a.Transport})
 	}
 
 	return v
@@ -146,6 +146,7 @@ func ShowCity(w *region.World, c *region.City) *proto.CityView {
 		Name:   c.Name,
 		Owner:  c.Owner,
 		Deputy: c.Deputy,
+		Cell:   c.Cell,
 
 		Cult:        c.Cult,
 		Chaotic:     c.Chaotic,
@@ -178,6 +179,9 @@ func ShowArmy(w *region.World, a *region.Army) *proto.ArmyView {
 	view.Id = a.Id
 	view.Name = a.Name
 	view.Location = a.Cell
+	view.Transport = a.Transport
+	view.Postures = a.Postures
+	view.DefaultPosture = a.DefaultPosture
 	view.Stock = resAbsM2P(a.Stock)
 	for _, u := range a.Units {
 		view.Units = append(view.Units, ShowUnit(w, u))
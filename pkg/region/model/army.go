@@ -16,41 +16,87 @@ func (a *Army) PopCommand() {
 	a.Targets = a.Targets[1:]
 }
 
+// Let the Army react to the fights and the armies on its Cell, according to
+// its postures. It joins the first running Fight where it takes a side.
+// Otherwise it seizes the freights without escort it assaults, starts a Fight
+// against the first Army it assaults, or else assaults the City on the Cell.
 func (a *Army) ApplyAgressivity(w *World) {
-	// FIXME(jfs): NYI
+	if a.Deleted || a.Fight != 0 || a.Transport || len(a.Units) == 0 {
+		return
+	}
+
+	for _, f := range w.Live.Fights.SliceByCell(a.Cell) {
+		if attack, ok := a.sideIn(w, f); ok {
+			a.joinFight(w, f, attack)
+			return
+		}
+	}
+
+	// Work on a copy because the Fight alters the original set
+	for _, b := range append([]*Army{}, w.armiesAt(a.Cell)...) {
+		if b == a || b.Deleted || b.Cell != a.Cell || a.postureAgainst(w, b) >= 0 {
+			continue
+		}
+		if len(b.Units) > 0 {
+			a.startFight(w, b)
+			return
+		}
+		if !b.Stock.IsZero() {
+			a.seize(w, b)
+		}
+	}
+
+	if pCity := w.CityAt(a.Cell); pCity != nil && !pCity.Deleted && a.posture(w, pCity.Id) < 0 {
+		a.JoinCityAttack(w, pCity)
+	}
 }
 
 func (a *Army) Move(w *World) {
-	if a.Deleted {
+	// The Army may have been drawn in a Fight earlier in the same tick
+	if a.Deleted || a.Fight != 0 {
 		return
 	}
 
+	if len(a.Targets) <= 0 && a.Region != "" {
+		// Abroad, no City commands the Army anymore
+		a.returnHome(w)
+	}
+
 	if len(a.Targets) <= 0 {
 		// The Army has no command pending. It just stays.
+	} else if cmd := a.Targets[0]; cmd.Region != "" {
+		a.moveAbroad(w, cmd)
 	} else {
-		cmd := a.Targets[0]
 		src := a.Cell
 		dst := cmd.Cell
 
-		nxt, err := w.Places.PathNextStep(src, dst)
-		if err != nil {
+		var nxt uint64
+		if src == dst {
+			// Already on the spot, e.g. after a Fight on the target Cell
+			nxt = dst
+		} else if step, err := w.Places.PathNextStep(src, dst); err == ErrNoRoute {
+			w.notify(a.home(w), Event{Kind: EvtArmyNoRoute, Army: a.Id, Cell: dst})
+		} else if err != nil {
 			log.Println("Map error:", err.Error())
-		} else if nxt == 0 {
-			// FIXME(jfs): Notify the City that there is no route
-		} else {
-			a.Cell = nxt
-			// FIXME(jfs): Notify a.City of the movement
-			// FIXME(jfs): Notify the local City of the passage
+		} else if a.stepTo(w, step) {
+			nxt = step
+		}
+
+		if nxt != 0 && a.Transport && a.intercepted(w) {
+			return
 		}
 
-		// pSrc := w.Places.CellGet(src)
-		var pLocalCity *City
-		pLocal := w.Places.CellGet(a.Cell)
-		if pLocal != nil {
-			pLocalCity = w.CityGet(pLocal.City)
+		pLocalCity := w.CityAt(a.Cell)
+		if pLocalCity == nil {
+			// Only the commands that crossed a border may target a Cell
+			// without City, they are checked at the arrival.
+			cmd.Action = CmdPause
 		}
 
 		if nxt == dst {
+			if src != dst {
+				w.notify(a.home(w), Event{Kind: EvtArmyArrived, Army: a.Id, Cell: dst})
+			}
 			var preventPopping bool
 			switch cmd.Action {
 			case CmdPause:
@@ -61,16 +107,17 @@ func (a *Army) Move(w *World) {
 				if a.JoinCityDefence(w, pLocalCity) {
 					preventPopping = true
 				}
-			case CmdCityOverlord:
-				a.Conquer(w, pLocalCity)
-			case CmdCityLiberate:
-				a.Liberate(w, pLocalCity)
-			case CmdCityBreak:
-				a.BreakBuilding(w, pLocalCity)
-			case CmdCityMassacre:
-				a.Massacre(w, pLocalCity)
+			case CmdCityOverlord, CmdCityLiberate, CmdCityBreak, CmdCityMassacre:
+				// The action requires a victory, it will be applied when the
+				// Fight ends.
+				a.JoinCityAttack(w, pLocalCity)
+				preventPopping = true
 			case CmdCityDeposit:
 				a.Deposit(w, pLocalCity)
+				if a.Transport {
+					a.vanish(w)
+					preventPopping = true
+				}
 			case CmdCityDisband:
 				a.Disband(w, pLocalCity)
 			}
@@ -82,18 +129,63 @@ func (a *Army) Move(w *World) {
 	a.ApplyAgressivity(w)
 }
 
+// Make the Army progress on the road to the adjacent Cell, at the speed of its
+// slowest Unit. Return true once the Army entered the Cell.
+func (a *Army) stepTo(w *World, next uint64) bool {
+	cost, err := w.Places.StepCost(a.Cell, next)
+	if err != nil {
+		return false
+	}
+	if a.Toward != next {
+		a.Toward, a.Progress = next, 0
+	}
+	a.Progress += a.Speed(w)
+	if a.Progress < cost {
+		return false
+	}
+
+	a.Toward, a.Progress = 0, 0
+	w.reindexArmy(a, a.Cell, next)
+	a.Cell = next
+	w.notify(a.home(w), Event{Kind: EvtArmyMoved, Army: a.Id, Cell: next})
+	if pLocal := w.CityAt(next); pLocal != nil && pLocal != a.home(w) {
+		w.notify(pLocal, Event{Kind: EvtArmyPassage, Army: a.Id, Other: a.City, Cell: next})
+	}
+	return true
+}
+
+// Return the movement points of the Army per tick: the Speed of its slowest
+// Unit, 1 without Unit.
+func (a *Army) Speed(w *World) uint64 {
+	var speed uint64
+	for _, u := range a.Units {
+		s := uint64(1)
+		if t := w.UnitTypeGet(u.Type); t != nil && t.Speed > 0 {
+			s = t.Speed
+		}
+		if speed == 0 || s < speed {
+			speed = s
+		}
+	}
+	if speed == 0 {
+		return 1
+	}
+	return speed
+}
+
 func (a *Army) Deposit(w *World, pCity *City) {
 	if pCity == nil {
 		panic("Impossible action: nil city")
 	}
 
-	pCity.Stock.Add(a.Stock)
+	amount := a.Stock
+	pCity.Stock.Add(amount)
 	a.Stock.Zero()
 
 	// FIXME(jfs): Popularities
 
-	// FIXME(jfs): Notify pLocalCity
-	// FIXME(jfs): Notify a.City
+	w.notify(pCity, Event{Kind: EvtResourcesReceived, Army: a.Id, Other: a.City, Resources: &amount})
+	w.notify(a.home(w), Event{Kind: EvtResourcesDeposited, Army: a.Id, Other: pCity.Id, Resources: &amount})
 }
 
 func (a *Army) Massacre(w *World, pCity *City) {
@@ -104,8 +196,8 @@ func (a *Army) Massacre(w *World, pCity *City) {
 	pCity.TicksMassacres++
 
 	// FIXME(jfs): Popularities
-	// FIXME(jfs): Notify pLocalCity
-	// FIXME(jfs): Notify a.City
+	w.notify(pCity, Event{Kind: EvtMassacreSuffered, Army: a.Id, Other: a.City})
+	w.notify(a.home(w), Event{Kind: EvtMassacreDone, Army: a.Id, Other: pCity.Id})
 }
 
 func (a *Army) Disband(w *World, pCity *City) {
@@ -120,11 +212,13 @@ func (a *Army) Disband(w *World, pCity *City) {
 		}
 		sort.Sort(pCity.Units)
 		a.Units = a.Units[:0]
-		a.Deleted = true
 
-		// FIXME(jfs): Notify pCity the arrival of 'nb' units
-		// FIXME(jfs): Notify a.City the transfer of 'nb' units
+		if pCity != a.home(w) {
+			w.notify(pCity, Event{Kind: EvtUnitsReceived, Army: a.Id, Other: a.City, Count: nb})
+		}
 	}
+	w.notify(a.home(w), Event{Kind: EvtArmyDisbanded, Army: a.Id, Other: pCity.Id, Count: nb})
+	a.vanish(w)
 }
 
 func (a *Army) BreakBuilding(w *World, pCity *City) {
@@ -132,12 +226,18 @@ func (a *Army) BreakBuilding(w *World, pCity *City) {
 		panic("Impossible action: nil city")
 	}
 
-	idx := rand.Intn(len(pCity.Buildings))
-	pCity.Buildings[idx].Deleted = true
+	if len(pCity.Buildings) <= 0 {
+		return
+	}
+	// The choice must be reproducible when the operations are replayed
+	rng := rand.New(rand.NewSource(int64(a.Id ^ w.Clock.Tick)))
+	idx := rng.Intn(len(pCity.Buildings))
+	b := pCity.Buildings[idx]
+	b.Deleted = true
 
 	// FIXME(jfs): Popularities
-	// FIXME(jfs): Notify pLocalCity
-	// FIXME(jfs): Notify a.City
+	w.notify(pCity, Event{Kind: EvtBuildingBroken, Army: a.Id, Other: a.City, Type: b.Type})
+	w.notify(a.home(w), Event{Kind: EvtBuildingDestroyed, Army: a.Id, Other: pCity.Id, Type: b.Type})
 }
 
 func (a *Army) Conquer(w *World, pCity *City) {
@@ -145,7 +245,7 @@ func (a *Army) Conquer(w *World, pCity *City) {
 		panic("Impossible action: nil city")
 	}
 
-	pOverlord := w.CityGet(a.City)
+	pOverlord := a.home(w)
 	if pOverlord == nil {
 		panic("Impossible action: nil overlord")
 	}
@@ -158,7 +258,7 @@ func (a *Army) Liberate(w *World, pCity *City) {
 		panic("Impossible action: nil city")
 	}
 
-	pOverlord := w.CityGet(a.City)
+	pOverlord := a.home(w)
 	if pOverlord == nil {
 		panic("Impossible action: nil overlord")
 	}
@@ -177,9 +277,7 @@ func (a *Army) JoinCityDefence(w *World, pCity *City) bool {
 		panic("inconsistency")
 	}
 
-	w.Live.Armies.Remove(a)
-	pCity.Assault.Defense.Add(a)
-	a.Fight = pCity.Assault.Id
+	a.joinFight(w, pCity.Assault, false)
 	return true
 }
 
@@ -189,7 +287,10 @@ func (a *Army) JoinCityAttack(w *World, pCity *City) {
 			Id: w.getNextId(), Cell: pCity.Cell,
 			Defense: make(SetOfArmies, 0),
 			Attack:  make(SetOfArmies, 0)}
-		pCity.Assault.Defense.Add(pCity.MakeDefence(w))
+		garrison := pCity.MakeDefence(w)
+		garrison.Fight = pCity.Assault.Id
+		pCity.Assault.Defense.Add(garrison)
+		w.Live.Fights.Add(pCity.Assault)
 	}
 
 	if pCity.Assault.Cell != a.Cell {
@@ -199,59 +300,285 @@ func (a *Army) JoinCityAttack(w *World, pCity *City) {
 		panic("inconsistency")
 	}
 
-	w.Live.Armies.Remove(a)
-	pCity.Assault.Attack.Add(a)
-	a.Fight = pCity.Assault.Id
+	a.joinFight(w, pCity.Assault, true)
+}
+
+// Apply the pending action of the Army, after a victory in the Fight against
+// the given City.
+func (a *Army) Victory(w *World, pCity *City) {
+	if len(a.Targets) <= 0 || a.Targets[0].Cell != a.Cell {
+		return
+	}
+	switch a.Targets[0].Action {
+	case CmdCityOverlord:
+		// An Army abroad cannot bring the City under its overlord
+		if a.home(w) != nil {
+			a.Conquer(w, pCity)
+		}
+	case CmdCityLiberate:
+		if a.home(w) != nil {
+			a.Liberate(w, pCity)
+		}
+	case CmdCityBreak:
+		a.BreakBuilding(w, pCity)
+	case CmdCityMassacre:
+		a.Massacre(w, pCity)
+	default:
+		return
+	}
+	a.PopCommand()
+}
+
+// Drop the pending action of the Army after a lost Fight.
+func (a *Army) Defeat(w *World) {
+	if len(a.Targets) <= 0 || a.Targets[0].Cell != a.Cell {
+		return
+	}
+	switch a.Targets[0].Action {
+	case CmdCityOverlord, CmdCityLiberate, CmdCityBreak, CmdCityMassacre:
+		a.PopCommand()
+	}
+}
+
+// Free the Army from its Fight. The Army is back on the map if it still has
+// Units, otherwise it is dropped.
+func (a *Army) leaveFight(w *World) {
+	a.Fight = 0
+	if len(a.Units) > 0 {
+		w.Live.Armies.Add(a)
+	} else {
+		a.Deleted = true
+		if c := a.home(w); c != nil {
+			c.armies.Remove(a)
+		}
+	}
+}
+
+func (a *Army) getFight(w *World) (*Fight, error) {
+	if a.Fight == 0 {
+		return nil, errors.New("Not in a fight")
+	}
+	f := w.Live.Fights.Get(a.Fight)
+	if f == nil {
+		return nil, errors.New("Fight not found")
+	}
+	return f, nil
+}
+
+// Drop the pending command if it concerns the Cell of the Fight. A disband
+// is kept, so that the Units still get back in the City, e.g. those of a
+// garrison.
+func (a *Army) dropLocalCommand(f *Fight) {
+	if len(a.Targets) > 0 && a.Targets[0].Cell == f.Cell && a.Targets[0].Action != CmdCityDisband {
+		a.PopCommand()
+	}
 }
 
-// Leave the Fight as a loser
+// Leave the Fight as a loser.
+// The Army pays the penalty configured in the DefinitionsBase (lost Units,
+// lost Stock, lost Popularity) and escapes to an adjacent Cell, preferably
+// on the way to its City.
 func (a *Army) Flea(w *World) error {
-	return errors.New("NYI")
+	f, err := a.getFight(w)
+	if err != nil {
+		return err
+	}
+
+	if f.Attack.Has(a.Id) {
+		f.Attack.Remove(a)
+	} else if f.Defense.Has(a.Id) {
+		f.Defense.Remove(a)
+	} else {
+		return errors.New("Army not in the fight")
+	}
+
+	// Apply the penalty
+	if nb := int(float64(len(a.Units)) * w.Definitions.RateFleaUnits); nb > 0 {
+		if nb > len(a.Units) {
+			nb = len(a.Units)
+		}
+		a.Units = a.Units[:len(a.Units)-nb]
+	}
+	a.Stock.Multiply(MultiplierUniform(1.0 - w.Definitions.RateFleaStock))
+	if pCity := a.home(w); pCity != nil {
+		pCity.Pop += w.Definitions.PopBonusArmyFlea
+	}
+
+	// Escape to a neighbor Cell
+	a.Cell = w.escapeCell(a, f.Cell)
+	a.Toward, a.Progress = 0, 0
+	a.dropLocalCommand(f)
+	a.leaveFight(w)
+
+	w.notify(a.home(w), Event{Kind: EvtArmyFled, Army: a.Id, Cell: f.Cell})
+	f.conclude(w)
+	return nil
 }
 
 // Change the side in the Fight.
 // If the Army was defending, it becomes an attacker, if it was an attacker
 // it becomes a defender.
 func (a *Army) Flip(w *World) error {
-	return errors.New("NYI")
+	f, err := a.getFight(w)
+	if err != nil {
+		return err
+	}
+
+	if f.Attack.Has(a.Id) {
+		f.Attack.Remove(a)
+		f.Defense.Add(a)
+	} else if f.Defense.Has(a.Id) {
+		f.Defense.Remove(a)
+		f.Attack.Add(a)
+	} else {
+		return errors.New("Army not in the fight")
+	}
+
+	// The command that brought the Army in the Fight makes no sense anymore
+	a.dropLocalCommand(f)
+
+	w.notify(a.home(w), Event{Kind: EvtArmyFlipped, Army: a.Id, Cell: f.Cell})
+	f.conclude(w)
+	return nil
+}
+
+// Check the given action of the Army is legal against the given City
+func (a *Army) checkAction(w *World, t *City, action uint) error {
+	home := a.home(w)
+	if home == nil {
+		return errors.New("Army not controlled by a City")
+	}
+
+	switch action {
+	case CmdCityAttack, CmdCityOverlord, CmdCityBreak, CmdCityMassacre:
+		if home.Id == t.Id || (home.Owner != 0 && home.Owner == t.Owner) {
+			return errors.New("Own city")
+		}
+	case CmdCityLiberate:
+		if t.Overlord == 0 {
+			return errors.New("City already free")
+		}
+	case CmdCityDeposit, CmdCityDisband:
+		if !home.Friendly(t) {
+			return errors.New("Hostile city")
+		}
+	}
+	return nil
+}
+
+// Check there is a route between each step of the given sequence of Commands,
+// starting at the given Cell. A command in another Region only requires a
+// border toward that Region, and the route from the Region is unknown, so
+// the command after it is not checked.
+func (w *World) checkRoute(src uint64, targets []Command) error {
+	for _, cmd := range targets {
+		if cmd.Region != "" {
+			if src != 0 {
+				if _, err := w.Places.BorderTo(src, cmd.Region); err != nil {
+					return err
+				}
+			}
+			src = 0
+			continue
+		}
+		if src != 0 && src != cmd.Cell {
+			if _, err := w.Places.PathNextStep(src, cmd.Cell); err != nil {
+				return err
+			}
+		}
+		src = cmd.Cell
+	}
+	return nil
+}
+
+// Validate then append the Command at the end of the queue of the Army
+func (a *Army) deferAction(w *World, t *City, action uint) error {
+	if t == nil || t.Deleted || w.CityGet(t.Id) != t {
+		return errors.New("City not found")
+	}
+	if err := a.checkAction(w, t, action); err != nil {
+		return err
+	}
+
+	cmd := Command{Cell: t.Cell, Action: action}
+	if err := w.checkRoute(a.lastCell(), []Command{cmd}); err != nil {
+		return err
+	}
+
+	a.Targets = append(a.Targets, cmd)
+	return nil
+}
+
+// Move the Command at the position 'from' in the queue of the Army to the
+// position 'to'. The Command currently executed by an Army involved in a
+// Fight cannot be moved.
+func (a *Army) ReorderCommand(w *World, from, to int) error {
+	if from < 0 || from >= len(a.Targets) || to < 0 || to >= len(a.Targets) {
+		return errors.New("Invalid command index")
+	}
+	if a.Fight != 0 && (from == 0 || to == 0) {
+		return errors.New("Army in a fight")
+	}
+	if from == to {
+		return nil
+	}
+
+	cmd := a.Targets[from]
+	targets := make([]Command, 0, len(a.Targets))
+	targets = append(targets, a.Targets[:from]...)
+	targets = append(targets, a.Targets[from+1:]...)
+	targets = append(targets[:to], append([]Command{cmd}, targets[to:]...)...)
+
+	if err := w.checkRoute(a.Cell, targets); err != nil {
+		return err
+	}
+	a.Targets = targets
+	return nil
+}
+
+// Remove the Command at the given position in the queue of the Army.
+// The Command currently executed by an Army involved in a Fight cannot be
+// cancelled.
+func (a *Army) CancelCommand(w *World, idx int) error {
+	if idx < 0 || idx >= len(a.Targets) {
+		return errors.New("Invalid command index")
+	}
+	if a.Fight != 0 && idx == 0 {
+		return errors.New("Army in a fight")
+	}
+
+	a.Targets = append(a.Targets[:idx], a.Targets[idx+1:]...)
+	return nil
 }
 
 func (a *Army) DeferAttack(w *World, t *City) error {
-	//FIXME(jfs):
-	return errors.New("NYI")
+	return a.deferAction(w, t, CmdCityAttack)
 }
 
 func (a *Army) DeferDefend(w *World, t *City) error {
-	//FIXME(jfs):
-	return errors.New("NYI")
+	return a.deferAction(w, t, CmdCityDefend)
 }
 
 func (a *Army) DeferBreak(w *World, t *City) error {
-	//FIXME(jfs):
-	return errors.New("NYI")
+	return a.deferAction(w, t, CmdCityBreak)
 }
 
 func (a *Army) DeferDeposit(w *World, t *City) error {
-	//FIXME(jfs):
-	return errors.New("NYI")
+	return a.deferAction(w, t, CmdCityDeposit)
 }
 
 func (a *Army) DeferDisband(w *World, t *City) error {
-	//FIXME(jfs):
-	return errors.New("NYI")
+	return a.deferAction(w, t, CmdCityDisband)
 }
 
 func (a *Army) DeferMassacre(w *World, t *City) error {
-	//FIXME(jfs):
-	return errors.New("NYI")
+	return a.deferAction(w, t, CmdCityMassacre)
 }
 
 func (a *Army) DeferConquer(w *World, t *City) error {
-	//FIXME(jfs):
-	return errors.New("NYI")
+	return a.deferAction(w, t, CmdCityOverlord)
 }
 
 func (a *Army) DeferLiberate(w *World, t *City) error {
-	//FIXME(jfs):
-	return errors.New("NYI")
+	return a.deferAction(w, t, CmdCityLiberate)
 }
@@ -4,3 +4,326 @@
 // file, You can obtain one at http://mozilla.org/MPL/2.0/.
 
 package region
+
+import (
+	"testing"
+)
+
+// Start a Fight on the defender City with one Army made of 'nb' units of the
+// attacker City. The Fight isn't played.
+func newTestFight(t *testing.T, nbAtt, nbDef int) (*World, *Army, *Fight) {
+	w, att, def := newTestWorld()
+	trainUnits(w, att, 1, nbAtt)
+	trainUnits(w, def, 1, nbDef)
+
+	a, _ := w.ArmyCreate(att, "A")
+	for _, u := range append(SetOfUnits{}, att.Units...) {
+		att.TransferOwnUnit(a, u.Id)
+	}
+	a.Stock = Resources{10, 10, 10, 10, 10, 10}
+	a.Cell = def.Cell
+	a.Targets = append(a.Targets, Command{Cell: def.Cell, Action: CmdCityOverlord})
+	a.JoinCityAttack(w, def)
+
+	if def.Assault == nil || a.Fight != def.Assault.Id {
+		t.Fatal("fight not started")
+	}
+	return w, a, def.Assault
+}
+
+func TestArmyFleaOutOfFight(t *testing.T) {
+	w, att, _ := newTestWorld()
+	a, _ := w.ArmyCreate(att, "A")
+	if err := a.Flea(w); err == nil {
+		t.Fatal()
+	}
+	if err := a.Flip(w); err == nil {
+		t.Fatal()
+	}
+}
+
+func TestArmyFlea(t *testing.T) {
+	for _, tc := range []struct {
+		name       string
+		rateUnits  float64
+		rateStock  float64
+		pop        int64
+		nbUnits    int
+		expUnits   int
+		expStock   uint64
+		expDeleted bool
+	}{
+		{"free", 0, 0, 0, 4, 4, 10, false},
+		{"half", 0.5, 0.5, -1, 4, 2, 5, false},
+		{"floor", 0.5, 0, -2, 3, 2, 10, false},
+		{"all", 1.0, 1.0, -3, 4, 0, 0, true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			w, a, f := newTestFight(t, tc.nbUnits, 1)
+			w.Definitions.RateFleaUnits = tc.rateUnits
+			w.Definitions.RateFleaStock = tc.rateStock
+			w.Definitions.PopBonusArmyFlea = tc.pop
+			pCity := w.CityGet(a.City)
+			pop := pCity.Pop
+
+			if err := a.Flea(w); err != nil {
+				t.Fatal(err)
+			}
+			if a.Fight != 0 || f.Attack.Has(a.Id) {
+				t.Fatal("still fighting")
+			}
+			if len(a.Units) != tc.expUnits {
+				t.Fatal("units", len(a.Units))
+			}
+			if a.Stock[0] != tc.expStock {
+				t.Fatal("stock", a.Stock)
+			}
+			if pCity.Pop != pop+tc.pop {
+				t.Fatal("popularity", pCity.Pop)
+			}
+			if a.Deleted != tc.expDeleted || w.Live.Armies.Has(a.Id) == tc.expDeleted {
+				t.Fatal("deleted", a.Deleted)
+			}
+			if a.Cell == f.Cell {
+				t.Fatal("no escape")
+			}
+			if len(a.Targets) != 0 {
+				t.Fatal("command still pending")
+			}
+			// The attack side is empty, the Fight is over
+			if len(w.Live.Fights) != 0 || w.CityAt(f.Cell).Assault != nil {
+				t.Fatal("fight not closed")
+			}
+			if w.CityAt(f.Cell).Overlord != 0 {
+				t.Fatal("conquered")
+			}
+		})
+	}
+}
+
+func TestArmyFlip(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		company  bool
+		twice    bool
+		expFight bool
+	}{
+		// The only attacker changes side, the attack is empty and the Fight ends
+		{"alone", false, false, false},
+		// Another attacker remains, the Fight goes on
+		{"company", true, false, true},
+		// Flipping twice gets the Army back to the attack
+		{"twice", true, true, true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			w, a, f := newTestFight(t, 2, 2)
+			if tc.company {
+				pCity := w.CityGet(a.City)
+				b, _ := w.ArmyCreate(pCity, "B")
+				trainUnits(w, pCity, 1, 1)
+				pCity.TransferOwnUnit(b, pCity.Units[0].Id)
+				b.Cell = f.Cell
+				b.JoinCityAttack(w, w.CityAt(f.Cell))
+			}
+
+			if err := a.Flip(w); err != nil {
+				t.Fatal(err)
+			}
+			if tc.twice {
+				if err := a.Flip(w); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			if over := len(w.Live.Fights) == 0; over == tc.expFight {
+				t.Fatal("over", over)
+			}
+			if (a.Fight != 0) != tc.expFight {
+				t.Fatal("fight", a.Fight)
+			}
+			if tc.expFight {
+				if f.Attack.Has(a.Id) != tc.twice || f.Defense.Has(a.Id) == tc.twice {
+					t.Fatal("wrong side")
+				}
+				if w.ArmyGet(a.Id) != a {
+					t.Fatal("army lookup")
+				}
+			} else if w.CityAt(f.Cell).Overlord != 0 {
+				t.Fatal("conquered")
+			}
+			if len(a.Targets) != 0 {
+				t.Fatal("command still pending")
+			}
+		})
+	}
+}
+
+func TestArmyDefer(t *testing.T) {
+	w, att, def := newTestWorld()
+	ally, _ := w.CityCreate(w.Places.CellCreate().Id)
+	pAlly := w.CityGet(ally)
+	pAlly.Owner = 7
+	att.Owner = 7
+	def.Owner = 8
+
+	// An isolated Cell, without any road
+	island, _ := w.CityCreate(w.Places.CellCreate().Id)
+	pIsland := w.CityGet(island)
+	w.Places.CellGet(pIsland.Cell).City = island
+	w.Places.CellGet(pAlly.Cell).City = ally
+	w.Places.RoadCreate(pAlly.Cell, att.Cell, true)
+	w.Places.RoadCreate(att.Cell, pAlly.Cell, true)
+	w.Places.Rehash()
+
+	for _, tc := range []struct {
+		name   string
+		defer_ func(a *Army, w *World, t *City) error
+		target *City
+		ok     bool
+	}{
+		{"attack", (*Army).DeferAttack, def, true},
+		{"attack-own", (*Army).DeferAttack, att, false},
+		{"attack-ally", (*Army).DeferAttack, pAlly, false},
+		{"attack-island", (*Army).DeferAttack, pIsland, false},
+		{"conquer", (*Army).DeferConquer, def, true},
+		{"break", (*Army).DeferBreak, def, true},
+		{"massacre", (*Army).DeferMassacre, def, true},
+		{"defend", (*Army).DeferDefend, def, true},
+		{"deposit", (*Army).DeferDeposit, pAlly, true},
+		{"deposit-enemy", (*Army).DeferDeposit, def, false},
+		{"disband", (*Army).DeferDisband, att, true},
+		{"disband-enemy", (*Army).DeferDisband, def, false},
+		{"liberate-free", (*Army).DeferLiberate, def, false},
+		{"nil", (*Army).DeferAttack, nil, false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			a, _ := w.ArmyCreate(att, tc.name)
+			err := tc.defer_(a, w, tc.target)
+			if (err == nil) != tc.ok {
+				t.Fatal("unexpected", err)
+			}
+			if tc.ok && (len(a.Targets) != 1 || a.Targets[0].Cell != tc.target.Cell) {
+				t.Fatal("not queued", a.Targets)
+			}
+			if !tc.ok && len(a.Targets) != 0 {
+				t.Fatal("queued", a.Targets)
+			}
+		})
+	}
+
+	// Liberation of a liege
+	def.Overlord = ally
+	a, _ := w.ArmyCreate(att, "liberate")
+	if err := a.DeferLiberate(w, def); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestArmyCommandQueue(t *testing.T) {
+	w, att, def := newTestWorld()
+	a, _ := w.ArmyCreate(att, "A")
+	if err := a.DeferDefend(w, def); err != nil {
+		t.Fatal(err)
+	}
+	if err := a.DeferAttack(w, def); err != nil {
+		t.Fatal(err)
+	}
+	if err := a.DeferDisband(w, att); err != nil {
+		t.Fatal(err)
+	}
+	expect := func(actions ...uint) {
+		if len(a.Targets) != len(actions) {
+			t.Fatal("length", a.Targets)
+		}
+		for i, action := range actions {
+			if a.Targets[i].Action != action {
+				t.Fatal("order", a.Targets)
+			}
+		}
+	}
+	expect(CmdCityDefend, CmdCityAttack, CmdCityDisband)
+
+	if err := a.ReorderCommand(w, 2, 0); err != nil {
+		t.Fatal(err)
+	}
+	expect(CmdCityDisband, CmdCityDefend, CmdCityAttack)
+	if err := a.ReorderCommand(w, 0, 3); err == nil {
+		t.Fatal()
+	}
+	if err := a.CancelCommand(w, 1); err != nil {
+		t.Fatal(err)
+	}
+	expect(CmdCityDisband, CmdCityAttack)
+	if err := a.CancelCommand(w, 2); err == nil {
+		t.Fatal()
+	}
+
+	// The current command is locked during a Fight
+	a.Fight = 1
+	if err := a.CancelCommand(w, 0); err == nil {
+		t.Fatal()
+	}
+	if err := a.ReorderCommand(w, 1, 0); err == nil {
+		t.Fatal()
+	}
+}
+
+func TestArmySpeed(t *testing.T) {
+	w, c0, c1 := newTestWorld()
+	w.Definitions.Units.Add(&UnitType{Id: 3, Name: "fast", Health: 10, Speed: 3})
+	w.Places.Roads.Get(c0.Cell, w.Places.Cells[1].Id).Cost = 3
+	w.Places.Rehash()
+
+	for _, tc := range []struct {
+		types []uint64
+		speed uint64
+		ticks int
+	}{
+		{nil, 1, 4},
+		{[]uint64{3}, 3, 2},
+		{[]uint64{3, 1}, 1, 4},
+	} {
+		a, _ := w.ArmyCreate(c0, "A")
+		for _, typ := range tc.types {
+			trainUnits(w, c0, typ, 1)
+			c0.TransferOwnUnit(a, c0.Units[0].Id)
+		}
+		if s := a.Speed(w); s != tc.speed {
+			t.Fatal("types", tc.types, "speed", s)
+		}
+
+		// A long road to c1, then a short one
+		a.Targets = []Command{{Cell: c1.Cell, Action: CmdPause}}
+		ticks := 0
+		for ; a.Cell != c1.Cell && ticks < 10; ticks++ {
+			a.Move(w)
+			if ticks == 0 && a.Cell != c0.Cell && tc.speed < 3 {
+				t.Fatal("long road crossed at once")
+			}
+		}
+		if ticks != tc.ticks {
+			t.Fatal("types", tc.types, "ticks", ticks)
+		}
+	}
+}
+
+func TestArmyFleaGarrison(t *testing.T) {
+	w, _, f := newTestFight(t, 2, 2)
+	def := w.CityAt(f.Cell)
+	garrison := f.Defense[0]
+	nb := len(garrison.Units)
+
+	// The garrison escapes, then comes back to disband in its City
+	if err := garrison.Flea(w); err != nil {
+		t.Fatal(err)
+	}
+	if garrison.Cell == def.Cell || len(garrison.Targets) != 1 || garrison.Targets[0].Action != CmdCityDisband {
+		t.Fatal("garrison lost its way home", garrison.Cell, garrison.Targets)
+	}
+	for i := 0; i < 10 && !garrison.Deleted; i++ {
+		garrison.Move(w)
+	}
+	if !garrison.Deleted || len(def.Units) != nb {
+		t.Fatal("units lost", len(def.Units), nb)
+	}
+}
@@ -42,8 +42,11 @@ func (c *City) Armies() []*Army {
 func (c *City) Popularity(w *World) int64 {
 	var pop int64 = c.Pop
 
-	// Add Transient values for Units in the Armies
+	// Add Transient values for Units in the Armies, the transports excepted
 	for _, a := range c.armies {
+		if a.Transport {
+			continue
+		}
 		for _, u := range a.Units {
 			ut := w.UnitTypeGet(u.Type)
 			pop += ut.PopBonus
@@ -148,7 +151,10 @@ func (c *City) GetStock(w *World) *CityStock {
 	return p
 }
 
-// Create an Army made of the Units defending the City
+// Create an Army made of the Units defending the City.
+// The trained Units leave the City for the Army, that will bring them back
+// with a Disband command once the Fight is over. The Army belongs to the
+// Fight and not to the map, it is not registered in the LiveBase.
 func (c *City) MakeDefence(w *World) *Army {
 	a := &Army{
 		Id:       w.getNextId(),
@@ -158,9 +164,17 @@ func (c *City) MakeDefence(w *World) *Army {
 		Name:     "Wot?",
 		Units:    make(SetOfUnits, 0),
 		Postures: []int64{int64(c.Id)},
-		Targets:  make([]Command, 0),
+		Targets:  []Command{{Cell: c.Cell, Action: CmdCityDisband}},
+	}
+	for _, u := range c.Units {
+		if u.Ticks <= 0 {
+			a.Units = append(a.Units, u)
+		}
 	}
-	w.Live.Armies.Add(a)
+	for _, u := range a.Units {
+		c.Units.Remove(u)
+	}
+	c.armies.Add(a)
 	return a
 }
 
@@ -195,20 +209,21 @@ func (c *City) Produce(w *World) {
 			// Ensure the tax isn't superior to the actual production (to cope with
 			// invalid tax rates)
 			tax.TrimTo(c.Stock)
-			// Then preempt the tax from the stock
-			c.Stock.Remove(tax)
 
 			// TODO(jfs): check for potential shortage
 			//  shortage := c.Tax.GreaterThan(tax)
 
 			if w.Definitions.InstantTransfers {
+				c.Stock.Remove(tax)
 				c.pOverlord.Stock.Add(tax)
-			} else {
-				c.SendResourcesTo(w, c.pOverlord, tax)
+				w.notify(c, Event{Kind: EvtTaxPaid, Other: c.pOverlord.Id, Resources: &tax})
+				w.notify(c.pOverlord, Event{Kind: EvtTaxReceived, Other: c.Id, Resources: &tax})
+			} else if !tax.IsZero() {
+				// The Overlord is notified at the delivery
+				if _, err := c.SendResourcesTo(w, "Tax", c.pOverlord, tax); err == nil {
+					w.notify(c, Event{Kind: EvtTaxPaid, Other: c.pOverlord.Id, Resources: &tax})
+				}
 			}
-
-			// FIXME(jfs): notify overlord
-			// FIXME(jfs): notify c
 		}
 	}
 
@@ -223,7 +238,7 @@ func (c *City) Produce(w *World) {
 				c.Stock.Remove(ut.Cost)
 				u.Ticks--
 				if u.Ticks <= 0 {
-					// FIXME(jfs): Notify the City
+					w.notify(c, Event{Kind: EvtUnitTrained, Type: u.Type})
 				}
 			}
 		}
@@ -231,12 +246,12 @@ func (c *City) Produce(w *World) {
 
 	for _, b := range c.Buildings {
 		if b.Ticks > 0 {
-			bt := w.BuildingTypeGet(b.Id)
+			bt := w.BuildingTypeGet(b.Type)
 			if c.Stock.GreaterOrEqualTo(bt.Cost) {
 				c.Stock.Remove(bt.Cost)
 				b.Ticks--
 				if b.Ticks <= 0 {
-					// FIXME(jfs): Notify the City
+					w.notify(c, Event{Kind: EvtBuildingCompleted, Type: b.Type})
 				}
 			}
 		}
@@ -244,13 +259,13 @@ func (c *City) Produce(w *World) {
 
 	for _, k := range c.Knowledges {
 		if k.Ticks > 0 {
-			bt := w.KnowledgeTypeGet(k.Id)
-			if c.Stock.GreaterOrEqualTo(bt.Cost) {
-				c.Stock.Remove(bt.Cost)
+			kt := w.KnowledgeTypeGet(k.Type)
+			if c.Stock.GreaterOrEqualTo(kt.Cost) {
+				c.Stock.Remove(kt.Cost)
 				k.Ticks--
-			}
-			if k.Ticks <= 0 {
-				// FIXME(jfs): Notify the City
+				if k.Ticks <= 0 {
+					w.notify(c, Event{Kind: EvtKnowledgeLearned, Type: k.Type})
+				}
 			}
 		}
 	}
@@ -268,18 +283,32 @@ func (c *City) SetTaxRate(m ResourcesMultiplier) {
 	c.TaxRate = m
 }
 
+// Replace the Overlord of the current City, and maintain the set of lieges
+// of both the former and the new Overlord.
+func (c *City) setOverlord(o *City) {
+	if c.pOverlord != nil {
+		c.pOverlord.lieges.Remove(c)
+	}
+	c.pOverlord = o
+	if o != nil {
+		c.Overlord = o.Id
+		o.lieges.Add(c)
+	} else {
+		c.Overlord = 0
+	}
+}
+
 func (c *City) LiberateCity(w *World, other *City) {
 	pre := other.pOverlord
 	if pre == nil {
 		return
 	}
 
-	other.Overlord = 0
-	other.pOverlord = nil
+	other.setOverlord(nil)
 
-	// FIXME(jfs): Notify 'pre'
-	// FIXME(jfs): Notify 'c'
-	// FIXME(jfs): Notify 'other'
+	w.notify(pre, Event{Kind: EvtLiegeLost, Other: other.Id})
+	w.notify(c, Event{Kind: EvtLiberationDone, Other: other.Id})
+	w.notify(other, Event{Kind: EvtCityLiberated, Other: c.Id})
 }
 
 func (c *City) GainFreedom(w *World) {
@@ -288,33 +317,29 @@ func (c *City) GainFreedom(w *World) {
 		return
 	}
 
-	c.Overlord = 0
-	c.pOverlord = nil
+	c.setOverlord(nil)
 
-	// FIXME(jfs): Notify 'pre'
-	// FIXME(jfs): Notify 'c'
+	w.notify(pre, Event{Kind: EvtLiegeLost, Other: c.Id})
+	w.notify(c, Event{Kind: EvtCityFreed, Other: pre.Id})
 }
 
 func (c *City) ConquerCity(w *World, other *City) {
-	if other.pOverlord == c {
-		c.pOverlord = nil
-		c.Overlord = 0
+	// A liege that defeats its Overlord just gains its freedom
+	if c.pOverlord == other {
+		c.GainFreedom(w)
 		c.TaxRate = MultiplierUniform(0)
 		return
 	}
 
-	//pre := other.pOverlord
-	other.pOverlord = c
-	other.Overlord = c.Id
+	pre := other.pOverlord
+	other.setOverlord(c)
 	other.TaxRate = MultiplierUniform(w.Definitions.RateOverlord)
 
-	// FIXME(jfs): Notify 'pre'
-	// FIXME(jfs): Notify 'c'
-	// FIXME(jfs): Notify 'other'
-}
-
-func (c *City) SendResourcesTo(w *World, overlord *City, amount Resources) {
-	// FIXME(jfs): NYI
+	if pre != nil && pre != c {
+		w.notify(pre, Event{Kind: EvtLiegeLost, Other: other.Id})
+	}
+	w.notify(c, Event{Kind: EvtConquestDone, Other: other.Id})
+	w.notify(other, Event{Kind: EvtCityConquered, Other: c.Id})
 }
 
 func (c *City) TransferOwnResources(a *Army, r Resources) error {
@@ -472,6 +497,44 @@ func (c *City) Build(w *World, bId uint64) (uint64, error) {
 	return id, nil
 }
 
+// Tell if the other City is on the same side than the current City: the City
+// itself, a City of the same Owner, its Overlord or one of its lieges.
+func (c *City) Friendly(other *City) bool {
+	if c.Id == other.Id {
+		return true
+	}
+	if c.Owner != 0 && c.Owner == other.Owner {
+		return true
+	}
+	return c.Overlord == other.Id || other.Overlord == c.Id
+}
+
 func (c *City) Lieges() []*City {
 	return c.lieges[:]
 }
+
+// Return the number of assets owned by the City: its Units (in the City and
+// in its armies), its Buildings and its Knowledges.
+func (c *City) Assets() int {
+	nb := len(c.Units) + len(c.Buildings) + len(c.Knowledges)
+	for _, a := range c.armies {
+		nb += len(a.Units)
+	}
+	return nb
+}
+
+// Compute the score of the City, as a weighted sum of its Popularity, its
+// assets, its stock and its lieges. The weights are set in the DefinitionsBase.
+func (c *City) Score(w *World) int64 {
+	var stock uint64
+	for _, r := range c.Stock {
+		stock += r
+	}
+
+	d := &w.Definitions
+	score := d.ScorePopularity * float64(c.Popularity(w))
+	score += d.ScoreAssets * float64(c.Assets())
+	score += d.ScoreStock * float64(stock)
+	score += d.ScoreLieges * float64(len(c.lieges))
+	return int64(score)
+}
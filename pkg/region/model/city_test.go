@@ -4,3 +4,85 @@
 // file, You can obtain one at http://mozilla.org/MPL/2.0/.
 
 package region
+
+import (
+	"testing"
+)
+
+func TestCityConquest(t *testing.T) {
+	w, att, def := newTestWorld()
+
+	att.ConquerCity(w, def)
+	if def.Overlord != att.Id || def.pOverlord != att || !att.lieges.Has(def.Id) {
+		t.Fatal("not conquered")
+	}
+
+	// The liege defeats its overlord and gains its freedom
+	def.ConquerCity(w, att)
+	if def.Overlord != 0 || def.pOverlord != nil || att.lieges.Has(def.Id) {
+		t.Fatal("not freed")
+	}
+	if att.Overlord != 0 {
+		t.Fatal("reverse conquest")
+	}
+
+	att.ConquerCity(w, def)
+	att.LiberateCity(w, def)
+	if def.Overlord != 0 || len(att.lieges) != 0 {
+		t.Fatal("not liberated")
+	}
+}
+
+func TestCityScore(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		pop      float64
+		assets   float64
+		stock    float64
+		lieges   float64
+		expected int64
+	}{
+		{"none", 0, 0, 0, 0, 0},
+		{"popularity", 1, 0, 0, 0, 5},
+		{"assets", 0, 1, 0, 0, 3},
+		{"stock", 0, 0, 0.5, 0, 30},
+		{"lieges", 0, 0, 0, 10, 10},
+		{"all", 1, 1, 0.5, 10, 48},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			w, att, def := newTestWorld()
+			w.Definitions.ScorePopularity = tc.pop
+			w.Definitions.ScoreAssets = tc.assets
+			w.Definitions.ScoreStock = tc.stock
+			w.Definitions.ScoreLieges = tc.lieges
+
+			att.Pop = 5
+			att.Stock = Resources{10, 10, 10, 10, 10, 10}
+			trainUnits(w, att, 1, 2)
+			a, _ := w.ArmyCreate(att, "A")
+			att.TransferOwnUnit(a, att.Units[0].Id)
+			trainUnits(w, att, 1, 1)
+			att.ConquerCity(w, def)
+
+			if s := att.Score(w); s != tc.expected {
+				t.Fatal("expected", tc.expected, "got", s)
+			}
+		})
+	}
+}
+
+func TestWorldScoreBoard(t *testing.T) {
+	w, att, def := newTestWorld()
+	def.Pop = 10
+	att.Pop = 5
+	sb := w.ScoreBoard()
+	if len(sb) != 2 || sb[0].City != def || sb[1].City != att {
+		t.Fatal("wrong order", sb)
+	}
+
+	att.ConquerCity(w, def)
+	sb = w.ScoreBoard()
+	if sb[0].City != att {
+		t.Fatal("lieges ignored", sb)
+	}
+}
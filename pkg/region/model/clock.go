@@ -0,0 +1,151 @@
+// Copyright (C) 2018-2020 Hegemonie's AUTHORS
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package region
+
+import (
+	"errors"
+	"time"
+)
+
+// Return a copy of the current state of the Clock
+func (w *World) ClockGet() Clock {
+	w.rw.RLock()
+	defer w.rw.RUnlock()
+	return w.Clock
+}
+
+// Suspend the scheduling of the ticks. The manual ticks are still possible.
+func (w *World) Pause() {
+	w.rw.Lock()
+	defer w.rw.Unlock()
+	w.Clock.Paused = true
+}
+
+// Resume the scheduling of the ticks. The time spent in pause is not caught
+// up: the next ticks are rescheduled from 'now'.
+func (w *World) Resume(now time.Time, s Schedule) {
+	w.rw.Lock()
+	defer w.rw.Unlock()
+	if !w.Clock.Paused {
+		return
+	}
+	w.Clock.Paused = false
+	w.Clock.NextMove = due(now, s.Move)
+	w.Clock.NextProduce = due(now, s.Produce)
+}
+
+// Tell if at least one tick is due at the given moment
+func (w *World) Due(now time.Time, s Schedule) bool {
+	c := w.ClockGet()
+	if c.Paused {
+		return false
+	}
+	return (s.Move > 0 && !c.NextMove.After(now)) ||
+		(s.Produce > 0 && !c.NextProduce.After(now))
+}
+
+// Play all the ticks that are due at the given moment, in chronological
+// order. Return the number of movement ticks played.
+func (w *World) Advance(now time.Time, s Schedule) int {
+	if w.ClockGet().Paused {
+		return 0
+	}
+	return w.advance(now, s)
+}
+
+// Play immediately the next 'n' movement ticks and the production rounds
+// scheduled in between, as if the time had passed. Then the schedule is
+// shifted so that the next movement tick is one period after 'now'.
+func (w *World) CatchUp(n int, now time.Time, s Schedule) error {
+	if s.Move <= 0 {
+		return errors.New("Movement ticks disabled")
+	}
+	if n <= 0 {
+		return nil
+	}
+
+	c := w.ClockGet()
+	if c.NextMove.IsZero() {
+		c.NextMove = now
+		w.setNextMove(now)
+	}
+	target := c.NextMove.Add(time.Duration(n-1) * s.Move)
+
+	// Catching up is explicit: the limit doesn't apply
+	s.MaxCatchUp = 0
+	w.advance(target, s)
+
+	w.rw.Lock()
+	defer w.rw.Unlock()
+	shift := now.Add(s.Move).Sub(w.Clock.NextMove)
+	w.Clock.NextMove = w.Clock.NextMove.Add(shift)
+	if !w.Clock.NextProduce.IsZero() {
+		w.Clock.NextProduce = w.Clock.NextProduce.Add(shift)
+	}
+	return nil
+}
+
+func (w *World) advance(now time.Time, s Schedule) int {
+	moves, productions := 0, 0
+	for {
+		c := w.ClockGet()
+		isMove := s.Move > 0 && !c.NextMove.After(now)
+		isProd := s.Produce > 0 && !c.NextProduce.After(now)
+
+		// The first call only schedules the ticks
+		if s.Move > 0 && c.NextMove.IsZero() {
+			w.setNextMove(due(now, s.Move))
+			continue
+		}
+		if s.Produce > 0 && c.NextProduce.IsZero() {
+			w.setNextProduce(due(now, s.Produce))
+			continue
+		}
+
+		// Drop the ticks beyond the catch-up limit
+		if isMove && s.MaxCatchUp > 0 && moves >= s.MaxCatchUp {
+			w.setNextMove(due(now, s.Move))
+			continue
+		}
+		if isProd && s.MaxCatchUp > 0 && productions >= s.MaxCatchUp {
+			w.setNextProduce(due(now, s.Produce))
+			continue
+		}
+
+		// Play the earliest tick first, the production first on a tie
+		switch {
+		case isProd && (!isMove || !c.NextProduce.After(c.NextMove)):
+			w.Produce()
+			w.setNextProduce(c.NextProduce.Add(s.Produce))
+			productions++
+		case isMove:
+			w.Move()
+			w.setNextMove(c.NextMove.Add(s.Move))
+			moves++
+		default:
+			return moves
+		}
+	}
+}
+
+func (w *World) setNextMove(t time.Time) {
+	w.rw.Lock()
+	defer w.rw.Unlock()
+	w.Clock.NextMove = t
+}
+
+func (w *World) setNextProduce(t time.Time) {
+	w.rw.Lock()
+	defer w.rw.Unlock()
+	w.Clock.NextProduce = t
+}
+
+func due(now time.Time, period time.Duration) time.Time {
+	if period <= 0 {
+		return time.Time{}
+	}
+	return now.Add(period)
+}
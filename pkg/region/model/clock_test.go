@@ -0,0 +1,87 @@
+// Copyright (C) 2018-2020 Hegemonie's AUTHORS
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package region
+
+import (
+	"testing"
+	"time"
+)
+
+func TestClockAdvance(t *testing.T) {
+	t0 := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	for _, tc := range []struct {
+		name     string
+		schedule Schedule
+		elapsed  time.Duration
+		expMove  uint64
+		expProd  uint64
+	}{
+		{"nothing", Schedule{Move: time.Minute, Produce: time.Hour}, 0, 0, 0},
+		{"moves", Schedule{Move: time.Minute, Produce: time.Hour}, 10 * time.Minute, 10, 0},
+		{"both", Schedule{Move: time.Minute, Produce: time.Hour}, 2 * time.Hour, 120, 2},
+		{"no-move", Schedule{Produce: time.Hour}, 2 * time.Hour, 0, 2},
+		{"limited", Schedule{Move: time.Minute, Produce: time.Hour, MaxCatchUp: 5}, 2 * time.Hour, 5, 2},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			w, _, _ := newTestWorld()
+			// The first call schedules the ticks
+			if n := w.Advance(t0, tc.schedule); n != 0 {
+				t.Fatal("unexpected tick", n)
+			}
+			w.Advance(t0.Add(tc.elapsed), tc.schedule)
+			c := w.ClockGet()
+			if c.Tick != tc.expMove || c.Production != tc.expProd {
+				t.Fatal("ticks", c.Tick, "productions", c.Production)
+			}
+			if tc.schedule.Move > 0 && !c.NextMove.After(t0.Add(tc.elapsed)) {
+				t.Fatal("next move in the past", c.NextMove)
+			}
+		})
+	}
+}
+
+func TestClockPause(t *testing.T) {
+	t0 := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	s := Schedule{Move: time.Minute}
+	w, _, _ := newTestWorld()
+	w.Advance(t0, s)
+
+	w.Pause()
+	if n := w.Advance(t0.Add(time.Hour), s); n != 0 {
+		t.Fatal("ticks while paused", n)
+	}
+
+	// The pause is not caught up
+	w.Resume(t0.Add(time.Hour), s)
+	if n := w.Advance(t0.Add(time.Hour+time.Minute), s); n != 1 {
+		t.Fatal("ticks after resume", n)
+	}
+}
+
+func TestClockCatchUp(t *testing.T) {
+	t0 := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	s := Schedule{Move: time.Minute, Produce: 10 * time.Minute}
+	w, _, _ := newTestWorld()
+	w.Advance(t0, s)
+
+	if err := w.CatchUp(25, t0, s); err != nil {
+		t.Fatal(err)
+	}
+	c := w.ClockGet()
+	if c.Tick != 25 || c.Production != 2 {
+		t.Fatal("ticks", c.Tick, "productions", c.Production)
+	}
+	if !c.NextMove.Equal(t0.Add(time.Minute)) {
+		t.Fatal("schedule not shifted", c.NextMove)
+	}
+	if !c.NextProduce.After(c.NextMove) {
+		t.Fatal("production schedule", c.NextProduce)
+	}
+
+	if err := w.CatchUp(1, t0, Schedule{}); err == nil {
+		t.Fatal()
+	}
+}
@@ -0,0 +1,212 @@
+// Copyright (C) 2018-2020 Hegemonie's AUTHORS
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package region
+
+import (
+	"errors"
+	"sort"
+)
+
+var ErrTreatyNotFound = errors.New("Treaty not found")
+
+// Propose a Treaty to another City. A war is in force as soon as it is
+// declared, and it breaks the Treaty in force between both cities.
+func (c *City) TreatyPropose(w *World, other *City, kind uint32) (*Treaty, error) {
+	if other == nil || other == c || other.Deleted {
+		return nil, errors.New("Invalid city")
+	}
+	if kind < TreatyAlliance || kind > TreatyWar {
+		return nil, errors.New("Invalid treaty")
+	}
+	current := w.treatyBetween(c.Id, other.Id, true)
+	if current != nil && current.Kind == kind {
+		return nil, errors.New("Treaty already in force")
+	}
+
+	t := &Treaty{Id: w.getNextId(), Kind: kind, Proposer: c.Id, Target: other.Id}
+	if kind != TreatyWar {
+		if w.treatyBetween(c.Id, other.Id, false) != nil {
+			return nil, errors.New("Treaty already proposed")
+		}
+		w.Live.Treaties = append(w.Live.Treaties, t)
+		w.notify(other, Event{Kind: EvtTreatyProposed, Other: c.Id, Type: uint64(kind)})
+		return t, nil
+	}
+
+	if current != nil {
+		c.Pop += w.Definitions.PopBonusTreatyBreak
+		w.treatyRemove(current)
+	}
+	if pending := w.treatyBetween(c.Id, other.Id, false); pending != nil {
+		w.treatyRemove(pending)
+	}
+	t.Accepted = true
+	w.Live.Treaties = append(w.Live.Treaties, t)
+	w.applyTreaty(c, other)
+	w.notify(other, Event{Kind: EvtWarDeclared, Other: c.Id})
+	return t, nil
+}
+
+// Accept a Treaty proposed to the City. It replaces the Treaty in force
+// between both cities, e.g. a peace ends a war.
+func (c *City) TreatyAccept(w *World, id uint64) error {
+	idx := w.treatyIndex(id)
+	if idx < 0 || w.Live.Treaties[idx].Accepted || w.Live.Treaties[idx].Target != c.Id {
+		return ErrTreatyNotFound
+	}
+	t := w.Live.Treaties[idx]
+	proposer := w.CityGet(t.Proposer)
+	if proposer == nil {
+		return errors.New("Invalid city")
+	}
+
+	if current := w.treatyBetween(c.Id, proposer.Id, true); current != nil {
+		w.treatyRemove(current)
+	}
+	t.Accepted = true
+	w.applyTreaty(c, proposer)
+	w.notify(proposer, Event{Kind: EvtTreatyAccepted, Other: c.Id, Type: uint64(t.Kind)})
+	return nil
+}
+
+// Break a Treaty of the City. Breaking a Treaty in force costs Popularity,
+// while withdrawing or refusing a proposal is free. A war only ends with
+// another Treaty.
+func (c *City) TreatyBreak(w *World, id uint64) error {
+	idx := w.treatyIndex(id)
+	if idx < 0 {
+		return ErrTreatyNotFound
+	}
+	t := w.Live.Treaties[idx]
+	var otherId uint64
+	switch c.Id {
+	case t.Proposer:
+		otherId = t.Target
+	case t.Target:
+		otherId = t.Proposer
+	default:
+		return ErrTreatyNotFound
+	}
+	if t.Accepted && t.Kind == TreatyWar {
+		return errors.New("A war only ends with another treaty")
+	}
+
+	w.treatyRemove(t)
+	other := w.CityGet(otherId)
+	if t.Accepted {
+		c.Pop += w.Definitions.PopBonusTreatyBreak
+		if other != nil {
+			w.applyTreaty(c, other)
+		}
+	}
+	w.notify(other, Event{Kind: EvtTreatyBroken, Other: c.Id, Type: uint64(t.Kind)})
+	return nil
+}
+
+// Return the treaties of the City, proposed or in force
+func (c *City) Treaties(w *World) []*Treaty {
+	out := make([]*Treaty, 0)
+	for _, t := range w.Live.Treaties {
+		if t.Proposer == c.Id || t.Target == c.Id {
+			out = append(out, t)
+		}
+	}
+	return out
+}
+
+// Return the kind of the Treaty in force between both cities, 0 if none.
+func (w *World) Relation(c0, c1 uint64) uint32 {
+	if t := w.treatyBetween(c0, c1, true); t != nil {
+		return t.Kind
+	}
+	return 0
+}
+
+func (w *World) treatyBetween(c0, c1 uint64, accepted bool) *Treaty {
+	for _, t := range w.Live.Treaties {
+		if t.Accepted == accepted &&
+			((t.Proposer == c0 && t.Target == c1) || (t.Proposer == c1 && t.Target == c0)) {
+			return t
+		}
+	}
+	return nil
+}
+
+func (w *World) treatyIndex(id uint64) int {
+	i := sort.Search(len(w.Live.Treaties), func(i int) bool {
+		return w.Live.Treaties[i].Id >= id
+	})
+	if i < len(w.Live.Treaties) && w.Live.Treaties[i].Id == id {
+		return i
+	}
+	return -1
+}
+
+func (w *World) treatyRemove(t *Treaty) {
+	if idx := w.treatyIndex(t.Id); idx >= 0 {
+		w.Live.Treaties = append(w.Live.Treaties[:idx], w.Live.Treaties[idx+1:]...)
+	}
+}
+
+// Return the posture dictated by a kind of Treaty: 1 to defend, -1 to
+// assault, 0 to ignore.
+func treatyPosture(kind uint32) int64 {
+	switch kind {
+	case TreatyAlliance:
+		return 1
+	case TreatyWar:
+		return -1
+	default:
+		return 0
+	}
+}
+
+// Align the postures of the armies of both cities on the Treaty in force
+// between them.
+func (w *World) applyTreaty(c0, c1 *City) {
+	p := treatyPosture(w.Relation(c0.Id, c1.Id))
+	for _, a := range c0.armies {
+		a.putPosture(c1.Id, p)
+	}
+	for _, a := range c1.armies {
+		a.putPosture(c0.Id, p)
+	}
+}
+
+// Return the postures dictated by the treaties of the City, for its new armies
+func (c *City) treatyPostures(w *World) []int64 {
+	out := make([]int64, 0)
+	for _, t := range w.Live.Treaties {
+		if !t.Accepted {
+			continue
+		}
+		other := t.Target
+		if other == c.Id {
+			other = t.Proposer
+		} else if t.Proposer != c.Id {
+			continue
+		}
+		if p := treatyPosture(t.Kind); p != 0 {
+			out = append(out, p*int64(other))
+		}
+	}
+	return out
+}
+
+// Replace the posture of the Army against the given City: positive to defend
+// it, negative to assault it, 0 to ignore it.
+func (a *Army) putPosture(city uint64, p int64) {
+	kept := a.Postures[:0]
+	for _, x := range a.Postures {
+		if x != int64(city) && x != -int64(city) {
+			kept = append(kept, x)
+		}
+	}
+	if p != 0 {
+		kept = append(kept, p*int64(city))
+	}
+	a.Postures = kept
+}
@@ -0,0 +1,149 @@
+// Copyright (C) 2018-2020 Hegemonie's AUTHORS
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package region
+
+import (
+	"testing"
+)
+
+func TestTreatyAlliance(t *testing.T) {
+	w, c0, c1 := newTestWorld()
+	w.Definitions.PopBonusTreatyBreak = -5
+	rec := &recorder{}
+	w.SetNotifier(rec)
+	a0, _ := w.ArmyCreate(c0, "A")
+
+	for _, tc := range []struct {
+		other *City
+		kind  uint32
+	}{
+		{nil, TreatyAlliance},
+		{c0, TreatyAlliance},
+		{c1, 0},
+		{c1, TreatyWar + 1},
+	} {
+		if _, err := c0.TreatyPropose(w, tc.other, tc.kind); err == nil {
+			t.Fatal("treaty accepted", tc.other, tc.kind)
+		}
+	}
+
+	tr, err := c0.TreatyPropose(w, c1, TreatyAlliance)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = c1.TreatyPropose(w, c0, TreatyPeace); err == nil {
+		t.Fatal("second proposal accepted")
+	}
+	if err = c0.TreatyAccept(w, tr.Id); err != ErrTreatyNotFound {
+		t.Fatal("treaty accepted by its proposer", err)
+	}
+	if w.Relation(c0.Id, c1.Id) != 0 || len(a0.Postures) != 0 {
+		t.Fatal("proposal in force")
+	}
+
+	if err = c1.TreatyAccept(w, tr.Id); err != nil {
+		t.Fatal(err)
+	}
+	if w.Relation(c1.Id, c0.Id) != TreatyAlliance || a0.posture(w, c1.Id) != 1 {
+		t.Fatal("alliance not in force", a0.Postures)
+	}
+	a1, _ := w.ArmyCreate(c1, "B")
+	if a1.posture(w, c0.Id) != 1 {
+		t.Fatal("new army ignores the alliance", a1.Postures)
+	}
+	if len(rec.filter(EvtTreatyProposed, c1.Id)) != 1 || len(rec.filter(EvtTreatyAccepted, c0.Id)) != 1 {
+		t.Fatal("missing events")
+	}
+
+	if err = c1.TreatyBreak(w, tr.Id); err != nil {
+		t.Fatal(err)
+	}
+	if w.Relation(c0.Id, c1.Id) != 0 || a0.posture(w, c1.Id) != 0 || a1.posture(w, c0.Id) != 0 {
+		t.Fatal("alliance still in force")
+	}
+	if c1.Pop != -5 || c0.Pop != 0 || len(rec.filter(EvtTreatyBroken, c0.Id)) != 1 {
+		t.Fatal("break not paid", c0.Pop, c1.Pop)
+	}
+
+	// Refusing a proposal is free
+	tr, _ = c0.TreatyPropose(w, c1, TreatyPeace)
+	if err = c1.TreatyBreak(w, tr.Id); err != nil || c1.Pop != -5 || len(w.Live.Treaties) != 0 {
+		t.Fatal("refusal failed", err, c1.Pop)
+	}
+}
+
+func TestTreatyWar(t *testing.T) {
+	w, c0, c1 := newTestWorld()
+	w.Definitions.PopBonusTreatyBreak = -5
+	a0, _ := w.ArmyCreate(c0, "A")
+
+	peace, _ := c0.TreatyPropose(w, c1, TreatyPeace)
+	c1.TreatyAccept(w, peace.Id)
+	alliance, _ := c1.TreatyPropose(w, c0, TreatyAlliance)
+
+	// The war breaks the peace in force and drops the pending proposal
+	war, err := c0.TreatyPropose(w, c1, TreatyWar)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if w.Relation(c0.Id, c1.Id) != TreatyWar || c0.Pop != -5 || len(w.Live.Treaties) != 1 {
+		t.Fatal("war not in force", w.Live.Treaties)
+	}
+	if c0.TreatyAccept(w, alliance.Id) != ErrTreatyNotFound || a0.posture(w, c1.Id) != -1 {
+		t.Fatal("unexpected postures", a0.Postures)
+	}
+	if _, err = c1.TreatyPropose(w, c0, TreatyWar); err == nil {
+		t.Fatal("war declared twice")
+	}
+	if err = c1.TreatyBreak(w, war.Id); err == nil {
+		t.Fatal("war broken")
+	}
+
+	// A peace ends the war
+	peace, _ = c1.TreatyPropose(w, c0, TreatyPeace)
+	if err = c0.TreatyAccept(w, peace.Id); err != nil {
+		t.Fatal(err)
+	}
+	if w.Relation(c0.Id, c1.Id) != TreatyPeace || len(w.Live.Treaties) != 1 || a0.posture(w, c1.Id) != 0 {
+		t.Fatal("war not ended", w.Live.Treaties)
+	}
+}
+
+func TestTreatyPostures(t *testing.T) {
+	w, c0, c1 := newTestWorld()
+	c2id, _ := w.CityCreate(w.Places.Cells[1].Id)
+	w.Places.Cells[1].City = c2id
+	c2 := w.CityGet(c2id)
+
+	// An Army of c0 at war with c2 assaults it on its way to c1
+	c0.Owner, c1.Owner = 1, 1
+	trainUnits(w, c0, 1, 1)
+	attacker, _ := w.ArmyCreate(c0, "A")
+	c0.TransferOwnUnit(attacker, c0.Units[0].Id)
+	war, _ := c0.TreatyPropose(w, c2, TreatyWar)
+	if err := attacker.DeferDeposit(w, c1); err != nil {
+		t.Fatal(err)
+	}
+
+	// An Army of c1 allied to c2 waits in c2
+	trainUnits(w, c1, 1, 1)
+	ally, _ := w.ArmyCreate(c1, "B")
+	c1.TransferOwnUnit(ally, c1.Units[0].Id)
+	ally.Cell = c2.Cell
+	alliance, _ := c2.TreatyPropose(w, c1, TreatyAlliance)
+	c1.TreatyAccept(w, alliance.Id)
+
+	w.Move()
+	if c2.Assault == nil || attacker.Fight != c2.Assault.Id || ally.Fight != c2.Assault.Id {
+		t.Fatal("postures not applied", attacker.Fight, ally.Fight)
+	}
+	if c2.Assault.Attack.Get(attacker.Id) == nil || c2.Assault.Defense.Get(ally.Id) == nil {
+		t.Fatal("wrong sides")
+	}
+	if war.Kind != TreatyWar || len(attacker.Targets) != 1 {
+		t.Fatal("command dropped", attacker.Targets)
+	}
+}
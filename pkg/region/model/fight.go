@@ -27,6 +27,25 @@ func (s *SetOfFights) Add(f *Fight) {
 	sort.Sort(*s)
 }
 
+func (s *SetOfFights) Remove(f *Fight) {
+	for i, x := range *s {
+		if x == f {
+			*s = append((*s)[:i], (*s)[i+1:]...)
+			return
+		}
+	}
+}
+
+// The set is sorted by Cell, so the lookup by ID has to be linear
+func (s SetOfFights) Get(id uint64) *Fight {
+	for _, f := range s {
+		if f.Id == id {
+			return f
+		}
+	}
+	return nil
+}
+
 func (s SetOfFights) SliceByCell(cell uint64) []*Fight {
 	start := s.First(cell)
 	for end := start; end < len(s); end++ {
@@ -36,3 +55,191 @@ func (s SetOfFights) SliceByCell(cell uint64) []*Fight {
 	}
 	return s[start:]
 }
+
+// A Unit involved in a Fight, with a direct access to its definition and
+// to the Army it belongs to.
+type fighter struct {
+	army *Army
+	unit *Unit
+	kind *UnitType
+}
+
+// The damage undergone by a Unit during a round, and the Army of the last hitter.
+type wound struct {
+	damage uint32
+	by     *Army
+}
+
+// Return the strength of the Unit in a Fight, modulated by its loss of health.
+func (f fighter) strength() float64 {
+	if f.kind == nil || f.kind.Health == 0 {
+		return 0
+	}
+	ratio := float64(f.unit.Health) / float64(f.kind.Health)
+	if ratio > 1.0 {
+		ratio = 1.0
+	}
+	return float64(f.kind.Attack) * (1.0 - f.kind.HealthFactor*(1.0-ratio))
+}
+
+func (f fighter) defense() uint32 {
+	if f.kind == nil {
+		return 0
+	}
+	return f.kind.Defense
+}
+
+func (s SetOfArmies) fighters(w *World) []fighter {
+	out := make([]fighter, 0)
+	for _, a := range s {
+		for _, u := range a.Units {
+			if u.Health > 0 {
+				out = append(out, fighter{army: a, unit: u, kind: w.UnitTypeGet(u.Type)})
+			}
+		}
+	}
+	return out
+}
+
+func (s SetOfArmies) empty() bool {
+	for _, a := range s {
+		if len(a.Units) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Each Unit of the hitting side strikes one Unit of the other side.
+// The targets are chosen in a round-robin fashion.
+func strike(hitters, targets []fighter, wounds []wound) {
+	if len(targets) <= 0 {
+		return
+	}
+	for i, h := range hitters {
+		idx := i % len(targets)
+		power := uint32(h.strength())
+		if def := targets[idx].defense(); power > def {
+			wounds[idx].damage += power - def
+			wounds[idx].by = h.army
+		}
+	}
+}
+
+// Apply the damages on the Units and remove the dead ones from their Army.
+func suffer(w *World, targets []fighter, wounds []wound) {
+	for i, t := range targets {
+		d := wounds[i].damage
+		if d <= 0 {
+			continue
+		}
+		if d < t.unit.Health {
+			t.unit.Health -= d
+			continue
+		}
+
+		t.unit.Health = 0
+		t.army.Units.Remove(t.unit)
+		if t.kind != nil {
+			if c := t.army.home(w); c != nil {
+				c.Pop += t.kind.PopBonusDeath
+			}
+			if c := wounds[i].by.home(w); c != nil {
+				c.Pop += t.kind.PopBonusKill
+			}
+		}
+		w.notify(t.army.home(w), Event{Kind: EvtUnitKilled, Army: t.army.Id, Other: wounds[i].by.City, Type: t.unit.Type})
+	}
+}
+
+// Play one round of the Fight: both sides strike simultaneously, then the
+// dead units are removed. Return true if the Fight is over, i.e. when at
+// least one side has no more Unit.
+func (f *Fight) Round(w *World) bool {
+	att := f.Attack.fighters(w)
+	def := f.Defense.fighters(w)
+
+	if len(att) > 0 && len(def) > 0 {
+		wAtt := make([]wound, len(att))
+		wDef := make([]wound, len(def))
+		strike(att, def, wDef)
+		strike(def, att, wAtt)
+		suffer(w, def, wDef)
+		suffer(w, att, wAtt)
+	}
+
+	return f.conclude(w)
+}
+
+// Finish the Fight if at least one side is empty, and return true in this
+// case. The defense wins when both sides are empty.
+func (f *Fight) conclude(w *World) bool {
+	attLost := f.Attack.empty()
+	defLost := f.Defense.empty()
+	if !attLost && !defLost {
+		return false
+	}
+
+	f.Finish(w, !attLost)
+	return true
+}
+
+// Close the Fight: the pending commands of the attackers are applied in case
+// of victory, all the surviving armies are freed on the map and the Fight is
+// unregistered.
+func (f *Fight) Finish(w *World, attackersWin bool) {
+	pCity := w.CityAt(f.Cell)
+	winners, losers := f.involved(w, pCity, attackersWin)
+
+	for _, a := range f.Attack {
+		if attackersWin && pCity != nil {
+			a.Victory(w, pCity)
+		} else {
+			a.Defeat(w)
+		}
+		a.leaveFight(w)
+	}
+	for _, a := range f.Defense {
+		a.leaveFight(w)
+	}
+
+	f.Attack = f.Attack[:0]
+	f.Defense = f.Defense[:0]
+	if pCity != nil && pCity.Assault == f {
+		pCity.Assault = nil
+	}
+	w.Live.Fights.Remove(f)
+
+	for _, c := range winners {
+		w.notify(c, Event{Kind: EvtFightWon, Cell: f.Cell})
+	}
+	for _, c := range losers {
+		w.notify(c, Event{Kind: EvtFightLost, Cell: f.Cell})
+	}
+}
+
+// Return the cities involved in the Fight, each only once, split between the
+// winners and the losers. The City on the Cell belongs to the defense.
+func (f *Fight) involved(w *World, pCity *City, attackersWin bool) (winners, losers []*City) {
+	seen := make(map[uint64]bool)
+	collect := func(out []*City, c *City) []*City {
+		if c == nil || seen[c.Id] {
+			return out
+		}
+		seen[c.Id] = true
+		return append(out, c)
+	}
+
+	var att, def []*City
+	for _, a := range f.Attack {
+		att = collect(att, a.home(w))
+	}
+	def = collect(def, pCity)
+	for _, a := range f.Defense {
+		def = collect(def, a.home(w))
+	}
+	if attackersWin {
+		return att, def
+	}
+	return def, att
+}
@@ -4,3 +4,122 @@
 // file, You can obtain one at http://mozilla.org/MPL/2.0/.
 
 package region
+
+import (
+	"testing"
+)
+
+// Build a small World with a line of 3 cells, an attacker City on the
+// first cell and a defender City on the last one.
+func newTestWorld() (*World, *City, *City) {
+	w := &World{}
+	w.Init()
+	w.Definitions.Units.Add(&UnitType{Id: 1, Name: "weak", Health: 10, Attack: 1})
+	w.Definitions.Units.Add(&UnitType{Id: 2, Name: "strong", Health: 100, Attack: 50, HealthFactor: 1.0})
+	w.Definitions.RateOverlord = 0.1
+
+	c0 := w.Places.CellCreate()
+	c1 := w.Places.CellCreate()
+	c2 := w.Places.CellCreate()
+	for _, r := range [][2]uint64{{c0.Id, c1.Id}, {c1.Id, c0.Id}, {c1.Id, c2.Id}, {c2.Id, c1.Id}} {
+		w.Places.RoadCreate(r[0], r[1], true)
+	}
+	w.Places.Rehash()
+
+	idAtt, _ := w.CityCreate(c0.Id)
+	idDef, _ := w.CityCreate(c2.Id)
+	c0.City, c2.City = idAtt, idDef
+	return w, w.CityGet(idAtt), w.CityGet(idDef)
+}
+
+func trainUnits(w *World, c *City, idType uint64, nb int) {
+	for i := 0; i < nb; i++ {
+		id := c.UnitCreate(w, w.UnitTypeGet(idType))
+		c.Unit(id).Ticks = 0
+	}
+}
+
+func TestFightStrength(t *testing.T) {
+	ut := &UnitType{Health: 100, Attack: 10, HealthFactor: 0.5}
+	for _, tc := range []struct {
+		health   uint32
+		expected float64
+	}{
+		{100, 10}, {50, 7.5}, {0, 5}, {200, 10},
+	} {
+		f := fighter{unit: &Unit{Health: tc.health}, kind: ut}
+		if s := f.strength(); s != tc.expected {
+			t.Fatal("health", tc.health, "expected", tc.expected, "got", s)
+		}
+	}
+	if s := (fighter{unit: &Unit{Health: 1}}).strength(); s != 0 {
+		t.Fatal()
+	}
+}
+
+func TestFightConquest(t *testing.T) {
+	w, att, def := newTestWorld()
+	trainUnits(w, att, 2, 3)
+	trainUnits(w, def, 1, 2)
+
+	a, _ := w.ArmyCreate(att, "A")
+	for _, u := range append(SetOfUnits{}, att.Units...) {
+		att.TransferOwnUnit(a, u.Id)
+	}
+	a.Targets = append(a.Targets, Command{Cell: def.Cell, Action: CmdCityOverlord})
+
+	for i := 0; i < 10 && def.Overlord == 0; i++ {
+		w.Move()
+	}
+
+	if def.Overlord != att.Id {
+		t.Fatal("no conquest")
+	}
+	if def.Assault != nil || len(w.Live.Fights) != 0 {
+		t.Fatal("fight not closed")
+	}
+	if len(a.Targets) != 0 || a.Fight != 0 || !w.Live.Armies.Has(a.Id) {
+		t.Fatal("army not freed")
+	}
+	if len(a.Units) != 3 {
+		t.Fatal("unexpected losses", len(a.Units))
+	}
+	if len(def.Units) != 0 {
+		t.Fatal("unexpected survivors", len(def.Units))
+	}
+}
+
+func TestFightDefence(t *testing.T) {
+	w, att, def := newTestWorld()
+	trainUnits(w, att, 1, 2)
+	trainUnits(w, def, 2, 1)
+
+	a, _ := w.ArmyCreate(att, "A")
+	for _, u := range append(SetOfUnits{}, att.Units...) {
+		att.TransferOwnUnit(a, u.Id)
+	}
+	a.Targets = append(a.Targets, Command{Cell: def.Cell, Action: CmdCityMassacre})
+
+	for i := 0; i < 10 && !a.Deleted; i++ {
+		w.Move()
+	}
+
+	if !a.Deleted || w.Live.Armies.Has(a.Id) {
+		t.Fatal("attackers not wiped out")
+	}
+	if def.TicksMassacres != 0 {
+		t.Fatal("massacre applied")
+	}
+	if def.Assault != nil || len(w.Live.Fights) != 0 {
+		t.Fatal("fight not closed")
+	}
+
+	// The garrison gets back in the City at the next movement
+	w.Move()
+	if len(def.Units) != 1 {
+		t.Fatal("garrison not back", len(def.Units))
+	}
+	if def.Units[0].Health >= 100 {
+		t.Fatal("no damage")
+	}
+}
@@ -0,0 +1,278 @@
+// Copyright (C) 2018-2020 Hegemonie's AUTHORS
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package region
+
+import (
+	"errors"
+	"log"
+)
+
+var ErrHandoffNotFound = errors.New("Handoff not found")
+
+// Set the name of the Region, as known by the other Regions
+func (w *World) SetName(name string) {
+	w.rw.Lock()
+	defer w.rw.Unlock()
+	w.name = name
+}
+
+func (w *World) Name() string {
+	return w.name
+}
+
+// Return the City controlling the Army, or nil when the Army is abroad
+func (a *Army) home(w *World) *City {
+	if a.Region != "" {
+		return nil
+	}
+	return w.CityGet(a.City)
+}
+
+// Freeze the Army standing on the border Cell, until the Region beyond the
+// border accepts or refuses it.
+func (w *World) depart(a *Army, link *CellLink) {
+	w.Live.Armies.Remove(a)
+	w.reindexArmy(a, a.Cell, 0)
+	if home := a.home(w); home != nil {
+		home.armies.Remove(a)
+	}
+	w.Live.Outbox = append(w.Live.Outbox, &Handoff{
+		Id: w.getNextId(), Region: link.Region, Cell: link.Cell, Army: a,
+	})
+}
+
+func (w *World) outboxGet(id uint64) (int, *Handoff) {
+	for i, h := range w.Live.Outbox {
+		if h.Id == id {
+			return i, h
+		}
+	}
+	return -1, nil
+}
+
+// Return the Army of the transfer as it must be sent to the Region it
+// enters: its home and its commands refer to the Regions by name.
+func (w *World) HandoffExport(h *Handoff) *Army {
+	if h.Army == nil {
+		return nil
+	}
+	a := *h.Army
+	if a.Region == "" {
+		a.Region = w.name
+	}
+	a.Targets = make([]Command, 0, len(h.Army.Targets))
+	for _, cmd := range h.Army.Targets {
+		if cmd.Region == "" {
+			cmd.Region = w.name
+		}
+		a.Targets = append(a.Targets, cmd)
+	}
+	return &a
+}
+
+// The Region beyond the border accepted the Army under the given ID: the
+// Army leaves the current Region for good. The record of the transfer is
+// kept until the other Region commits it.
+func (w *World) HandoffRelease(id, remote uint64) error {
+	_, h := w.outboxGet(id)
+	if h == nil {
+		return ErrHandoffNotFound
+	}
+	if h.Army == nil {
+		// Already released
+		return nil
+	}
+
+	a := h.Army
+	h.Army = nil
+	h.Remote = remote
+	w.notify(a.home(w), Event{Kind: EvtArmyDeparted, Army: a.Id, Cell: h.Cell, Region: h.Region})
+	a.vanish(w)
+	return nil
+}
+
+// The Region beyond the border refused the Army: it stays on the border,
+// without the command that led it there.
+func (w *World) HandoffAbort(id uint64) error {
+	idx, h := w.outboxGet(id)
+	if h == nil {
+		return ErrHandoffNotFound
+	}
+	if h.Army == nil {
+		return errors.New("Army already released")
+	}
+
+	w.Live.Outbox = append(w.Live.Outbox[:idx], w.Live.Outbox[idx+1:]...)
+	a := h.Army
+	if len(a.Targets) > 0 {
+		a.PopCommand()
+	}
+	w.Live.Armies.Add(a)
+	home := a.home(w)
+	if home != nil {
+		home.armies.Add(a)
+	}
+	w.notify(home, Event{Kind: EvtArmyRefused, Army: a.Id, Cell: a.Cell, Region: h.Region})
+	return nil
+}
+
+// The Region the Army entered committed the transfer, its record is dropped
+func (w *World) HandoffDone(id uint64) error {
+	idx, h := w.outboxGet(id)
+	if h == nil {
+		return ErrHandoffNotFound
+	}
+	if h.Army != nil {
+		return errors.New("Army not released")
+	}
+	w.Live.Outbox = append(w.Live.Outbox[:idx], w.Live.Outbox[idx+1:]...)
+	return nil
+}
+
+// Accept the Army leaving the given Region, under the ID of the transfer in
+// that Region. The Army gets fresh IDs and is kept aside until the commit.
+// Accepting the same transfer again returns the same Army.
+func (w *World) HandoffAccept(from string, id, cell uint64, a *Army) (uint64, error) {
+	for _, h := range w.Live.Inbox {
+		if h.Region == from && h.Id == id {
+			return h.Army.Id, nil
+		}
+	}
+
+	if from == "" || from == w.name {
+		return 0, errors.New("Invalid origin")
+	}
+	if !w.Places.CellHas(cell) {
+		return 0, errors.New("Cell not found")
+	}
+	if a.Region == "" {
+		return 0, errors.New("Army without home")
+	}
+	if a.Region == w.name && w.CityGet(a.City) == nil {
+		return 0, errors.New("Home city not found")
+	}
+	for _, u := range a.Units {
+		if w.UnitTypeGet(u.Type) == nil {
+			return 0, errors.New("Unit type not found")
+		}
+	}
+
+	in := &Army{
+		Id:       w.getNextId(),
+		City:     a.City,
+		Region:   a.Region,
+		Cell:     cell,
+		Name:     a.Name,
+		Stock:    a.Stock,
+		Units:    make(SetOfUnits, 0, len(a.Units)),
+		Targets:  make([]Command, 0, len(a.Targets)),
+		Postures: append([]int64{}, a.Postures...),
+
+		DefaultPosture: a.DefaultPosture,
+	}
+	if in.Region == w.name {
+		// Back home
+		in.Region = ""
+	}
+	for _, u := range a.Units {
+		in.Units.Add(&Unit{Id: w.getNextId(), Type: u.Type, Ticks: u.Ticks, Health: u.Health})
+	}
+	for _, cmd := range a.Targets {
+		if cmd.Region == w.name {
+			cmd.Region = ""
+			if cmd.Cell == 0 && in.Region == "" {
+				// The return home, toward the City of the Army
+				cmd.Cell = w.CityGet(a.City).Cell
+			}
+		}
+		in.Targets = append(in.Targets, cmd)
+	}
+
+	w.Live.Inbox = append(w.Live.Inbox, &Handoff{Id: id, Region: from, Cell: cell, Army: in})
+	return in.Id, nil
+}
+
+// The Region the Army left committed the transfer: the Army enters the map.
+// Committing an unknown transfer is not an error, so that the commit may be
+// retried after a lost reply.
+func (w *World) HandoffCommit(from string, id uint64) error {
+	for i, h := range w.Live.Inbox {
+		if h.Region != from || h.Id != id {
+			continue
+		}
+		w.Live.Inbox = append(w.Live.Inbox[:i], w.Live.Inbox[i+1:]...)
+		a := h.Army
+		w.Live.Armies.Add(a)
+		if home := a.home(w); home != nil {
+			home.armies.Add(a)
+			w.notify(home, Event{Kind: EvtArmyReturned, Army: a.Id, Cell: a.Cell, Region: from})
+		}
+		return nil
+	}
+	return nil
+}
+
+// Schedule a command on a Cell of another Region. The Army first heads to
+// the border, the command itself is checked once the border is crossed.
+func (a *Army) DeferAbroad(w *World, region string, cell uint64, action uint) error {
+	if region == "" || region == w.name {
+		return errors.New("Not abroad")
+	}
+	switch action {
+	case CmdPause, CmdCityAttack, CmdCityDefend, CmdCityOverlord, CmdCityLiberate,
+		CmdCityBreak, CmdCityMassacre, CmdCityDeposit, CmdCityDisband:
+	default:
+		return errors.New("Invalid action")
+	}
+
+	cmd := Command{Cell: cell, Action: action, Region: region}
+	if err := w.checkRoute(a.lastCell(), []Command{cmd}); err != nil {
+		return err
+	}
+	a.Targets = append(a.Targets, cmd)
+	return nil
+}
+
+// Send the Army abroad back to its home Region, where its City commands it
+// again. The Cell of the City is unknown abroad, the home Region resolves it
+// when the Army enters.
+func (a *Army) returnHome(w *World) {
+	if err := a.DeferAbroad(w, a.Region, 0, CmdPause); err != nil {
+		log.Println("Army", a.Id, "stuck abroad:", err.Error())
+	}
+}
+
+// Return the Cell where the Army will be after its last command, or 0 when
+// it will be in another Region.
+func (a *Army) lastCell() uint64 {
+	if len(a.Targets) == 0 {
+		return a.Cell
+	}
+	last := a.Targets[len(a.Targets)-1]
+	if last.Region != "" {
+		return 0
+	}
+	return last.Cell
+}
+
+// Head to the border leading to the Region of the command, and cross it
+func (a *Army) moveAbroad(w *World, cmd Command) {
+	border, err := w.Places.BorderTo(a.Cell, cmd.Region)
+	if err != nil {
+		w.notify(a.home(w), Event{Kind: EvtArmyNoRoute, Army: a.Id, Cell: cmd.Cell, Region: cmd.Region})
+		return
+	}
+	if border != a.Cell {
+		next, err := w.Places.PathNextStep(a.Cell, border)
+		if err != nil {
+			return
+		}
+		a.stepTo(w, next)
+	}
+	if a.Cell == border {
+		w.depart(a, w.Places.CellGet(border).Link)
+	}
+}
@@ -0,0 +1,213 @@
+// Copyright (C) 2018-2020 Hegemonie's AUTHORS
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package region
+
+import (
+	"bytes"
+	"testing"
+)
+
+// Add a border Cell next to the given one, leading to the Cell of the
+// other Region
+func addBorder(w *World, near uint64, region string, remote uint64) *MapVertex {
+	b := w.Places.CellCreate()
+	w.Places.RoadCreate(near, b.Id, true)
+	w.Places.RoadCreate(b.Id, near, true)
+	w.Places.Rehash()
+	b.Link = &CellLink{Region: region, Cell: remote}
+	return b
+}
+
+// Play the transfer of the first Army of the Outbox of 'from' to 'to'
+func handoff(t *testing.T, from, to *World) *Army {
+	if len(from.Live.Outbox) != 1 {
+		t.Fatal("outbox", len(from.Live.Outbox))
+	}
+	h := from.Live.Outbox[0]
+	exported := from.HandoffExport(h)
+
+	id, err := to.HandoffAccept(from.Name(), h.Id, h.Cell, exported)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The acceptance is idempotent and the Army isn't live yet
+	if again, err := to.HandoffAccept(from.Name(), h.Id, h.Cell, exported); err != nil || again != id {
+		t.Fatal("accept not idempotent", again, err)
+	}
+	if to.ArmyGet(id) != nil {
+		t.Fatal("army live before the commit")
+	}
+
+	if err = from.HandoffRelease(h.Id, id); err != nil {
+		t.Fatal(err)
+	}
+	nb := len(to.Live.Armies)
+	if err = to.HandoffCommit(from.Name(), h.Id); err != nil {
+		t.Fatal(err)
+	}
+	if err = to.HandoffCommit(from.Name(), h.Id); err != nil || len(to.Live.Armies) != nb+1 {
+		t.Fatal("commit not idempotent", err)
+	}
+	if err = from.HandoffDone(h.Id); err != nil || len(from.Live.Outbox) != 0 {
+		t.Fatal("outbox not drained", err)
+	}
+	return to.ArmyGet(id)
+}
+
+func TestHandoff(t *testing.T) {
+	wa, home, _ := newTestWorld()
+	wb, _, foreign := newTestWorld()
+	wa.SetName("a")
+	wb.SetName("b")
+	rec := &recorder{}
+	wa.SetNotifier(rec)
+
+	ba := addBorder(wa, home.Cell, "b", wb.Places.Cells[0].Id)
+	addBorder(wb, wb.Places.Cells[0].Id, "a", ba.Id)
+
+	trainUnits(wa, home, 1, 2)
+	a, _ := wa.ArmyCreate(home, "A")
+	for _, u := range append(SetOfUnits{}, home.Units...) {
+		home.TransferOwnUnit(a, u.Id)
+	}
+	a.Stock = Resources{10, 10, 10, 10, 10, 10}
+
+	if err := a.DeferAbroad(wa, "a", foreign.Cell, CmdCityDeposit); err == nil {
+		t.Fatal("local region accepted")
+	}
+	if err := a.DeferAbroad(wa, "c", foreign.Cell, CmdCityDeposit); err != ErrNoRoute {
+		t.Fatal("region without border accepted", err)
+	}
+	if err := a.DeferAbroad(wa, "b", foreign.Cell, CmdCityDeposit); err != nil {
+		t.Fatal(err)
+	}
+	// The way back from the other Region cannot be checked
+	if err := a.DeferDeposit(wa, home); err != nil {
+		t.Fatal(err)
+	}
+
+	// The Army reaches the border and is frozen
+	wa.Move()
+	if wa.Live.Armies.Has(a.Id) || len(home.Armies()) != 0 || a.Cell != ba.Id {
+		t.Fatal("army not frozen")
+	}
+
+	abroad := handoff(t, wa, wb)
+	if abroad.Region != "a" || abroad.City != home.Id || len(abroad.Units) != 2 || abroad.Stock[0] != 10 {
+		t.Fatal("unexpected army abroad", abroad)
+	}
+	if len(abroad.Targets) != 2 || abroad.Targets[0].Region != "" || abroad.Targets[1].Region != "a" {
+		t.Fatal("unexpected commands abroad", abroad.Targets)
+	}
+	if len(rec.filter(EvtArmyDeparted, home.Id)) != 1 {
+		t.Fatal("departure not notified")
+	}
+
+	// A World with an Army abroad survives a dump
+	var buf bytes.Buffer
+	if err := wb.DumpJSON(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if err := (&World{}).LoadJSON(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	// The Army deposits its stock abroad then heads back home
+	before := foreign.Stock[0]
+	for i := 0; i < 10 && len(wb.Live.Outbox) == 0; i++ {
+		wb.Move()
+	}
+	if foreign.Stock[0] != before+10 {
+		t.Fatal("no deposit abroad", foreign.Stock)
+	}
+
+	back := handoff(t, wb, wa)
+	if back.Region != "" || back.Cell != ba.Id || !home.armies.Has(back.Id) {
+		t.Fatal("army not back home", back)
+	}
+	if len(back.Targets) != 1 || back.Targets[0].Region != "" || back.Targets[0].Cell != home.Cell {
+		t.Fatal("unexpected commands back home", back.Targets)
+	}
+	if len(rec.filter(EvtArmyReturned, home.Id)) != 1 {
+		t.Fatal("return not notified")
+	}
+}
+
+func TestHandoffRefused(t *testing.T) {
+	wa, home, _ := newTestWorld()
+	wa.SetName("a")
+	ba := addBorder(wa, home.Cell, "b", 42)
+
+	a, _ := wa.ArmyCreate(home, "A")
+	if err := a.DeferAbroad(wa, "b", 7, CmdPause); err != nil {
+		t.Fatal(err)
+	}
+	wa.Move()
+	h := wa.Live.Outbox[0]
+
+	// The other Region doesn't know the Cell
+	wb, _, _ := newTestWorld()
+	wb.SetName("b")
+	if _, err := wb.HandoffAccept("a", h.Id, h.Cell, wa.HandoffExport(h)); err == nil {
+		t.Fatal("unknown cell accepted")
+	}
+	if len(wb.Live.Inbox) != 0 {
+		t.Fatal("refused army kept")
+	}
+
+	if err := wa.HandoffAbort(h.Id); err != nil {
+		t.Fatal(err)
+	}
+	if !wa.Live.Armies.Has(a.Id) || !home.armies.Has(a.Id) || a.Cell != ba.Id || len(a.Targets) != 0 {
+		t.Fatal("army not restored", a)
+	}
+	if err := wa.HandoffDone(h.Id); err != ErrHandoffNotFound {
+		t.Fatal("handoff still known", err)
+	}
+}
+
+func TestHandoffReturn(t *testing.T) {
+	wa, home, _ := newTestWorld()
+	wb, _, foreign := newTestWorld()
+	wa.SetName("a")
+	wb.SetName("b")
+
+	ba := addBorder(wa, home.Cell, "b", wb.Places.Cells[0].Id)
+	addBorder(wb, wb.Places.Cells[0].Id, "a", ba.Id)
+
+	a, _ := wa.ArmyCreate(home, "A")
+	if err := a.DeferAbroad(wa, "b", foreign.Cell, CmdPause); err != nil {
+		t.Fatal(err)
+	}
+	wa.Move()
+	abroad := handoff(t, wa, wb)
+
+	// Once its commands are done, nobody commands the Army abroad: it heads
+	// back to its home Region
+	for i := 0; i < 10 && len(wb.Live.Outbox) == 0; i++ {
+		wb.Move()
+	}
+	if len(abroad.Targets) != 1 || abroad.Targets[0].Region != "a" || abroad.Targets[0].Cell != 0 {
+		t.Fatal("return not scheduled", abroad.Targets)
+	}
+
+	back := handoff(t, wb, wa)
+	if len(back.Targets) != 1 || back.Targets[0].Region != "" || back.Targets[0].Cell != home.Cell {
+		t.Fatal("return not resolved", back.Targets)
+	}
+	for i := 0; i < 10 && len(back.Targets) > 0; i++ {
+		wa.Move()
+	}
+	if back.Region != "" || back.Cell != home.Cell || len(back.Targets) != 0 || !home.armies.Has(back.Id) {
+		t.Fatal("army not back in its city", back)
+	}
+
+	// Back home, the Army stays until its City commands it
+	wa.Move()
+	if len(back.Targets) != 0 || back.Cell != home.Cell {
+		t.Fatal("army moved home", back.Targets)
+	}
+}
@@ -6,6 +6,7 @@
 package region
 
 import (
+	"container/heap"
 	"errors"
 	"sort"
 	"strconv"
@@ -13,6 +14,8 @@ import (
 	"sync/atomic"
 )
 
+var ErrNoRoute = errors.New("No route")
+
 func (r SetOfEdges) Len() int      { return len(r) }
 func (r SetOfEdges) Swap(i, j int) { r[i], r[j] = r[j], r[i] }
 func (r SetOfEdges) Less(i, j int) bool {
@@ -27,14 +30,14 @@ func (r SetOfEdges) First(at uint64) int {
 
 func (s *SetOfEdges) Add(e *MapEdge) {
 	*s = append(*s, e)
-	if nb := len(*s); nb > 2 && !sort.IsSorted((*s)[nb-2:]) {
+	if nb := len(*s); nb > 1 && !sort.IsSorted((*s)[nb-2:]) {
 		sort.Sort(*s)
 	}
 }
 
 func (s SetOfEdges) Get(src, dst uint64) *MapEdge {
 	i := sort.Search(len(s), func(i int) bool {
-		return s[i].S >= src || (s[i].S == src && s[i].D == dst)
+		return s[i].S > src || (s[i].S == src && s[i].D >= dst)
 	})
 	if i < len(s) && s[i].S == src && s[i].D == dst {
 		return s[i]
@@ -73,7 +76,7 @@ func (m *Map) RoadCreateRaw(src, dst uint64) *MapEdge {
 		panic("Invalid Edge parameters")
 	}
 
-	e := &MapEdge{src, dst, false}
+	e := &MapEdge{S: src, D: dst}
 	m.Roads = append(m.Roads, e)
 	return e
 }
@@ -98,7 +101,7 @@ func (m *Map) RoadCreate(src, dst uint64, check bool) error {
 			return errors.New("MapEdge exists")
 		}
 	} else {
-		m.Roads.Add(&MapEdge{src, dst, false})
+		m.Roads.Add(&MapEdge{S: src, D: dst})
 		return nil
 	}
 }
@@ -136,8 +139,107 @@ func (m *Map) PathNextStep(src, dst uint64) (uint64, error) {
 	if ok {
 		return next, nil
 	} else {
-		return 0, errors.New("No route")
+		return 0, ErrNoRoute
+	}
+}
+
+// Return the movement points needed to go from src to the adjacent dst: the
+// cost of the road times the MoveCost of the Biome of dst.
+func (m *Map) StepCost(src, dst uint64) (uint64, error) {
+	e := m.Roads.Get(src, dst)
+	if e == nil || e.Deleted {
+		return 0, ErrNoRoute
 	}
+	return m.roadCost(e), nil
+}
+
+func (m *Map) roadCost(e *MapEdge) uint64 {
+	cost := e.Cost
+	if cost == 0 {
+		cost = 1
+	}
+	if c := m.CellGet(e.D); c != nil {
+		if factor := m.biomes[c.Biome]; factor > 0 {
+			cost *= factor
+		}
+	}
+	return cost
+}
+
+// Install the MoveCost of the biomes, for the next Rehash
+func (m *Map) SetBiomes(biomes []*Biome) {
+	m.biomes = make(map[uint64]uint64)
+	for _, b := range biomes {
+		m.biomes[b.Id] = b.MoveCost
+	}
+}
+
+// Return the cost of the route from src to dst, following the routing table
+func (m *Map) distance(src, dst uint64) (uint64, bool) {
+	var total uint64
+	for nb := 0; src != dst; nb++ {
+		next, ok := m.steps[vector{src, dst}]
+		if !ok || nb > len(m.Cells) {
+			return 0, false
+		}
+		cost, err := m.StepCost(src, next)
+		if err != nil {
+			return 0, false
+		}
+		total += cost
+		src = next
+	}
+	return total, true
+}
+
+// Return the nearest border Cell leading to the given Region, reachable
+// from src. src itself is returned when it is such a border.
+func (m *Map) BorderTo(src uint64, region string) (uint64, error) {
+	var best, bestDist uint64
+	found := false
+	for _, c := range m.Cells {
+		if c.Link == nil || c.Link.Region != region {
+			continue
+		}
+		if d, ok := m.distance(src, c.Id); ok && (!found || d < bestDist) {
+			best, bestDist, found = c.Id, d, true
+		}
+	}
+	if !found {
+		return 0, ErrNoRoute
+	}
+	return best, nil
+}
+
+// Return the Cells reachable from the center within the given number of
+// steps, sorted by ID. The center itself is part of the set.
+func (m *Map) CellsAround(center uint64, radius uint32) (SetOfVertices, error) {
+	c := m.CellGet(center)
+	if c == nil {
+		return nil, errors.New("Cell not found")
+	}
+
+	already := map[uint64]bool{center: true}
+	out := SetOfVertices{c}
+	frontier := []uint64{center}
+	for step := uint32(0); step < radius && len(frontier) > 0; step++ {
+		var next []uint64
+		for _, id := range frontier {
+			for _, adj := range m.CellAdjacency(id) {
+				if already[adj] {
+					continue
+				}
+				already[adj] = true
+				if v := m.CellGet(adj); v != nil {
+					out = append(out, v)
+					next = append(next, adj)
+				}
+			}
+		}
+		frontier = next
+	}
+	sort.Sort(&out)
+	return out, nil
 }
 
 func (m *Map) CellAdjacency(id uint64) []uint64 {
@@ -158,6 +260,11 @@ func (m *Map) Check(w *World) error {
 	if err := m.Cells.Check(); err != nil {
 		return err
 	}
+	for _, c := range m.Cells {
+		if c.Link != nil && (c.Link.Region == "" || c.Link.Cell == 0) {
+			return errors.New("Invalid border at cell " + strconv.FormatUint(c.Id, 10))
+		}
+	}
 	return nil
 }
 
@@ -181,6 +288,8 @@ func (m *Map) Dot() string {
 	return sb.String()
 }
 
+// Rebuild the routing table with the cheapest routes between all the Cells,
+// the open roads only.
 func (m *Map) Rehash() {
 	next := make(map[vector]uint64)
 
@@ -188,49 +297,46 @@ func (m *Map) Rehash() {
 	sort.Sort(&m.Cells)
 	sort.Sort(&m.Roads)
 
-	// Fill with the immediate neighbors
-	for _, r := range m.Roads {
-		next[vector{r.S, r.D}] = r.D
+	for _, cell := range m.Cells {
+		m.routesFrom(cell.Id, next)
 	}
 
-	add := func(src, dst, step uint64) {
-		_, found := next[vector{src, dst}]
-		if !found {
-			next[vector{src, dst}] = step
-		}
-	}
+	m.steps = next
+}
 
-	// Call one DFS per node and shortcut when possible
-	for _, cell := range m.Cells {
-		already := make(map[uint64]bool)
-		q := newQueue()
-
-		// Bootstrap the DFS with adjacent nodes
-		for _, next := range m.CellAdjacency(cell.Id) {
-			q.push(next, next)
-			already[next] = true
-			// No need to add this in the known routes, we already did it
-			// with an iteration on the roads (much faster)
+// Run a Dijkstra from src and record the first step toward each reachable
+// Cell. The ties are broken by the ID of the first step, so that the routes
+// are stable.
+func (m *Map) routesFrom(src uint64, next map[vector]uint64) {
+	best := make(map[uint64]route)
+	done := make(map[uint64]bool)
+	h := &routeHeap{{cell: src}}
+
+	for h.Len() > 0 {
+		r := heap.Pop(h).(route)
+		if done[r.cell] {
+			continue
+		}
+		done[r.cell] = true
+		if r.cell != src {
+			next[vector{src, r.cell}] = r.first
 		}
 
-		for !q.empty() {
-			current, first := q.pop()
-			neighbors := m.CellAdjacency(current)
-			// TODO(jfs): shuffle the neighbors
-			for _, next := range neighbors {
-				if !already[next] {
-					// Avoid passing again in the neighbor
-					already[next] = true
-					// Tell to contine at that neighbor
-					q.push(next, first)
-					// We already learned the shortest path to that neighbor
-					add(cell.Id, next, first)
-				}
+		for i := m.Roads.First(r.cell); i < len(m.Roads) && m.Roads[i].S == r.cell; i++ {
+			e := m.Roads[i]
+			if e.Deleted || done[e.D] {
+				continue
+			}
+			nr := route{cell: e.D, cost: r.cost + m.roadCost(e), first: r.first}
+			if r.cell == src {
+				nr.first = e.D
+			}
+			if old, ok := best[e.D]; !ok || nr.less(old) {
+				best[e.D] = nr
+				heap.Push(h, nr)
 			}
 		}
 	}
-
-	m.steps = next
 }
 
 type vector struct {
@@ -238,32 +344,32 @@ type vector struct {
 	dst uint64
 }
 
-type dfsTrack struct {
-	current uint64
-	first   uint64
-}
-
-type queue struct {
-	tab   []dfsTrack
-	start int
-}
-
-func newQueue() queue {
-	var q queue
-	q.tab = make([]dfsTrack, 0)
-	return q
+// A route toward a Cell, with its total cost and its first step
+type route struct {
+	cell  uint64
+	cost  uint64
+	first uint64
 }
 
-func (q *queue) push(node, first uint64) {
-	q.tab = append(q.tab, dfsTrack{node, first})
-}
-
-func (q *queue) pop() (uint64, uint64) {
-	v := q.tab[q.start]
-	q.start++
-	return v.current, v.first
+func (r route) less(o route) bool {
+	if r.cost != o.cost {
+		return r.cost < o.cost
+	}
+	if r.first != o.first {
+		return r.first < o.first
+	}
+	return r.cell < o.cell
 }
 
-func (q *queue) empty() bool {
-	return q.start == len(q.tab)
+type routeHeap []route
+
+func (h routeHeap) Len() int            { return len(h) }
+func (h routeHeap) Less(i, j int) bool  { return h[i].less(h[j]) }
+func (h routeHeap) Swap(i, j int)       { h[i], h[j] = h[j], h[i] }
+func (h *routeHeap) Push(x interface{}) { *h = append(*h, x.(route)) }
+func (h *routeHeap) Pop() interface{} {
+	old := *h
+	x := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return x
 }
@@ -248,3 +248,94 @@ func TestMapGrid(t *testing.T) {
 
 	t.Logf("Done at %v", time.Now())
 }
+
+func TestMapCellsAround(t *testing.T) {
+	var m Map
+	m.Init()
+
+	// A one-way chain c0 -> c1 -> c2 -> c3, plus c3 -> c0
+	var cells []*MapVertex
+	for i := 0; i < 4; i++ {
+		cells = append(cells, m.CellCreate())
+	}
+	for i := 0; i < 4; i++ {
+		m.RoadCreateRaw(cells[i].Id, cells[(i+1)%4].Id)
+	}
+	m.Rehash()
+
+	if _, err := m.CellsAround(42, 1); err == nil {
+		t.Fatal("unknown center accepted")
+	}
+
+	for _, tc := range []struct {
+		center   int
+		radius   uint32
+		expected []int
+	}{
+		{0, 0, []int{0}},
+		{0, 1, []int{0, 1}},
+		{0, 2, []int{0, 1, 2}},
+		{2, 2, []int{0, 2, 3}},
+		{1, 10, []int{0, 1, 2, 3}},
+	} {
+		around, err := m.CellsAround(cells[tc.center].Id, tc.radius)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(around) != len(tc.expected) {
+			t.Fatal("center", tc.center, "radius", tc.radius, "got", len(around), "cells")
+		}
+		for i, idx := range tc.expected {
+			if around[i].Id != cells[idx].Id {
+				t.Fatal("center", tc.center, "radius", tc.radius, "unexpected cell", around[i].Id)
+			}
+		}
+	}
+}
+
+func TestMapWeightedPath(t *testing.T) {
+	var m Map
+	m.Init()
+
+	// Two routes from c0 to c3: through c1 on a long road, through c2 in
+	// the mountains
+	var cells []*MapVertex
+	for i := 0; i < 4; i++ {
+		cells = append(cells, m.CellCreate())
+	}
+	for _, r := range [][3]int{{0, 1, 0}, {1, 3, 5}, {0, 2, 0}, {2, 3, 0}} {
+		m.RoadCreateRaw(cells[r[0]].Id, cells[r[1]].Id).Cost = uint64(r[2])
+	}
+	cells[2].Biome = 7
+	m.SetBiomes([]*Biome{{Id: 7, Name: "mountain", MoveCost: 3}})
+	m.Rehash()
+
+	for _, tc := range []struct {
+		src, dst int
+		expected uint64
+	}{
+		{0, 1, 1}, {1, 3, 5}, {0, 2, 3}, {2, 3, 1},
+	} {
+		if c, err := m.StepCost(cells[tc.src].Id, cells[tc.dst].Id); err != nil || c != tc.expected {
+			t.Fatal("road", tc.src, tc.dst, "cost", c, err)
+		}
+	}
+	if _, err := m.StepCost(cells[0].Id, cells[3].Id); err != ErrNoRoute {
+		t.Fatal("cost of a missing road", err)
+	}
+
+	// 1+5 through c1, 3+1 through c2
+	if next, _ := m.PathNextStep(cells[0].Id, cells[3].Id); next != cells[2].Id {
+		t.Fatal("expensive route chosen", next)
+	}
+
+	// Closed roads are avoided
+	m.RoadDelete(cells[2].Id, cells[3].Id, true)
+	m.Rehash()
+	if next, _ := m.PathNextStep(cells[0].Id, cells[3].Id); next != cells[1].Id {
+		t.Fatal("closed road taken", next)
+	}
+	if d, ok := m.distance(cells[0].Id, cells[3].Id); !ok || d != 6 {
+		t.Fatal("unexpected distance", d)
+	}
+}
@@ -0,0 +1,193 @@
+// Copyright (C) 2018-2020 Hegemonie's AUTHORS
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package region
+
+import (
+	"errors"
+	"log"
+	"math"
+	"sort"
+)
+
+var ErrOrderNotFound = errors.New("Order not found")
+
+// Place an Order on the market of the Region. The escrow is taken from the
+// Stock of the City: the goods of an ask, the payment at the given price of
+// a bid.
+func (c *City) OrderPlace(w *World, resource uint32, bid bool, quantity, price uint64) (*Order, error) {
+	if resource >= ResourceMax || resource == w.Definitions.MarketCurrency {
+		return nil, errors.New("Invalid resource")
+	}
+	if quantity == 0 || price == 0 || price > math.MaxUint64/quantity {
+		return nil, errors.New("Invalid quantity or price")
+	}
+
+	var escrow Resources
+	if bid {
+		escrow[w.Definitions.MarketCurrency] = quantity * price
+	} else {
+		escrow[resource] = quantity
+	}
+	if !c.Stock.GreaterOrEqualTo(escrow) {
+		return nil, errors.New("Insufficient resources")
+	}
+
+	c.Stock.Remove(escrow)
+	o := &Order{
+		Id: w.getNextId(), City: c.Id, Resource: resource, Bid: bid,
+		Quantity: quantity, Price: price, Escrow: escrow,
+	}
+	w.Live.Orders = append(w.Live.Orders, o)
+	return o, nil
+}
+
+// Withdraw an Order of the City, its escrow comes back in the Stock
+func (c *City) OrderCancel(w *World, id uint64) error {
+	idx := w.orderIndex(id)
+	if idx < 0 || w.Live.Orders[idx].City != c.Id {
+		return ErrOrderNotFound
+	}
+	c.Stock.Add(w.Live.Orders[idx].Escrow)
+	w.Live.Orders = append(w.Live.Orders[:idx], w.Live.Orders[idx+1:]...)
+	return nil
+}
+
+// Return the Orders of the City
+func (c *City) Orders(w *World) []*Order {
+	out := make([]*Order, 0)
+	for _, o := range w.Live.Orders {
+		if o.City == c.Id {
+			out = append(out, o)
+		}
+	}
+	return out
+}
+
+// Return the Orders with an ID greater than the marker, at most max of them
+func (w *World) OrderSlice(marker uint64, max uint32) []*Order {
+	start := sort.Search(len(w.Live.Orders), func(i int) bool {
+		return w.Live.Orders[i].Id > marker
+	})
+	end := start + int(max)
+	if end > len(w.Live.Orders) {
+		end = len(w.Live.Orders)
+	}
+	return w.Live.Orders[start:end]
+}
+
+func (w *World) orderIndex(id uint64) int {
+	i := sort.Search(len(w.Live.Orders), func(i int) bool {
+		return w.Live.Orders[i].Id >= id
+	})
+	if i < len(w.Live.Orders) && w.Live.Orders[i].Id == id {
+		return i
+	}
+	return -1
+}
+
+// Match the crossing Orders of each Resource, the best prices first. The
+// oldest Order of each pair sets the price of the trade. A City never trades
+// with itself, nor with a City it cannot send transports to.
+func (w *World) matchOrders() {
+	for r := uint32(0); r < ResourceMax; r++ {
+		var bids, asks []*Order
+		for _, o := range w.Live.Orders {
+			if o.Resource != r {
+				continue
+			} else if o.Bid {
+				bids = append(bids, o)
+			} else {
+				asks = append(asks, o)
+			}
+		}
+		sort.SliceStable(bids, func(i, j int) bool { return bids[i].Price > bids[j].Price })
+		sort.SliceStable(asks, func(i, j int) bool { return asks[i].Price < asks[j].Price })
+
+		for _, b := range bids {
+			for _, a := range asks {
+				if b.Quantity == 0 || b.Price < a.Price {
+					break
+				}
+				if a.Quantity == 0 || !w.tradable(b, a) {
+					continue
+				}
+				price := a.Price
+				if b.Id < a.Id {
+					price = b.Price
+				}
+				qty := b.Quantity
+				if a.Quantity < qty {
+					qty = a.Quantity
+				}
+				w.trade(b, a, qty, price)
+			}
+		}
+	}
+
+	// Drop the filled Orders, the change of the bids returns to the buyers
+	kept := w.Live.Orders[:0]
+	for _, o := range w.Live.Orders {
+		if o.Quantity > 0 {
+			kept = append(kept, o)
+		} else if c := w.CityGet(o.City); c != nil {
+			c.Stock.Add(o.Escrow)
+		}
+	}
+	w.Live.Orders = kept
+}
+
+func (w *World) trade(b, a *Order, qty, price uint64) {
+	var goods, payment Resources
+	goods[a.Resource] = qty
+	payment[w.Definitions.MarketCurrency] = qty * price
+
+	a.Quantity -= qty
+	a.Escrow.Remove(goods)
+	b.Quantity -= qty
+	b.Escrow.Remove(payment)
+
+	buyer, seller := w.CityGet(b.City), w.CityGet(a.City)
+	w.deliver(seller, buyer, goods)
+	w.deliver(buyer, seller, payment)
+	w.notify(buyer, Event{Kind: EvtMarketBought, Other: a.City, Resources: &goods})
+	w.notify(seller, Event{Kind: EvtMarketSold, Other: b.City, Resources: &payment})
+}
+
+// Tell if the cities of both Orders may trade: distinct cities, and a route
+// for the transports each way unless the transfers are instant.
+func (w *World) tradable(b, a *Order) bool {
+	if b.City == a.City {
+		return false
+	}
+	buyer, seller := w.CityGet(b.City), w.CityGet(a.City)
+	if buyer == nil || seller == nil {
+		return false
+	}
+	if w.Definitions.InstantTransfers {
+		return true
+	}
+	if _, err := w.Places.PathNextStep(seller.Cell, buyer.Cell); err != nil {
+		return false
+	}
+	_, err := w.Places.PathNextStep(buyer.Cell, seller.Cell)
+	return err == nil
+}
+
+// Deliver Resources out of an escrow, with a transport unless the transfers
+// are instant. Should the transport fail, the Resources stay with the sender.
+func (w *World) deliver(from, to *City, amount Resources) {
+	if to == nil {
+		return
+	}
+	if from == nil || from == to || w.Definitions.InstantTransfers {
+		to.Stock.Add(amount)
+		return
+	}
+	from.Stock.Add(amount)
+	if _, err := from.SendResourcesTo(w, "Market", to, amount); err != nil {
+		log.Println("Market delivery error:", err.Error())
+	}
+}
@@ -0,0 +1,147 @@
+// Copyright (C) 2018-2020 Hegemonie's AUTHORS
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package region
+
+import (
+	"math"
+	"testing"
+)
+
+func TestMarketPlace(t *testing.T) {
+	w, c, other := newTestWorld()
+	c.Stock = Resources{100, 100}
+
+	for _, tc := range []struct {
+		resource uint32
+		bid      bool
+		quantity uint64
+		price    uint64
+	}{
+		{0, false, 1, 1},
+		{ResourceMax, false, 1, 1},
+		{1, false, 0, 1},
+		{1, false, 1, 0},
+		{1, true, 2, math.MaxUint64},
+		{1, false, 101, 1},
+		{1, true, 51, 2},
+	} {
+		if _, err := c.OrderPlace(w, tc.resource, tc.bid, tc.quantity, tc.price); err == nil {
+			t.Fatal("order accepted", tc)
+		}
+	}
+	if !c.Stock.Equals(Resources{100, 100}) {
+		t.Fatal("stock altered by the refused orders", c.Stock)
+	}
+
+	ask, err := c.OrderPlace(w, 1, false, 10, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	bid, err := c.OrderPlace(w, 2, true, 10, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !c.Stock.Equals(Resources{70, 90}) || len(c.Orders(w)) != 2 {
+		t.Fatal("no escrow", c.Stock)
+	}
+
+	if err = other.OrderCancel(w, ask.Id); err != ErrOrderNotFound {
+		t.Fatal("order cancelled by another city", err)
+	}
+	for _, id := range []uint64{ask.Id, bid.Id} {
+		if err = c.OrderCancel(w, id); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if !c.Stock.Equals(Resources{100, 100}) || len(w.Live.Orders) != 0 {
+		t.Fatal("escrow not returned", c.Stock)
+	}
+}
+
+func TestMarketMatch(t *testing.T) {
+	w, seller, buyer := newTestWorld()
+	w.Definitions.InstantTransfers = true
+	rec := &recorder{}
+	w.SetNotifier(rec)
+	seller.Stock = Resources{0, 10}
+	buyer.Stock = Resources{100}
+
+	ask, _ := seller.OrderPlace(w, 1, false, 10, 3)
+	if _, err := buyer.OrderPlace(w, 1, true, 4, 5); err != nil {
+		t.Fatal(err)
+	}
+	low, _ := buyer.OrderPlace(w, 1, true, 4, 2)
+
+	// The ask is the oldest, the trade happens at its price and the change
+	// of the bid comes back to the buyer
+	w.matchOrders()
+	if !buyer.Stock.Equals(Resources{100 - 12 - 8, 4}) || !seller.Stock.Equals(Resources{12}) {
+		t.Fatal("unexpected stocks", buyer.Stock, seller.Stock)
+	}
+	if len(w.Live.Orders) != 2 || w.Live.Orders[0] != ask || ask.Quantity != 6 || w.Live.Orders[1] != low {
+		t.Fatal("unexpected order book", w.Live.Orders)
+	}
+	if len(rec.filter(EvtMarketBought, buyer.Id)) != 1 || len(rec.filter(EvtMarketSold, seller.Id)) != 1 {
+		t.Fatal("missing events")
+	}
+
+	// The orders don't cross anymore
+	w.matchOrders()
+	if len(w.Live.Orders) != 2 || ask.Quantity != 6 {
+		t.Fatal("unexpected trade")
+	}
+}
+
+func TestMarketTransport(t *testing.T) {
+	w, seller, buyer := newTestWorld()
+	w.Definitions.InstantTransfers = false
+	seller.Stock = Resources{0, 10}
+	buyer.Stock = Resources{30}
+
+	seller.OrderPlace(w, 1, false, 10, 3)
+	buyer.OrderPlace(w, 1, true, 10, 3)
+	w.matchOrders()
+	if len(w.Live.Orders) != 0 || len(seller.Transports()) != 1 || len(buyer.Transports()) != 1 {
+		t.Fatal("no transport", w.Live.Orders)
+	}
+
+	w.Move()
+	w.Move()
+	if !buyer.Stock.Equals(Resources{0, 10}) || !seller.Stock.Equals(Resources{30}) {
+		t.Fatal("not delivered", buyer.Stock, seller.Stock)
+	}
+}
+
+func TestMarketUnmatched(t *testing.T) {
+	w, seller, buyer := newTestWorld()
+	w.Definitions.InstantTransfers = false
+	far := w.Places.CellCreate()
+	idFar, _ := w.CityCreate(far.Id)
+	far.City = idFar
+	isolated := w.CityGet(idFar)
+	seller.Stock = Resources{100, 10}
+	isolated.Stock = Resources{100}
+
+	// The own orders of a City and the City without route don't match
+	ask, _ := seller.OrderPlace(w, 1, false, 10, 3)
+	seller.OrderPlace(w, 1, true, 10, 5)
+	isolated.OrderPlace(w, 1, true, 10, 4)
+	w.matchOrders()
+	if ask.Quantity != 10 || len(w.Live.Orders) != 3 || len(seller.Transports()) != 0 {
+		t.Fatal("unexpected trade", w.Live.Orders)
+	}
+	if !isolated.Stock.Equals(Resources{60}) {
+		t.Fatal("goods delivered without route", isolated.Stock)
+	}
+
+	// Another City with a route does
+	buyer.Stock = Resources{30}
+	buyer.OrderPlace(w, 1, true, 10, 3)
+	w.matchOrders()
+	if ask.Quantity != 0 || len(seller.Transports()) != 1 || len(buyer.Transports()) != 1 {
+		t.Fatal("no trade", w.Live.Orders)
+	}
+}
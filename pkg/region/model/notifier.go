@@ -0,0 +1,148 @@
+// Copyright (C) 2018-2020 Hegemonie's AUTHORS
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package region
+
+type EventKind string
+
+const (
+	// A Unit finished its training. Type is the UnitType.
+	EvtUnitTrained EventKind = "unit.trained"
+	// A Building has been completed. Type is the BuildingType.
+	EvtBuildingCompleted EventKind = "building.completed"
+	// A Knowledge has been learned. Type is the KnowledgeType.
+	EvtKnowledgeLearned EventKind = "knowledge.learned"
+
+	// The City paid its tax to its Overlord (Other)
+	EvtTaxPaid EventKind = "tax.paid"
+	// The City received the tax of its liege (Other)
+	EvtTaxReceived EventKind = "tax.received"
+
+	// The City has been conquered by Other
+	EvtCityConquered EventKind = "city.conquered"
+	// The City conquered Other
+	EvtConquestDone EventKind = "city.conquest"
+	// The City has been liberated from its Overlord by Other
+	EvtCityLiberated EventKind = "city.liberated"
+	// The City liberated Other from its Overlord
+	EvtLiberationDone EventKind = "city.liberation"
+	// The City is not the Overlord of Other anymore
+	EvtLiegeLost EventKind = "city.liege.lost"
+	// The City defeated its Overlord (Other) and gained its freedom
+	EvtCityFreed EventKind = "city.freed"
+
+	// The Army moved to Cell
+	EvtArmyMoved EventKind = "army.moved"
+	// The Army of Other passed by the City
+	EvtArmyPassage EventKind = "army.passage"
+	// The Army reached the Cell of its current command
+	EvtArmyArrived EventKind = "army.arrived"
+	// The Army has no route to the Cell of its current command
+	EvtArmyNoRoute EventKind = "army.noroute"
+	// The Army fled its Fight
+	EvtArmyFled EventKind = "army.fled"
+	// The Army changed its side in its Fight
+	EvtArmyFlipped EventKind = "army.flipped"
+	// The Army has been disbanded in Other, with Count units
+	EvtArmyDisbanded EventKind = "army.disbanded"
+	// The Army crossed the border to Region, where it entered on Cell
+	EvtArmyDeparted EventKind = "army.departed"
+	// The Army has been refused by Region at the border
+	EvtArmyRefused EventKind = "army.refused"
+	// The Army came back from Region, on Cell
+	EvtArmyReturned EventKind = "army.returned"
+
+	// The Army deposited Resources in Other
+	EvtResourcesDeposited EventKind = "resources.deposited"
+	// The City received Resources from an Army of Other
+	EvtResourcesReceived EventKind = "resources.received"
+	// The City received Count units from a disbanded Army of Other
+	EvtUnitsReceived EventKind = "units.received"
+
+	// The transport Army left with Resources for Other
+	EvtTransportSent EventKind = "transport.sent"
+	// The transport Army has been intercepted on Cell by an Army of Other
+	EvtTransportLost EventKind = "transport.lost"
+	// The Army seized on Cell the Resources of a transport of Other
+	EvtTransportSeized EventKind = "transport.seized"
+
+	// The City bought Resources on the market from Other
+	EvtMarketBought EventKind = "market.bought"
+	// The City sold Resources on the market to Other, and got paid Resources
+	EvtMarketSold EventKind = "market.sold"
+
+	// Other proposed a Treaty to the City. Type is the kind of Treaty.
+	EvtTreatyProposed EventKind = "treaty.proposed"
+	// The Treaty between the City and Other is in force. Type is the kind of Treaty.
+	EvtTreatyAccepted EventKind = "treaty.accepted"
+	// The Treaty between the City and Other has been broken or withdrawn by
+	// Other. Type is the kind of Treaty.
+	EvtTreatyBroken EventKind = "treaty.broken"
+	// Other declared the war to the City
+	EvtWarDeclared EventKind = "war.declared"
+
+	// The City suffered a massacre by an Army of Other
+	EvtMassacreSuffered EventKind = "massacre.suffered"
+	// The Army massacred the population of Other
+	EvtMassacreDone EventKind = "massacre.done"
+	// An Army of Other broke a Building of the City. Type is the BuildingType.
+	EvtBuildingBroken EventKind = "building.broken"
+	// The Army broke a Building of Other. Type is the BuildingType.
+	EvtBuildingDestroyed EventKind = "building.destroyed"
+
+	// A Unit of the City died in a Fight. Type is the UnitType.
+	EvtUnitKilled EventKind = "unit.killed"
+	// A Fight involving the City ended on Cell, with a victory
+	EvtFightWon EventKind = "fight.won"
+	// A Fight involving the City ended on Cell, with a defeat
+	EvtFightLost EventKind = "fight.lost"
+)
+
+// A fact of the game, addressed to a City and to the Characters in charge of
+// it. The meaning of the optional fields depends on the Kind.
+type Event struct {
+	Kind EventKind
+
+	// The City notified, and the Characters in charge of it at that moment
+	City   uint64
+	Owner  uint64
+	Deputy uint64 `json:",omitempty"`
+
+	// The movement tick during which the event happened
+	Tick uint64
+
+	Army      uint64     `json:",omitempty"`
+	Other     uint64     `json:",omitempty"`
+	Cell      uint64     `json:",omitempty"`
+	Type      uint64     `json:",omitempty"`
+	Count     int        `json:",omitempty"`
+	Resources *Resources `json:",omitempty"`
+	Region    string     `json:",omitempty"`
+}
+
+// Receives the events of the World. Notify is called with the lock of the
+// World held, so it must neither block nor call the World.
+type Notifier interface {
+	Notify(evt Event)
+}
+
+// Install the Notifier of the World. nil disables the notifications.
+func (w *World) SetNotifier(n Notifier) {
+	w.rw.Lock()
+	defer w.rw.Unlock()
+	w.notifier = n
+}
+
+// Complete the event with the information about the City then emit it
+func (w *World) notify(c *City, evt Event) {
+	if w.notifier == nil || c == nil {
+		return
+	}
+	evt.City = c.Id
+	evt.Owner = c.Owner
+	evt.Deputy = c.Deputy
+	evt.Tick = w.Clock.Tick
+	w.notifier.Notify(evt)
+}
@@ -0,0 +1,126 @@
+// Copyright (C) 2018-2020 Hegemonie's AUTHORS
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package region
+
+import (
+	"testing"
+)
+
+type recorder struct {
+	events []Event
+}
+
+func (r *recorder) Notify(evt Event) {
+	r.events = append(r.events, evt)
+}
+
+// Return the events of the given kind addressed to the City
+func (r *recorder) filter(kind EventKind, city uint64) []Event {
+	out := make([]Event, 0)
+	for _, evt := range r.events {
+		if evt.Kind == kind && evt.City == city {
+			out = append(out, evt)
+		}
+	}
+	return out
+}
+
+func TestNotifyProduction(t *testing.T) {
+	w, c, _ := newTestWorld()
+	w.Definitions.Buildings.Add(&BuildingType{Id: 7, Name: "b", Ticks: 2})
+	w.Definitions.Knowledges.Add(&KnowledgeType{Id: 9, Name: "k", Ticks: 1})
+	c.Owner, c.Deputy = 3, 4
+
+	uid := c.UnitCreate(w, w.UnitTypeGet(1))
+	c.Unit(uid).Ticks = 1
+	if _, err := c.Build(w, 7); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Study(w, 9); err != nil {
+		t.Fatal(err)
+	}
+
+	r := &recorder{}
+	w.SetNotifier(r)
+	for i := 0; i < 3; i++ {
+		c.Produce(w)
+	}
+
+	for _, tc := range []struct {
+		kind EventKind
+		typ  uint64
+	}{
+		{EvtUnitTrained, 1},
+		{EvtBuildingCompleted, 7},
+		{EvtKnowledgeLearned, 9},
+	} {
+		evts := r.filter(tc.kind, c.Id)
+		if len(evts) != 1 {
+			t.Fatal(tc.kind, "expected once, got", len(evts))
+		}
+		if evts[0].Type != tc.typ || evts[0].Owner != 3 || evts[0].Deputy != 4 {
+			t.Fatal(tc.kind, "unexpected", evts[0])
+		}
+	}
+}
+
+func TestNotifyConquest(t *testing.T) {
+	w, att, def := newTestWorld()
+	trainUnits(w, att, 2, 3)
+	trainUnits(w, def, 1, 2)
+
+	a, _ := w.ArmyCreate(att, "A")
+	for _, u := range append(SetOfUnits{}, att.Units...) {
+		att.TransferOwnUnit(a, u.Id)
+	}
+	a.Targets = append(a.Targets, Command{Cell: def.Cell, Action: CmdCityOverlord})
+
+	r := &recorder{}
+	w.SetNotifier(r)
+	for i := 0; i < 10 && def.Overlord == 0; i++ {
+		w.Move()
+	}
+
+	for _, tc := range []struct {
+		kind EventKind
+		city *City
+		nb   int
+	}{
+		{EvtArmyMoved, att, 2},
+		{EvtArmyArrived, att, 1},
+		{EvtConquestDone, att, 1},
+		{EvtCityConquered, def, 1},
+		{EvtFightWon, att, 1},
+		{EvtFightLost, def, 1},
+		{EvtUnitKilled, def, 2},
+		{EvtUnitKilled, att, 0},
+	} {
+		if nb := len(r.filter(tc.kind, tc.city.Id)); nb != tc.nb {
+			t.Fatal(tc.kind, tc.city.Id, "expected", tc.nb, "got", nb)
+		}
+	}
+}
+
+func TestNotifyNoRoute(t *testing.T) {
+	w, att, _ := newTestWorld()
+	island := w.Places.CellCreate()
+	w.Places.Rehash()
+
+	a, _ := w.ArmyCreate(att, "A")
+	a.Targets = append(a.Targets, Command{Cell: island.Id, Action: CmdPause})
+
+	r := &recorder{}
+	w.SetNotifier(r)
+	w.Move()
+
+	evts := r.filter(EvtArmyNoRoute, att.Id)
+	if len(evts) != 1 || evts[0].Army != a.Id || evts[0].Cell != island.Id {
+		t.Fatal(r.events)
+	}
+	if a.Cell != att.Cell {
+		t.Fatal("the army moved")
+	}
+}
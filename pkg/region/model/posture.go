@@ -0,0 +1,138 @@
+// Copyright (C) 2018-2020 Hegemonie's AUTHORS
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package region
+
+import (
+	"errors"
+)
+
+// Set the posture of the Army against the given City, or its default posture
+// if city is 0: 1 to defend, -1 to assault, 0 to ignore. A Treaty of the
+// home City overrides the posture against the other party when it changes.
+func (a *Army) SetPosture(w *World, city uint64, p int64) error {
+	if p < -1 || p > 1 {
+		return errors.New("Invalid posture")
+	}
+	if city == 0 {
+		a.DefaultPosture = p
+		return nil
+	}
+	if city == a.City {
+		return errors.New("Own city")
+	}
+	if w.CityGet(city) == nil {
+		return errors.New("City not found")
+	}
+	a.putPosture(city, p)
+	return nil
+}
+
+// Return the posture of the Army against the given City: the explicit one if
+// any, otherwise defend the friendly cities, ignore the cities at peace with
+// the home City, and apply the default posture to the others. Abroad, only
+// the default posture applies because the others refer to the cities of the
+// home Region.
+func (a *Army) posture(w *World, city uint64) int64 {
+	if a.Region != "" {
+		return a.DefaultPosture
+	}
+	for _, p := range a.Postures {
+		if p == int64(city) {
+			return 1
+		}
+		if p == -int64(city) {
+			return -1
+		}
+	}
+	home := a.home(w)
+	if home == nil {
+		return a.DefaultPosture
+	}
+	if other := w.CityGet(city); other != nil && home.Friendly(other) {
+		return 1
+	}
+	if w.Relation(home.Id, city) == TreatyPeace {
+		return 0
+	}
+	return a.DefaultPosture
+}
+
+// Return the posture of the Army against the other Army. The armies abroad
+// belong to the cities of another Region, the default posture applies to them.
+func (a *Army) postureAgainst(w *World, other *Army) int64 {
+	if other.Region != "" {
+		return a.DefaultPosture
+	}
+	return a.posture(w, other.City)
+}
+
+// Tell on which side of the Fight the Army stands, if any. The cities it
+// defends matter first, then the cities it assaults.
+func (a *Army) sideIn(w *World, f *Fight) (attack bool, ok bool) {
+	pCity := w.CityAt(f.Cell)
+	if pCity != nil && pCity.Assault != f {
+		pCity = nil
+	}
+	att, def := f.involved(w, pCity, true)
+	for _, c := range def {
+		if a.posture(w, c.Id) > 0 {
+			return false, true
+		}
+	}
+	for _, c := range att {
+		if a.posture(w, c.Id) > 0 {
+			return true, true
+		}
+	}
+	for _, c := range def {
+		if a.posture(w, c.Id) < 0 {
+			return true, true
+		}
+	}
+	for _, c := range att {
+		if a.posture(w, c.Id) < 0 {
+			return false, true
+		}
+	}
+	return false, false
+}
+
+// Move the Army from the map to a side of the Fight
+func (a *Army) joinFight(w *World, f *Fight, attack bool) {
+	w.Live.Armies.Remove(a)
+	w.reindexArmy(a, a.Cell, 0)
+	if attack {
+		f.Attack.Add(a)
+	} else {
+		f.Defense.Add(a)
+	}
+	a.Fight = f.Id
+}
+
+// Start a Fight in the open against the other Army
+func (a *Army) startFight(w *World, other *Army) {
+	f := &Fight{
+		Id: w.getNextId(), Cell: a.Cell,
+		Attack:  make(SetOfArmies, 0),
+		Defense: make(SetOfArmies, 0),
+	}
+	w.Live.Fights.Add(f)
+	a.joinFight(w, f, true)
+	other.joinFight(w, f, false)
+}
+
+// Take the Resources of a freight without escort, i.e. a transport or a
+// caravan without Units. The transport vanishes once emptied.
+func (a *Army) seize(w *World, freight *Army) {
+	loot := freight.Stock
+	a.Stock.Add(loot)
+	freight.Stock.Zero()
+	w.notify(freight.home(w), Event{Kind: EvtTransportLost, Army: freight.Id, Other: a.City, Cell: a.Cell, Resources: &loot})
+	w.notify(a.home(w), Event{Kind: EvtTransportSeized, Army: a.Id, Other: freight.City, Cell: a.Cell, Resources: &loot})
+	if freight.Transport {
+		freight.vanish(w)
+	}
+}
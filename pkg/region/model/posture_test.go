@@ -0,0 +1,182 @@
+// Copyright (C) 2018-2020 Hegemonie's AUTHORS
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package region
+
+import (
+	"testing"
+)
+
+func TestPostureSet(t *testing.T) {
+	w, c0, c1 := newTestWorld()
+	a, _ := w.ArmyCreate(c0, "A")
+
+	for _, tc := range []struct {
+		city    uint64
+		posture int64
+	}{
+		{c1.Id, 2},
+		{c1.Id, -2},
+		{c0.Id, -1},
+		{c1.Id + 1000, -1},
+	} {
+		if err := a.SetPosture(w, tc.city, tc.posture); err == nil {
+			t.Fatal("posture accepted", tc.city, tc.posture)
+		}
+	}
+
+	if a.posture(w, c0.Id) != 1 || a.posture(w, c1.Id) != 0 {
+		t.Fatal("unexpected postures")
+	}
+	if err := a.SetPosture(w, 0, -1); err != nil || a.posture(w, c1.Id) != -1 {
+		t.Fatal("default posture not applied", err)
+	}
+	if err := a.SetPosture(w, c1.Id, 1); err != nil || a.posture(w, c1.Id) != 1 {
+		t.Fatal("posture not applied", err)
+	}
+
+	// The peace overrides the default posture, not the explicit one
+	a.SetPosture(w, c1.Id, 0)
+	peace, _ := c0.TreatyPropose(w, c1, TreatyPeace)
+	c1.TreatyAccept(w, peace.Id)
+	if a.posture(w, c1.Id) != 0 {
+		t.Fatal("peace ignored")
+	}
+	a.SetPosture(w, c1.Id, -1)
+	if a.posture(w, c1.Id) != -1 {
+		t.Fatal("explicit posture ignored")
+	}
+
+	// The friendly cities are always defended
+	c0.Owner, c1.Owner = 1, 1
+	a.SetPosture(w, c1.Id, 0)
+	if a.posture(w, c1.Id) != 1 {
+		t.Fatal("friendly city not defended")
+	}
+}
+
+func TestPostureFight(t *testing.T) {
+	w, c0, c1 := newTestWorld()
+	rec := &recorder{}
+	w.SetNotifier(rec)
+	cell := w.Places.Cells[1].Id
+	army := func(c *City, nb int) *Army {
+		a, _ := w.ArmyCreate(c, "A")
+		trainUnits(w, c, 1, nb)
+		for len(c.Units) > 0 {
+			c.TransferOwnUnit(a, c.Units[0].Id)
+		}
+		a.Cell = cell
+		return a
+	}
+
+	raiders := army(c0, 2)
+	raiders.SetPosture(w, 0, -1)
+	caravan := army(c1, 0)
+	caravan.Stock = Resources{10}
+	escort := army(c1, 2)
+	helper := army(c1, 2)
+	far := w.Places.CellCreate()
+	idFar, _ := w.CityCreate(far.Id)
+	far.City = idFar
+	passerby := army(w.CityGet(idFar), 1)
+
+	w.Move()
+	if !caravan.Stock.IsZero() || raiders.Stock[0] != 10 || caravan.Deleted {
+		t.Fatal("caravan not seized", caravan.Stock, raiders.Stock)
+	}
+	if len(rec.filter(EvtTransportLost, c1.Id)) != 1 || len(rec.filter(EvtTransportSeized, c0.Id)) != 1 {
+		t.Fatal("missing events")
+	}
+
+	fights := w.Live.Fights.SliceByCell(cell)
+	if len(fights) != 1 {
+		t.Fatal("no fight", fights)
+	}
+	f := fights[0]
+	if f.Attack.Get(raiders.Id) == nil || f.Defense.Get(escort.Id) == nil || f.Defense.Get(helper.Id) == nil {
+		t.Fatal("wrong sides", f.Attack, f.Defense)
+	}
+	if passerby.Fight != 0 {
+		t.Fatal("the passer-by joined the fight")
+	}
+}
+
+func TestPostureForeign(t *testing.T) {
+	w, c0, c1 := newTestWorld()
+	cell := w.Places.Cells[1].Id
+	army := func(c *City, nb int) *Army {
+		a, _ := w.ArmyCreate(c, "A")
+		trainUnits(w, c, 1, nb)
+		for len(c.Units) > 0 {
+			c.TransferOwnUnit(a, c.Units[0].Id)
+		}
+		a.Cell = cell
+		return a
+	}
+	// An Army of another Region, whose City shares its ID with a local one
+	abroad := func(nb int) *Army {
+		a := army(c1, nb)
+		c1.armies.Remove(a)
+		a.Region = "b"
+		return a
+	}
+
+	// The explicit postures don't apply to the armies abroad
+	guard := army(c0, 2)
+	guard.SetPosture(w, c1.Id, -1)
+	caravan := abroad(0)
+	caravan.Stock = Resources{10}
+	foreign := abroad(2)
+	w.Move()
+	if guard.Fight != 0 || foreign.Fight != 0 || caravan.Stock.IsZero() {
+		t.Fatal("foreign armies assaulted", guard.Fight, caravan.Stock)
+	}
+
+	// The default posture does
+	guard.SetPosture(w, 0, -1)
+	w.Move()
+	if !caravan.Stock.IsZero() || guard.Stock[0] != 10 {
+		t.Fatal("foreign caravan not seized", caravan.Stock)
+	}
+	if guard.Fight == 0 || guard.Fight != foreign.Fight {
+		t.Fatal("foreign army not assaulted", guard.Fight, foreign.Fight)
+	}
+}
+
+func TestPostureEncounter(t *testing.T) {
+	w, c0, c1 := newTestWorld()
+	c0.Owner, c1.Owner = 1, 1
+	far := w.Places.CellCreate()
+	idFar, _ := w.CityCreate(far.Id)
+	far.City = idFar
+	c2 := w.CityGet(idFar)
+
+	// An Army of c0 heads to c1
+	trainUnits(w, c0, 1, 1)
+	mover, _ := w.ArmyCreate(c0, "M")
+	c0.TransferOwnUnit(mover, c0.Units[0].Id)
+	if err := mover.DeferDeposit(w, c1); err != nil {
+		t.Fatal(err)
+	}
+
+	// An aggressive Army of c2 waits in c1, it notices the arrival during
+	// the same tick
+	trainUnits(w, c2, 1, 1)
+	waiting, _ := w.ArmyCreate(c2, "W")
+	c2.TransferOwnUnit(waiting, c2.Units[0].Id)
+	waiting.Cell = c1.Cell
+	waiting.SetPosture(w, 0, -1)
+
+	for i := 0; i < 10 && mover.Cell != c1.Cell; i++ {
+		w.Move()
+	}
+	if mover.Fight == 0 || mover.Fight != waiting.Fight {
+		t.Fatal("no encounter at the arrival", mover.Fight, waiting.Fight)
+	}
+	if w.armiesByCell != nil {
+		t.Fatal("index kept after the tick")
+	}
+}
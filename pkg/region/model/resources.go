@@ -69,9 +69,7 @@ func (r *Resources) Remove(o Resources) {
 
 func (r *Resources) TrimTo(limit Resources) {
 	for i := 0; i < ResourceMax; i++ {
-		if r[i] < limit[i] {
-			r[i] = r[i]
-		} else {
+		if r[i] > limit[i] {
 			r[i] = limit[i]
 		}
 	}
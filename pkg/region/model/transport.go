@@ -0,0 +1,83 @@
+// Copyright (C) 2018-2020 Hegemonie's AUTHORS
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package region
+
+import (
+	"errors"
+)
+
+// Send the Resources to the given City with a transport Army. A transport
+// of the City still waiting to leave for the same City carries them too,
+// instead of a new one.
+func (c *City) SendResourcesTo(w *World, name string, dst *City, amount Resources) (*Army, error) {
+	if dst == nil || dst == c || dst.Deleted {
+		return nil, errors.New("Invalid destination")
+	}
+	if !c.Stock.GreaterOrEqualTo(amount) {
+		return nil, errors.New("Insufficient resources")
+	}
+	cmd := Command{Cell: dst.Cell, Action: CmdCityDeposit}
+	if err := w.checkRoute(c.Cell, []Command{cmd}); err != nil {
+		return nil, err
+	}
+
+	a := c.pendingTransport(cmd)
+	if a == nil {
+		a, _ = w.ArmyCreate(c, name)
+		a.Transport = true
+		a.Targets = append(a.Targets, cmd)
+	}
+	c.Stock.Remove(amount)
+	a.Stock.Add(amount)
+	w.notify(c, Event{Kind: EvtTransportSent, Army: a.Id, Other: dst.Id, Resources: &amount})
+	return a, nil
+}
+
+func (c *City) pendingTransport(cmd Command) *Army {
+	for _, a := range c.armies {
+		if a.Transport && !a.Deleted && a.Fight == 0 && a.Cell == c.Cell &&
+			len(a.Targets) == 1 && a.Targets[0] == cmd {
+			return a
+		}
+	}
+	return nil
+}
+
+// Return the transport Armies of the City, on their way or about to leave
+func (c *City) Transports() []*Army {
+	out := make([]*Army, 0)
+	for _, a := range c.armies {
+		if a.Transport && !a.Deleted {
+			out = append(out, a)
+		}
+	}
+	return out
+}
+
+// Let the first hostile Army on the Cell of the transport seize its
+// Resources. The transport vanishes if intercepted.
+func (a *Army) intercepted(w *World) bool {
+	for _, b := range w.armiesAt(a.Cell) {
+		if b == a || b.Deleted || b.Transport || b.Cell != a.Cell ||
+			len(b.Units) == 0 || b.postureAgainst(w, a) >= 0 {
+			continue
+		}
+		b.seize(w, a)
+		return true
+	}
+	return false
+}
+
+// Drop the Army without any trace, e.g. an empty transport. It leaves the
+// map and its City for good.
+func (a *Army) vanish(w *World) {
+	a.Deleted = true
+	w.Live.Armies.Remove(a)
+	w.reindexArmy(a, a.Cell, 0)
+	if home := a.home(w); home != nil {
+		home.armies.Remove(a)
+	}
+}
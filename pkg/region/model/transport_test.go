@@ -0,0 +1,153 @@
+// Copyright (C) 2018-2020 Hegemonie's AUTHORS
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package region
+
+import (
+	"testing"
+)
+
+func TestTransportDelivery(t *testing.T) {
+	w, dst, src := newTestWorld()
+	rec := &recorder{}
+	w.SetNotifier(rec)
+	src.Stock = Resources{100, 100, 100, 100, 100, 100}
+	before := dst.Stock
+
+	for _, tc := range []struct {
+		dst    *City
+		amount Resources
+	}{
+		{nil, Resources{1}},
+		{src, Resources{1}},
+		{dst, Resources{1000}},
+	} {
+		if _, err := src.SendResourcesTo(w, "T", tc.dst, tc.amount); err == nil {
+			t.Fatal("transport accepted", tc.dst, tc.amount)
+		}
+	}
+
+	// Both shipments leave with the same transport
+	a, err := src.SendResourcesTo(w, "T", dst, Resources{10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := src.SendResourcesTo(w, "T", dst, Resources{0, 5})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a != b || a.Stock[0] != 10 || a.Stock[1] != 5 || src.Stock[0] != 90 {
+		t.Fatal("transports not merged", a, b)
+	}
+	if l := src.Transports(); len(l) != 1 || l[0] != a {
+		t.Fatal("transport not tracked", l)
+	}
+
+	// The transports don't count as living armies
+	w.Definitions.PopBonusArmyAlive = 1
+	if pop := src.Popularity(w); pop != src.Pop {
+		t.Fatal("transport counted in the popularity", pop, src.Pop)
+	}
+	w.Definitions.PopBonusArmyAlive = 0
+
+	// Once on its way, the transport doesn't take more Resources
+	w.Move()
+	if c, _ := src.SendResourcesTo(w, "T", dst, Resources{1}); c == a {
+		t.Fatal("transport merged on its way")
+	}
+	w.Move()
+	if !a.Deleted || dst.Stock[0] != before[0]+10 || dst.Stock[1] != before[1]+5 {
+		t.Fatal("transport not delivered", a, dst.Stock)
+	}
+	if l := src.Transports(); len(l) != 1 {
+		t.Fatal("delivered transport still tracked", l)
+	}
+	if len(rec.filter(EvtTransportSent, src.Id)) != 3 || len(rec.filter(EvtResourcesReceived, dst.Id)) != 1 {
+		t.Fatal("missing events")
+	}
+}
+
+func TestTransportIntercepted(t *testing.T) {
+	w, hostile, src := newTestWorld()
+	rec := &recorder{}
+	w.SetNotifier(rec)
+	src.Stock = Resources{100}
+
+	// An Army of the destination waits halfway, assaulting the sender
+	trainUnits(w, hostile, 1, 1)
+	raiders, _ := w.ArmyCreate(hostile, "R")
+	hostile.TransferOwnUnit(raiders, hostile.Units[0].Id)
+	raiders.Cell = w.Places.Cells[1].Id
+	raiders.Postures = []int64{-int64(src.Id)}
+
+	a, err := src.SendResourcesTo(w, "T", hostile, Resources{10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	w.Move()
+	if !a.Deleted || raiders.Stock[0] != 10 || len(src.Transports()) != 0 {
+		t.Fatal("transport not intercepted", a, raiders.Stock)
+	}
+	if len(rec.filter(EvtTransportLost, src.Id)) != 1 || len(rec.filter(EvtTransportSeized, hostile.Id)) != 1 {
+		t.Fatal("missing events")
+	}
+
+	// A peaceful Army lets the transport pass
+	raiders.Postures = nil
+	a, _ = src.SendResourcesTo(w, "T", hostile, Resources{10})
+	w.Move()
+	w.Move()
+	if !a.Deleted || raiders.Stock[0] != 10 || hostile.Stock[0] != 10 {
+		t.Fatal("transport not delivered", a, hostile.Stock)
+	}
+}
+
+func TestTransportTax(t *testing.T) {
+	w, overlord, liege := newTestWorld()
+	overlord.ConquerCity(w, liege)
+	liege.Production = Resources{100, 100, 100, 100, 100, 100}
+	liege.StockCapacity = Resources{1000, 1000, 1000, 1000, 1000, 1000}
+
+	w.Definitions.InstantTransfers = false
+	liege.Produce(w)
+	l := liege.Transports()
+	if len(l) != 1 || l[0].Stock.IsZero() || !overlord.Stock.IsZero() {
+		t.Fatal("tax not sent", l, overlord.Stock)
+	}
+	tax := l[0].Stock
+	w.Move()
+	w.Move()
+	if !overlord.Stock.Equals(tax) {
+		t.Fatal("tax not delivered", overlord.Stock, tax)
+	}
+}
+
+func TestTransportPurged(t *testing.T) {
+	w, overlord, liege := newTestWorld()
+	overlord.ConquerCity(w, liege)
+	liege.Production = Resources{100, 100, 100, 100, 100, 100}
+	liege.StockCapacity = Resources{1000, 1000, 1000, 1000, 1000, 1000}
+	w.Definitions.InstantTransfers = false
+
+	// A tax transport leaves at each production tick and vanishes once
+	// delivered: the map doesn't keep track of it
+	before := len(w.Live.Armies)
+	for i := 0; i < 10; i++ {
+		w.Produce()
+		w.Move()
+		w.Move()
+		if len(w.Live.Armies) > before+1 {
+			t.Fatal("dead armies kept", len(w.Live.Armies))
+		}
+	}
+	for _, a := range w.Live.Armies {
+		if a.Deleted {
+			t.Fatal("deleted army on the map", a.Id)
+		}
+	}
+	if len(w.Live.Armies) != before || len(liege.Transports()) != 0 {
+		t.Fatal("transports not purged", len(w.Live.Armies))
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"sort"
 	"sync/atomic"
 )
@@ -18,6 +19,7 @@ func (w *World) Init() {
 	w.rw.Lock()
 	defer w.rw.Unlock()
 
+	w.Version = WorldVersion
 	w.Places.Init()
 
 	if w.NextId <= 0 {
@@ -25,9 +27,19 @@ func (w *World) Init() {
 	}
 	w.Live.Armies = make(SetOfArmies, 0)
 	w.Live.Cities = make(SetOfCities, 0)
+	w.Live.Fights = make(SetOfFights, 0)
 	w.Definitions.Units = make(SetOfUnitTypes, 0)
 	w.Definitions.Buildings = make(SetOfBuildingTypes, 0)
 	w.Definitions.Knowledges = make(SetOfKnowledgeTypes, 0)
+
+	// Default weights of the score, possibly overridden by the definitions
+	w.Definitions.ScorePopularity = 1.0
+	w.Definitions.ScoreAssets = 1.0
+	w.Definitions.ScoreStock = 0.01
+	w.Definitions.ScoreLieges = 10.0
+
+	// Breaking a Treaty in force costs Popularity, unless overridden
+	w.Definitions.PopBonusTreatyBreak = -10
 }
 
 func (w *World) Check() error {
@@ -57,6 +69,30 @@ func (w *World) Check() error {
 		return errors.New("army sequence: unsorted")
 	}
 
+	if w.Definitions.MarketCurrency >= ResourceMax {
+		return errors.New("market currency: invalid resource")
+	}
+	for i, o := range w.Live.Orders {
+		if i > 0 && o.Id <= w.Live.Orders[i-1].Id {
+			return errors.New("order sequence: unsorted")
+		}
+		if o.Resource >= ResourceMax || o.Resource == w.Definitions.MarketCurrency {
+			return errors.New("order: invalid resource")
+		}
+	}
+
+	for i, t := range w.Live.Treaties {
+		if i > 0 && t.Id <= w.Live.Treaties[i-1].Id {
+			return errors.New("treaty sequence: unsorted")
+		}
+		if t.Kind < TreatyAlliance || t.Kind > TreatyWar {
+			return errors.New("treaty: invalid kind")
+		}
+		if w.CityGet(t.Proposer) == nil || w.CityGet(t.Target) == nil {
+			return errors.New("treaty: city not found")
+		}
+	}
+
 	for _, a := range w.Live.Armies {
 		if !sort.IsSorted(&a.Units) {
 			return errors.New("unit sequence: unsorted")
@@ -83,23 +119,76 @@ func (w *World) WUnlock() { w.rw.Unlock() }
 
 func (w *World) RLock() { w.rw.RLock() }
 
-func (w *World) RUnlock() { w.rw.RLock() }
+func (w *World) RUnlock() { w.rw.RUnlock() }
 
 func (w *World) getNextId() uint64 {
 	return atomic.AddUint64(&w.NextId, 1)
 }
 
+// Dump the World, with the version set by Init or LoadJSON. The World is
+// only read, so that concurrent dumps only require the read lock.
 func (w *World) DumpJSON(dst io.Writer) error {
 	return json.NewEncoder(dst).Encode(w)
 }
 
+// Load a full dump of the World, migrate it to the current schema if
+// necessary, then prepare it for the play.
+func (w *World) LoadJSON(src io.Reader) error {
+	encoded, err := ioutil.ReadAll(src)
+	if err != nil {
+		return err
+	}
+
+	var header struct{ Version uint32 }
+	if err = json.Unmarshal(encoded, &header); err != nil {
+		return err
+	}
+	if header.Version > WorldVersion {
+		return errors.New(fmt.Sprintf("Unsupported version %d (max %d)", header.Version, WorldVersion))
+	}
+
+	if err = json.Unmarshal(encoded, w); err != nil {
+		return err
+	}
+	if header.Version < 1 {
+		if err = w.migrateV0(encoded); err != nil {
+			return err
+		}
+	}
+	w.Version = WorldVersion
+	return w.PostLoad()
+}
+
+// Before the version 1, the Fights were only stored in the City they target
+func (w *World) migrateV0(encoded []byte) error {
+	var old struct {
+		Live struct {
+			Cities []struct{ Assault *Fight }
+		}
+	}
+	if err := json.Unmarshal(encoded, &old); err != nil {
+		return err
+	}
+	for _, c := range old.Live.Cities {
+		if c.Assault != nil && w.Live.Fights.Get(c.Assault.Id) == nil {
+			w.Live.Fights.Add(c.Assault)
+		}
+	}
+	return nil
+}
+
 func (w *World) PostLoad() error {
+	// Rebuild the routing table of the Map
+	w.Places.SetBiomes(w.Definitions.Biomes)
+	w.Places.Rehash()
+
 	// Sort all the lookup arrays
 	sort.Sort(&w.Definitions.Knowledges)
 	sort.Sort(&w.Definitions.Buildings)
 	sort.Sort(&w.Definitions.Units)
 	sort.Sort(&w.Live.Armies)
 	sort.Sort(&w.Live.Cities)
+	sort.Sort(w.Live.Fights)
 	for _, a := range w.Live.Armies {
 		sort.Sort(&a.Units)
 	}
@@ -108,6 +197,25 @@ func (w *World) PostLoad() error {
 		sort.Sort(&c.Buildings)
 		sort.Sort(&c.Units)
 	}
+	for _, f := range w.Live.Fights {
+		sort.Sort(&f.Attack)
+		sort.Sort(&f.Defense)
+		for _, a := range f.Attack {
+			sort.Sort(&a.Units)
+		}
+		for _, a := range f.Defense {
+			sort.Sort(&a.Units)
+		}
+	}
+
+	// Purge the armies gone for good, kept by the saves of former versions
+	armies := w.Live.Armies[:0]
+	for _, a := range w.Live.Armies {
+		if !a.Deleted {
+			armies = append(armies, a)
+		}
+	}
+	w.Live.Armies = armies
 
 	if err := w.Live.Armies.Check(); err != nil {
 		return err
@@ -117,13 +225,52 @@ func (w *World) PostLoad() error {
 	}
 
 	// Link Armies and Cities
-	for _, a := range w.Live.Armies {
+	linkArmy := func(a *Army) error {
+		if a.Region != "" {
+			// Abroad, its City is in another Region
+			return nil
+		}
 		if a.City == 0 {
 			return errors.New(fmt.Sprintf("Army %v points to no City", a))
 		} else if c := w.CityGet(a.City); c == nil {
 			return errors.New(fmt.Sprintf("Army %v points to ghost City", a))
 		} else {
 			c.armies.Add(a)
+			return nil
+		}
+	}
+	for _, a := range w.Live.Armies {
+		if err := linkArmy(a); err != nil {
+			return err
+		}
+	}
+
+	// Link the Cities with their Overlord
+	for _, c := range w.Live.Cities {
+		if c.Overlord == 0 {
+			continue
+		}
+		if o := w.CityGet(c.Overlord); o == nil {
+			return errors.New(fmt.Sprintf("City %v points to ghost Overlord", c.Id))
+		} else {
+			c.setOverlord(o)
+		}
+	}
+
+	// Link the Fights with the Cities and their Armies
+	for _, f := range w.Live.Fights {
+		for _, a := range f.Attack {
+			if err := linkArmy(a); err != nil {
+				return err
+			}
+		}
+		for _, a := range f.Defense {
+			if err := linkArmy(a); err != nil {
+				return err
+			}
+		}
+		if c := w.CityAt(f.Cell); c != nil {
+			c.Assault = f
 		}
 	}
 
@@ -187,6 +334,51 @@ func (w *World) PostLoad() error {
 			}
 		}
 	}
+	fighting := func(a *Army) {
+		if a.Id > maxId {
+			maxId = a.Id + 1
+		}
+		if len(a.Units) > 0 {
+			last := a.Units[len(a.Units)-1]
+			if last.Id > maxId {
+				maxId = last.Id + 1
+			}
+		}
+	}
+	for _, h := range w.Live.Outbox {
+		if h.Id > maxId {
+			maxId = h.Id + 1
+		}
+		if h.Army != nil {
+			fighting(h.Army)
+		}
+	}
+	for _, h := range w.Live.Inbox {
+		fighting(h.Army)
+	}
+	if len(w.Live.Orders) > 0 {
+		last := w.Live.Orders[len(w.Live.Orders)-1]
+		if last.Id > maxId {
+			maxId = last.Id + 1
+		}
+	}
+	if len(w.Live.Treaties) > 0 {
+		last := w.Live.Treaties[len(w.Live.Treaties)-1]
+		if last.Id > maxId {
+			maxId = last.Id + 1
+		}
+	}
+	for _, f := range w.Live.Fights {
+		if f.Id > maxId {
+			maxId = f.Id + 1
+		}
+		for _, a := range f.Attack {
+			fighting(a)
+		}
+		for _, a := range f.Defense {
+			fighting(a)
+		}
+	}
 
 	w.NextId = maxId
 	return nil
@@ -199,15 +391,75 @@ func (w *World) Produce() {
 	for _, c := range w.Live.Cities {
 		c.Produce(w)
 	}
+	w.matchOrders()
+	w.Clock.Production++
 }
 
+// Make all the armies on the map move one step, then play one round of each
+// running Fight.
 func (w *World) Move() {
 	w.rw.Lock()
 	defer w.rw.Unlock()
 
-	for _, a := range w.Live.Armies {
+	// Work on copies because the moves and the fights alter the original sets
+	w.indexArmies()
+	for _, a := range append(SetOfArmies{}, w.Live.Armies...) {
 		a.Move(w)
 	}
+	w.armiesByCell = nil
+	for _, f := range append(SetOfFights{}, w.Live.Fights...) {
+		f.Round(w)
+	}
+	w.Clock.Tick++
+}
+
+// Index the armies on the map by Cell, for the encounters of the movement tick
+func (w *World) indexArmies() {
+	w.armiesByCell = make(map[uint64][]*Army)
+	for _, a := range w.Live.Armies {
+		w.armiesByCell[a.Cell] = append(w.armiesByCell[a.Cell], a)
+	}
+}
+
+// Return the armies on the Cell during a movement tick. Out of the ticks,
+// all the armies on the map are returned.
+func (w *World) armiesAt(cell uint64) []*Army {
+	if w.armiesByCell == nil {
+		return w.Live.Armies
+	}
+	return w.armiesByCell[cell]
+}
+
+// Keep the index of the armies up to date when the Army leaves the Cell
+// 'from' for the Cell 'to', or for no Cell at all if 'to' is 0.
+func (w *World) reindexArmy(a *Army, from, to uint64) {
+	if w.armiesByCell == nil {
+		return
+	}
+	l := w.armiesByCell[from]
+	for i, b := range l {
+		if b == a {
+			w.armiesByCell[from] = append(l[:i], l[i+1:]...)
+			break
+		}
+	}
+	if to != 0 {
+		w.armiesByCell[to] = append(w.armiesByCell[to], a)
+	}
+}
+
+// Return the score of all the cities of the Region, from the best to the worst
+func (w *World) ScoreBoard() []CityScore {
+	scores := make([]CityScore, 0, len(w.Live.Cities))
+	for _, c := range w.Live.Cities {
+		if !c.Deleted {
+			scores = append(scores, CityScore{City: c, Score: c.Score(w)})
+		}
+	}
+	sort.SliceStable(scores, func(i, j int) bool {
+		return scores[i].Score > scores[j].Score
+	})
+	return scores
 }
 
 func (w *World) UnitTypeGet(id uint64) *UnitType {
@@ -222,21 +474,58 @@ func (w *World) ArmyCreate(c *City, name string) (*Army, error) {
 	a := &Army{
 		Id: w.getNextId(), City: c.Id, Cell: c.Cell,
 		Name: name, Units: make(SetOfUnits, 0),
-		Targets: make([]Command, 0),
+		Targets:  make([]Command, 0),
+		Postures: c.treatyPostures(w),
 	}
 	w.Live.Armies.Add(a)
 	c.armies.Add(a)
 	return a, nil
 }
 
+// Return the Army with the given ID, either free on the map or involved
+// in a Fight.
 func (w *World) ArmyGet(id uint64) *Army {
-	return w.Live.Armies.Get(id)
+	if a := w.Live.Armies.Get(id); a != nil {
+		return a
+	}
+	for _, f := range w.Live.Fights {
+		if a := f.Attack.Get(id); a != nil {
+			return a
+		}
+		if a := f.Defense.Get(id); a != nil {
+			return a
+		}
+	}
+	return nil
+}
+
+// Return the Cell where an Army escapes when it flees a Fight on the given
+// Cell: the next step toward its City, or any neighbor, or the Cell itself
+// when there is no way out.
+func (w *World) escapeCell(a *Army, cell uint64) uint64 {
+	if pCity := a.home(w); pCity != nil && pCity.Cell != cell {
+		if next, err := w.Places.PathNextStep(cell, pCity.Cell); err == nil && next != 0 {
+			return next
+		}
+	}
+	if adj := w.Places.CellAdjacency(cell); len(adj) > 0 {
+		return adj[0]
+	}
+	return cell
 }
 
 func (w *World) CityGet(id uint64) *City {
 	return w.Live.Cities.Get(id)
 }
 
+// Return the City built on the given Cell, if any
+func (w *World) CityAt(cell uint64) *City {
+	if pCell := w.Places.CellGet(cell); pCell != nil && pCell.City != 0 {
+		return w.CityGet(pCell.City)
+	}
+	return nil
+}
+
 func (w *World) CityCheck(id uint64) bool {
 	return w.CityGet(id) != nil
 }
@@ -247,14 +536,19 @@ func (w *World) CityCreate(loc uint64) (uint64, error) {
 	return id, nil
 }
 
+var (
+	ErrCityNotFound  = errors.New("Not Found")
+	ErrCityForbidden = errors.New("Forbidden")
+)
+
 func (w *World) CityGetAndCheck(characterId, cityId uint64) (*City, error) {
 	// Fetch + sanity checks about the city
 	pCity := w.CityGet(cityId)
 	if pCity == nil {
-		return nil, errors.New("Not Found")
+		return nil, ErrCityNotFound
 	}
 	if pCity.Deputy != characterId && pCity.Owner != characterId {
-		return nil, errors.New("Forbidden")
+		return nil, ErrCityForbidden
 	}
 
 	return pCity, nil
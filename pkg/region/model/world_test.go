@@ -0,0 +1,114 @@
+// Copyright (C) 2018-2020 Hegemonie's AUTHORS
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package region
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"sync"
+	"testing"
+)
+
+// Dump a World with a running Fight, then rewrite the dump with the given hook
+func dumpTestWorld(t *testing.T, hook func(dump map[string]interface{})) ([]byte, *Fight) {
+	w, _, f := newTestFight(t, 2, 2)
+	var buf bytes.Buffer
+	if err := w.DumpJSON(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if hook == nil {
+		return buf.Bytes(), f
+	}
+
+	var dump map[string]interface{}
+	if err := json.Unmarshal(buf.Bytes(), &dump); err != nil {
+		t.Fatal(err)
+	}
+	hook(dump)
+	encoded, err := json.Marshal(dump)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return encoded, f
+}
+
+func TestWorldLoadJSON(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		hook func(dump map[string]interface{})
+		ok   bool
+	}{
+		{"current", nil, true},
+		{"future", func(dump map[string]interface{}) {
+			dump["Version"] = WorldVersion + 1
+		}, false},
+		{"v0", func(dump map[string]interface{}) {
+			// The Fights used to be stored in the City they target
+			delete(dump, "Version")
+			live := dump["Live"].(map[string]interface{})
+			fight := live["Fights"].([]interface{})[0]
+			for _, c := range live["Cities"].([]interface{}) {
+				city := c.(map[string]interface{})
+				if city["Cell"] == fight.(map[string]interface{})["Cell"] {
+					city["Assault"] = fight
+				}
+			}
+			live["Fights"] = []interface{}{}
+		}, true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			encoded, f := dumpTestWorld(t, tc.hook)
+
+			w := &World{}
+			w.Init()
+			err := w.LoadJSON(bytes.NewReader(encoded))
+			if (err == nil) != tc.ok {
+				t.Fatal("unexpected", err)
+			}
+			if !tc.ok {
+				return
+			}
+
+			if w.Version != WorldVersion {
+				t.Fatal("version", w.Version)
+			}
+			if len(w.Live.Fights) != 1 || w.Live.Fights[0].Id != f.Id {
+				t.Fatal("fight lost", w.Live.Fights)
+			}
+			if pCity := w.CityAt(f.Cell); pCity == nil || pCity.Assault != w.Live.Fights[0] {
+				t.Fatal("fight not linked")
+			}
+			if err = w.Check(); err != nil {
+				t.Fatal(err)
+			}
+			if _, err = w.Places.PathNextStep(w.Places.Cells[0].Id, f.Cell); err != nil {
+				t.Fatal("map not rehashed", err)
+			}
+		})
+	}
+}
+
+// The dumps only require the read lock, e.g. a snapshot during an admin save
+func TestDumpConcurrent(t *testing.T) {
+	w, _, _ := newTestWorld()
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			w.RLock()
+			defer w.RUnlock()
+			if err := w.DumpJSON(ioutil.Discard); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+	if w.Version != WorldVersion {
+		t.Fatal("version", w.Version)
+	}
+}
@@ -5,7 +5,10 @@
 
 package region
 
-import "sync"
+import (
+	"sync"
+	"time"
+)
 
 const (
 	ResourceMax = 6
@@ -32,14 +35,66 @@ const (
 	CmdCityDisband = 9
 )
 
+// Version of the schema of the World dumps. Increment it at each change of the
+// schema that requires a migration, and add the migration step in LoadJSON.
+const WorldVersion = 1
+
 type World struct {
+	// Version of the schema, set when the World is created or loaded, so that
+	// the dumps stay read-only. Zero for the dumps that predate the versioning.
+	Version uint32
+
 	Definitions DefinitionsBase
 	Live        LiveBase
 	Places      Map
 
-	NextId uint64
-	Salt   string
-	rw     sync.RWMutex
+	Clock Clock
+
+	// Sequence number of the last journaled operation applied on the World.
+	// Set by the agent, it tells which journal entries a snapshot already
+	// contains.
+	JournalSeq uint64 `json:",omitempty"`
+
+	NextId   uint64
+	Salt     string
+	rw       sync.RWMutex
+	notifier Notifier
+
+	// The name of the Region, as known by the other Regions. Not persisted.
+	name string
+
+	// The armies on the map by Cell, during a movement tick. Not persisted.
+	armiesByCell map[uint64][]*Army
+}
+
+// The heartbeat of the Region: the movement and production ticks already
+// played and the moments the next ones are due.
+type Clock struct {
+	// Number of movement ticks played since the beginning of the game
+	Tick uint64
+
+	// Number of production rounds played since the beginning of the game
+	Production uint64
+
+	// When the next movement tick is due. Zero when not scheduled yet.
+	NextMove time.Time
+
+	// When the next production round is due. Zero when not scheduled yet.
+	NextProduce time.Time
+
+	// Is the scheduling of the ticks suspended
+	Paused bool
+}
+
+// The periods of the movement and production ticks. A zero period disables
+// the matching tick.
+type Schedule struct {
+	Move    time.Duration
+	Produce time.Duration
+
+	// Maximum number of ticks of each kind played at once to catch up a
+	// delay. The ticks beyond that limit are dropped. Zero means no limit.
+	MaxCatchUp int
 }
 
 type DefinitionsBase struct {
@@ -47,6 +102,9 @@ type DefinitionsBase struct {
 	Buildings  SetOfBuildingTypes
 	Knowledges SetOfKnowledgeTypes
 
+	// The effects of the biomes of the Map on the movements
+	Biomes []*Biome `json:",omitempty"`
+
 	// Ratio applied to the production of resources that is applied for each
 	// Massacre underwent by any city. It only impacts the production of the City itself.
 	MassacreImpact float64
@@ -56,6 +114,10 @@ type DefinitionsBase struct {
 	// `false` for a transport.
 	InstantTransfers bool
 
+	// Index of the Resource paying the trades on the market, that cannot be
+	// traded itself
+	MarketCurrency uint32
+
 	// Permanent bonus to the Popularity when a City creates an Army
 	PopBonusArmyCreate int64
 
@@ -68,6 +130,38 @@ type DefinitionsBase struct {
 	// Default Overlord rate: percentage of the production of a City that is
 	// taxed by its Overlord
 	RateOverlord float64
+
+	// Ratio of the Units lost by an Army that flees a Fight
+	RateFleaUnits float64
+
+	// Ratio of the Stock lost by an Army that flees a Fight
+	RateFleaStock float64
+
+	// Permanent bonus to the Popularity of a City when one of its armies flees a Fight.
+	// Usually negative.
+	PopBonusArmyFlea int64
+
+	// Permanent bonus to the Popularity of a City that breaks a Treaty in force.
+	// Usually negative.
+	PopBonusTreatyBreak int64
+
+	// Weight of the total Popularity in the score of a City
+	ScorePopularity float64
+
+	// Weight of the number of assets (units, buildings, knowledges) in the score of a City
+	ScoreAssets float64
+
+	// Weight of the total amount of resources in stock in the score of a City
+	ScoreStock float64
+
+	// Weight of the number of lieges in the score of a City
+	ScoreLieges float64
+}
+
+// The score of a City, as computed at a given moment
+type CityScore struct {
+	City  *City
+	Score int64
 }
 
 type LiveBase struct {
@@ -80,6 +174,60 @@ type LiveBase struct {
 	// Fights currently happening. The armies involved in the Fight are owned
 	// By the Fight and do not appear in the "Armies" field.
 	Fights SetOfFights
+
+	// Armies leaving the Region through a border, until the Region on the
+	// other side confirms their arrival. The armies are frozen meanwhile and
+	// do not appear in the "Armies" field.
+	Outbox []*Handoff `json:",omitempty"`
+
+	// Armies accepted from another Region, waiting for the commit of the
+	// Region they leave before they enter the map.
+	Inbox []*Handoff `json:",omitempty"`
+
+	// The order book of the market of the Region, sorted by ID
+	Orders []*Order `json:",omitempty"`
+
+	// The treaties between the cities, proposed or in force, sorted by ID
+	Treaties []*Treaty `json:",omitempty"`
+}
+
+const (
+	// The armies of both cities defend each other
+	TreatyAlliance = 1
+	// The armies of both cities ignore each other
+	TreatyPeace = 2
+	// The armies of both cities assault each other. A war is in force as soon
+	// as it is declared.
+	TreatyWar = 3
+)
+
+// A Treaty between two cities, proposed by a City to another. At most one
+// Treaty is in force between two cities, and at most one is proposed.
+type Treaty struct {
+	Id       uint64
+	Kind     uint32
+	Proposer uint64
+	Target   uint64
+
+	// Set once the Target accepted the Treaty
+	Accepted bool `json:",omitempty"`
+}
+
+// An Order of the market: the City buys (bids) or sells (asks) a Quantity
+// of a Resource, at a Price per unit paid with the currency Resource.
+type Order struct {
+	Id       uint64
+	City     uint64
+	Resource uint32
+	Bid      bool `json:",omitempty"`
+
+	// What remains to be traded
+	Quantity uint64
+	Price    uint64
+
+	// Taken from the Stock of the City when the Order was placed, and not
+	// traded yet: the goods of an ask, the payment of a bid.
+	Escrow Resources
 }
 
 type Resources [ResourceMax]uint64
@@ -117,6 +265,10 @@ type Command struct {
 
 	// What to do once arrived at the given Cell.
 	Action uint
+
+	// The Region of the Cell, when it is not the Region where the Army is.
+	// The command is only checked once the Army crossed the border.
+	Region string `json:",omitempty"`
 }
 
 type Army struct {
@@ -126,6 +278,10 @@ type Army struct {
 	// The ID of the City that controls the current Army
 	City uint64 `json:",omitempty"`
 
+	// The Region of the City that controls the current Army, when the Army
+	// is abroad. Empty when the Army is in the Region of its City.
+	Region string `json:",omitempty"`
+
 	// The ID of the Fight this Army is involved in.
 	Fight uint64 `json:",omitempty"`
 
@@ -144,6 +300,10 @@ type Army struct {
 
 	Units SetOfUnits
 
+	// Set on the Armies carrying Resources to another City. A transport
+	// vanishes once its Resources are deposited.
+	Transport bool `json:",omitempty"`
+
 	// The IS of a Cell of the Map that is a goal of the current movement of the Army
 	Targets []Command `json:",omitempty"`
 
@@ -151,6 +311,15 @@ type Army struct {
 	// A positive value means "defend"
 	// A negative value means "assault"
 	Postures []int64 `json:",omitempty"`
+
+	// The Posture against the cities absent from Postures, except the friendly
+	// ones that are always defended: 1 to defend, -1 to assault, 0 to ignore.
+	DefaultPosture int64 `json:",omitempty"`
+
+	// The adjacent Cell the Army is heading to, and the movement points
+	// already spent on the road to it
+	Toward   uint64 `json:",omitempty"`
+	Progress uint64 `json:",omitempty"`
 }
 
 type KnowledgeType struct {
@@ -267,7 +436,9 @@ type City struct {
 	// Both information must match.
 	Cell uint64
 
-	Assault *Fight `json:",omitempty"`
+	// The Fight currently happening on the Cell of the City, if any.
+	// The Fight is owned by the LiveBase and linked to the City at load time.
+	Assault *Fight `json:"-"`
 
 	// The display name of the current City
 	Name string
@@ -354,6 +525,17 @@ type UnitType struct {
 	// How many ticks
 	Ticks uint32
 
+	// How many health points are removed to an opponent Unit at each round
+	// of a Fight, when the current Unit has all its health points.
+	Attack uint32
+
+	// How many health points are absorbed at each hit received during a Fight
+	Defense uint32
+
+	// Movement points of the Unit per tick, 0 means 1. An Army moves at the
+	// speed of its slowest Unit.
+	Speed uint64 `json:",omitempty"`
+
 	// Instantiation cost of the current UnitType
 	Cost Resources
 
@@ -398,7 +580,7 @@ type Unit struct {
 }
 
 type Fight struct {
-	// The unique ID of the
+	// The unique ID of the current Fight
 	Id uint64
 
 	// The unique ID of the MapVertex the current Fight is happening on.
@@ -423,6 +605,20 @@ type MapEdge struct {
 
 	// May the road be used by Units
 	Deleted bool `json:",omitempty"`
+
+	// Movement points needed to cross the road, 0 means 1. The Biome of the
+	// destination multiplies it.
+	Cost uint64 `json:",omitempty"`
+}
+
+// A Biome of the Map and its effect on the movements
+type Biome struct {
+	Id   uint64
+	Name string
+
+	// Factor applied to the cost of the roads entering a Cell of the Biome,
+	// 0 means 1.
+	MoveCost uint64 `json:",omitempty"`
 }
 
 // A MapVertex is a vertex in the transportation directed graph
@@ -435,6 +631,44 @@ type MapVertex struct {
 
 	// The unique ID of the city present at this location.
 	City uint64 `json:",omitempty"`
+
+	// Position of the cell, only used to draw the map
+	X uint64 `json:",omitempty"`
+	Y uint64 `json:",omitempty"`
+
+	// Set on the border cells: where the armies heading to another Region
+	// are handed off.
+	Link *CellLink `json:",omitempty"`
+}
+
+// The Cell of another Region that a border Cell leads to
+type CellLink struct {
+	Region string
+	Cell   uint64
+}
+
+// An Army crossing the border between two Regions. The Region the Army
+// leaves keeps it in its Outbox until the other Region accepted it, then
+// keeps the record until the other Region committed the transfer. The Region
+// the Army enters keeps it in its Inbox between the acceptance and the
+// commit. So the Army is never live in both Regions, and never lost.
+type Handoff struct {
+	// Unique ID of the transfer in the Region the Army leaves
+	Id uint64
+
+	// In the Outbox, the Region the Army enters. In the Inbox, the Region
+	// the Army leaves.
+	Region string
+
+	// The Cell where the Army enters the Region
+	Cell uint64
+
+	// The Army in transit. Nil in the Outbox once the other Region accepted
+	// the Army.
+	Army *Army `json:",omitempty"`
+
+	// The ID of the Army in the Region it enters, once accepted
+	Remote uint64 `json:",omitempty"`
 }
 
 // A Map is a directed graph destined to be used as a transport network,
@@ -445,6 +679,9 @@ type Map struct {
 	NextId uint64
 
 	steps map[vector]uint64
+
+	// The MoveCost of the biomes, by ID
+	biomes map[uint64]uint64
 }
 
 type SetOfFights []*Fight
@@ -27,6 +27,7 @@ const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 type ScoredCity struct {
 	City                 uint64   `protobuf:"varint,1,opt,name=city,proto3" json:"city,omitempty"`
 	Score                int64    `protobuf:"varint,2,opt,name=score,proto3" json:"score,omitempty"`
+	Name                 string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -71,6 +72,13 @@ func (m *ScoredCity) GetScore() int64 {
 	return 0
 }
 
+func (m *ScoredCity) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
 type ScoreBoard struct {
 	Items                []*ScoredCity `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
@@ -110,6 +118,117 @@ func (m *ScoreBoard) GetItems() []*ScoredCity {
 	return nil
 }
 
+type ClockView struct {
+	Tick       uint64 `protobuf:"varint,1,opt,name=tick,proto3" json:"tick,omitempty"`
+	Production uint64 `protobuf:"varint,2,opt,name=production,proto3" json:"production,omitempty"`
+	// UNIX timestamps, in seconds. Zero when not scheduled
+	NextMove             int64    `protobuf:"varint,3,opt,name=nextMove,proto3" json:"nextMove,omitempty"`
+	NextProduce          int64    `protobuf:"varint,4,opt,name=nextProduce,proto3" json:"nextProduce,omitempty"`
+	Paused               bool     `protobuf:"varint,5,opt,name=paused,proto3" json:"paused,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ClockView) Reset()         { *m = ClockView{} }
+func (m *ClockView) String() string { return proto.CompactTextString(m) }
+func (*ClockView) ProtoMessage()    {}
+func (*ClockView) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{2}
+}
+
+func (m *ClockView) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ClockView.Unmarshal(m, b)
+}
+func (m *ClockView) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ClockView.Marshal(b, m, deterministic)
+}
+func (m *ClockView) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClockView.Merge(m, src)
+}
+func (m *ClockView) XXX_Size() int {
+	return xxx_messageInfo_ClockView.Size(m)
+}
+func (m *ClockView) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClockView.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClockView proto.InternalMessageInfo
+
+func (m *ClockView) GetTick() uint64 {
+	if m != nil {
+		return m.Tick
+	}
+	return 0
+}
+
+func (m *ClockView) GetProduction() uint64 {
+	if m != nil {
+		return m.Production
+	}
+	return 0
+}
+
+func (m *ClockView) GetNextMove() int64 {
+	if m != nil {
+		return m.NextMove
+	}
+	return 0
+}
+
+func (m *ClockView) GetNextProduce() int64 {
+	if m != nil {
+		return m.NextProduce
+	}
+	return 0
+}
+
+func (m *ClockView) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+type CatchUpReq struct {
+	Ticks                uint32   `protobuf:"varint,1,opt,name=ticks,proto3" json:"ticks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CatchUpReq) Reset()         { *m = CatchUpReq{} }
+func (m *CatchUpReq) String() string { return proto.CompactTextString(m) }
+func (*CatchUpReq) ProtoMessage()    {}
+func (*CatchUpReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{3}
+}
+
+func (m *CatchUpReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CatchUpReq.Unmarshal(m, b)
+}
+func (m *CatchUpReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CatchUpReq.Marshal(b, m, deterministic)
+}
+func (m *CatchUpReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CatchUpReq.Merge(m, src)
+}
+func (m *CatchUpReq) XXX_Size() int {
+	return xxx_messageInfo_CatchUpReq.Size(m)
+}
+func (m *CatchUpReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_CatchUpReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CatchUpReq proto.InternalMessageInfo
+
+func (m *CatchUpReq) GetTicks() uint32 {
+	if m != nil {
+		return m.Ticks
+	}
+	return 0
+}
+
 type NamedItem struct {
 	Id                   uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
@@ -122,7 +241,7 @@ func (m *NamedItem) Reset()         { *m = NamedItem{} }
 func (m *NamedItem) String() string { return proto.CompactTextString(m) }
 func (*NamedItem) ProtoMessage()    {}
 func (*NamedItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6eef30384a8831dd, []int{2}
+	return fileDescriptor_6eef30384a8831dd, []int{4}
 }
 
 func (m *NamedItem) XXX_Unmarshal(b []byte) error {
@@ -168,7 +287,7 @@ func (m *ListOfNamedItems) Reset()         { *m = ListOfNamedItems{} }
 func (m *ListOfNamedItems) String() string { return proto.CompactTextString(m) }
 func (*ListOfNamedItems) ProtoMessage()    {}
 func (*ListOfNamedItems) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6eef30384a8831dd, []int{3}
+	return fileDescriptor_6eef30384a8831dd, []int{5}
 }
 
 func (m *ListOfNamedItems) XXX_Unmarshal(b []byte) error {
@@ -210,7 +329,7 @@ func (m *ArmyId) Reset()         { *m = ArmyId{} }
 func (m *ArmyId) String() string { return proto.CompactTextString(m) }
 func (*ArmyId) ProtoMessage()    {}
 func (*ArmyId) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6eef30384a8831dd, []int{4}
+	return fileDescriptor_6eef30384a8831dd, []int{6}
 }
 
 func (m *ArmyId) XXX_Unmarshal(b []byte) error {
@@ -253,21 +372,27 @@ func (m *ArmyId) GetArmy() uint64 {
 }
 
 type ArmyView struct {
-	Id                   uint64        `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name                 string        `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Location             uint64        `protobuf:"varint,3,opt,name=location,proto3" json:"location,omitempty"`
-	Stock                *ResourcesAbs `protobuf:"bytes,4,opt,name=stock,proto3" json:"stock,omitempty"`
-	Units                []*UnitView   `protobuf:"bytes,5,rep,name=units,proto3" json:"units,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+	Id       uint64        `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name     string        `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Location uint64        `protobuf:"varint,3,opt,name=location,proto3" json:"location,omitempty"`
+	Stock    *ResourcesAbs `protobuf:"bytes,4,opt,name=stock,proto3" json:"stock,omitempty"`
+	Units    []*UnitView   `protobuf:"bytes,5,rep,name=units,proto3" json:"units,omitempty"`
+	// Set on the Armies carrying Resources to another City
+	Transport bool `protobuf:"varint,6,opt,name=transport,proto3" json:"transport,omitempty"`
+	// The explicit postures: the ID of a City defended, or the opposite of the
+	// ID of a City assaulted
+	Postures             []int64  `protobuf:"varint,7,rep,packed,name=postures,proto3" json:"postures,omitempty"`
+	DefaultPosture       int64    `protobuf:"varint,8,opt,name=default_posture,json=defaultPosture,proto3" json:"default_posture,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ArmyView) Reset()         { *m = ArmyView{} }
 func (m *ArmyView) String() string { return proto.CompactTextString(m) }
 func (*ArmyView) ProtoMessage()    {}
 func (*ArmyView) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6eef30384a8831dd, []int{5}
+	return fileDescriptor_6eef30384a8831dd, []int{7}
 }
 
 func (m *ArmyView) XXX_Unmarshal(b []byte) error {
@@ -323,10 +448,34 @@ func (m *ArmyView) GetUnits() []*UnitView {
 	return nil
 }
 
+func (m *ArmyView) GetTransport() bool {
+	if m != nil {
+		return m.Transport
+	}
+	return false
+}
+
+func (m *ArmyView) GetPostures() []int64 {
+	if m != nil {
+		return m.Postures
+	}
+	return nil
+}
+
+func (m *ArmyView) GetDefaultPosture() int64 {
+	if m != nil {
+		return m.DefaultPosture
+	}
+	return 0
+}
+
 type ArmyCommandReq struct {
-	Id                   *ArmyId  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Target               uint64   `protobuf:"varint,2,opt,name=target,proto3" json:"target,omitempty"`
-	Action               uint64   `protobuf:"varint,3,opt,name=action,proto3" json:"action,omitempty"`
+	Id     *ArmyId `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Target uint64  `protobuf:"varint,2,opt,name=target,proto3" json:"target,omitempty"`
+	Action uint64  `protobuf:"varint,3,opt,name=action,proto3" json:"action,omitempty"`
+	// Set to target a Cell of another Region, instead of a City
+	Region               string   `protobuf:"bytes,4,opt,name=region,proto3" json:"region,omitempty"`
+	Cell                 uint64   `protobuf:"varint,5,opt,name=cell,proto3" json:"cell,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -336,7 +485,7 @@ func (m *ArmyCommandReq) Reset()         { *m = ArmyCommandReq{} }
 func (m *ArmyCommandReq) String() string { return proto.CompactTextString(m) }
 func (*ArmyCommandReq) ProtoMessage()    {}
 func (*ArmyCommandReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6eef30384a8831dd, []int{6}
+	return fileDescriptor_6eef30384a8831dd, []int{8}
 }
 
 func (m *ArmyCommandReq) XXX_Unmarshal(b []byte) error {
@@ -378,2919 +527,5486 @@ func (m *ArmyCommandReq) GetAction() uint64 {
 	return 0
 }
 
-// Identifies a City and Character who is
-type CityId struct {
-	Character            uint64   `protobuf:"varint,1,opt,name=character,proto3" json:"character,omitempty"`
-	City                 uint64   `protobuf:"varint,2,opt,name=city,proto3" json:"city,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *CityId) Reset()         { *m = CityId{} }
-func (m *CityId) String() string { return proto.CompactTextString(m) }
-func (*CityId) ProtoMessage()    {}
-func (*CityId) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6eef30384a8831dd, []int{7}
-}
-
-func (m *CityId) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CityId.Unmarshal(m, b)
-}
-func (m *CityId) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CityId.Marshal(b, m, deterministic)
-}
-func (m *CityId) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CityId.Merge(m, src)
-}
-func (m *CityId) XXX_Size() int {
-	return xxx_messageInfo_CityId.Size(m)
-}
-func (m *CityId) XXX_DiscardUnknown() {
-	xxx_messageInfo_CityId.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_CityId proto.InternalMessageInfo
-
-func (m *CityId) GetCharacter() uint64 {
+func (m *ArmyCommandReq) GetRegion() string {
 	if m != nil {
-		return m.Character
+		return m.Region
 	}
-	return 0
+	return ""
 }
 
-func (m *CityId) GetCity() uint64 {
+func (m *ArmyCommandReq) GetCell() uint64 {
 	if m != nil {
-		return m.City
+		return m.Cell
 	}
 	return 0
 }
 
-type ResourcesAbs struct {
-	R0                   uint64   `protobuf:"varint,1,opt,name=r0,proto3" json:"r0,omitempty"`
-	R1                   uint64   `protobuf:"varint,2,opt,name=r1,proto3" json:"r1,omitempty"`
-	R2                   uint64   `protobuf:"varint,3,opt,name=r2,proto3" json:"r2,omitempty"`
-	R3                   uint64   `protobuf:"varint,4,opt,name=r3,proto3" json:"r3,omitempty"`
-	R4                   uint64   `protobuf:"varint,5,opt,name=r4,proto3" json:"r4,omitempty"`
-	R5                   uint64   `protobuf:"varint,6,opt,name=r5,proto3" json:"r5,omitempty"`
+type ArmyCommandView struct {
+	// Position of the command in the queue of the Army
+	Index uint32 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Cell  uint64 `protobuf:"varint,2,opt,name=cell,proto3" json:"cell,omitempty"`
+	// The City on the Cell, if any
+	City   uint64 `protobuf:"varint,3,opt,name=city,proto3" json:"city,omitempty"`
+	Action uint64 `protobuf:"varint,4,opt,name=action,proto3" json:"action,omitempty"`
+	// Set when the Cell is in another Region
+	Region               string   `protobuf:"bytes,5,opt,name=region,proto3" json:"region,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ResourcesAbs) Reset()         { *m = ResourcesAbs{} }
-func (m *ResourcesAbs) String() string { return proto.CompactTextString(m) }
-func (*ResourcesAbs) ProtoMessage()    {}
-func (*ResourcesAbs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6eef30384a8831dd, []int{8}
+func (m *ArmyCommandView) Reset()         { *m = ArmyCommandView{} }
+func (m *ArmyCommandView) String() string { return proto.CompactTextString(m) }
+func (*ArmyCommandView) ProtoMessage()    {}
+func (*ArmyCommandView) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{9}
 }
 
-func (m *ResourcesAbs) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ResourcesAbs.Unmarshal(m, b)
+func (m *ArmyCommandView) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ArmyCommandView.Unmarshal(m, b)
 }
-func (m *ResourcesAbs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ResourcesAbs.Marshal(b, m, deterministic)
+func (m *ArmyCommandView) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ArmyCommandView.Marshal(b, m, deterministic)
 }
-func (m *ResourcesAbs) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ResourcesAbs.Merge(m, src)
+func (m *ArmyCommandView) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArmyCommandView.Merge(m, src)
 }
-func (m *ResourcesAbs) XXX_Size() int {
-	return xxx_messageInfo_ResourcesAbs.Size(m)
+func (m *ArmyCommandView) XXX_Size() int {
+	return xxx_messageInfo_ArmyCommandView.Size(m)
 }
-func (m *ResourcesAbs) XXX_DiscardUnknown() {
-	xxx_messageInfo_ResourcesAbs.DiscardUnknown(m)
+func (m *ArmyCommandView) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArmyCommandView.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ResourcesAbs proto.InternalMessageInfo
+var xxx_messageInfo_ArmyCommandView proto.InternalMessageInfo
 
-func (m *ResourcesAbs) GetR0() uint64 {
+func (m *ArmyCommandView) GetIndex() uint32 {
 	if m != nil {
-		return m.R0
+		return m.Index
 	}
 	return 0
 }
 
-func (m *ResourcesAbs) GetR1() uint64 {
+func (m *ArmyCommandView) GetCell() uint64 {
 	if m != nil {
-		return m.R1
+		return m.Cell
 	}
 	return 0
 }
 
-func (m *ResourcesAbs) GetR2() uint64 {
+func (m *ArmyCommandView) GetCity() uint64 {
 	if m != nil {
-		return m.R2
+		return m.City
 	}
 	return 0
 }
 
-func (m *ResourcesAbs) GetR3() uint64 {
+func (m *ArmyCommandView) GetAction() uint64 {
 	if m != nil {
-		return m.R3
+		return m.Action
 	}
 	return 0
 }
 
-func (m *ResourcesAbs) GetR4() uint64 {
+func (m *ArmyCommandView) GetRegion() string {
 	if m != nil {
-		return m.R4
+		return m.Region
 	}
-	return 0
+	return ""
 }
 
-func (m *ResourcesAbs) GetR5() uint64 {
+type ListOfArmyCommands struct {
+	Items                []*ArmyCommandView `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *ListOfArmyCommands) Reset()         { *m = ListOfArmyCommands{} }
+func (m *ListOfArmyCommands) String() string { return proto.CompactTextString(m) }
+func (*ListOfArmyCommands) ProtoMessage()    {}
+func (*ListOfArmyCommands) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{10}
+}
+
+func (m *ListOfArmyCommands) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListOfArmyCommands.Unmarshal(m, b)
+}
+func (m *ListOfArmyCommands) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListOfArmyCommands.Marshal(b, m, deterministic)
+}
+func (m *ListOfArmyCommands) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListOfArmyCommands.Merge(m, src)
+}
+func (m *ListOfArmyCommands) XXX_Size() int {
+	return xxx_messageInfo_ListOfArmyCommands.Size(m)
+}
+func (m *ListOfArmyCommands) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListOfArmyCommands.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListOfArmyCommands proto.InternalMessageInfo
+
+func (m *ListOfArmyCommands) GetItems() []*ArmyCommandView {
 	if m != nil {
-		return m.R5
+		return m.Items
 	}
-	return 0
+	return nil
 }
 
-type ResourcesPlus struct {
-	R0                   int64    `protobuf:"varint,1,opt,name=r0,proto3" json:"r0,omitempty"`
-	R1                   int64    `protobuf:"varint,2,opt,name=r1,proto3" json:"r1,omitempty"`
-	R2                   int64    `protobuf:"varint,3,opt,name=r2,proto3" json:"r2,omitempty"`
-	R3                   int64    `protobuf:"varint,4,opt,name=r3,proto3" json:"r3,omitempty"`
-	R4                   int64    `protobuf:"varint,5,opt,name=r4,proto3" json:"r4,omitempty"`
-	R5                   int64    `protobuf:"varint,6,opt,name=r5,proto3" json:"r5,omitempty"`
+type ArmyCommandReorderReq struct {
+	Id                   *ArmyId  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	From                 uint32   `protobuf:"varint,2,opt,name=from,proto3" json:"from,omitempty"`
+	To                   uint32   `protobuf:"varint,3,opt,name=to,proto3" json:"to,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ResourcesPlus) Reset()         { *m = ResourcesPlus{} }
-func (m *ResourcesPlus) String() string { return proto.CompactTextString(m) }
-func (*ResourcesPlus) ProtoMessage()    {}
-func (*ResourcesPlus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6eef30384a8831dd, []int{9}
+func (m *ArmyCommandReorderReq) Reset()         { *m = ArmyCommandReorderReq{} }
+func (m *ArmyCommandReorderReq) String() string { return proto.CompactTextString(m) }
+func (*ArmyCommandReorderReq) ProtoMessage()    {}
+func (*ArmyCommandReorderReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{11}
 }
 
-func (m *ResourcesPlus) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ResourcesPlus.Unmarshal(m, b)
+func (m *ArmyCommandReorderReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ArmyCommandReorderReq.Unmarshal(m, b)
 }
-func (m *ResourcesPlus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ResourcesPlus.Marshal(b, m, deterministic)
+func (m *ArmyCommandReorderReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ArmyCommandReorderReq.Marshal(b, m, deterministic)
 }
-func (m *ResourcesPlus) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ResourcesPlus.Merge(m, src)
+func (m *ArmyCommandReorderReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArmyCommandReorderReq.Merge(m, src)
 }
-func (m *ResourcesPlus) XXX_Size() int {
-	return xxx_messageInfo_ResourcesPlus.Size(m)
+func (m *ArmyCommandReorderReq) XXX_Size() int {
+	return xxx_messageInfo_ArmyCommandReorderReq.Size(m)
 }
-func (m *ResourcesPlus) XXX_DiscardUnknown() {
-	xxx_messageInfo_ResourcesPlus.DiscardUnknown(m)
+func (m *ArmyCommandReorderReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArmyCommandReorderReq.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ResourcesPlus proto.InternalMessageInfo
+var xxx_messageInfo_ArmyCommandReorderReq proto.InternalMessageInfo
 
-func (m *ResourcesPlus) GetR0() int64 {
+func (m *ArmyCommandReorderReq) GetId() *ArmyId {
 	if m != nil {
-		return m.R0
+		return m.Id
 	}
-	return 0
+	return nil
 }
 
-func (m *ResourcesPlus) GetR1() int64 {
+func (m *ArmyCommandReorderReq) GetFrom() uint32 {
 	if m != nil {
-		return m.R1
+		return m.From
 	}
 	return 0
 }
 
-func (m *ResourcesPlus) GetR2() int64 {
+func (m *ArmyCommandReorderReq) GetTo() uint32 {
 	if m != nil {
-		return m.R2
+		return m.To
 	}
 	return 0
 }
 
-func (m *ResourcesPlus) GetR3() int64 {
-	if m != nil {
-		return m.R3
-	}
-	return 0
+type ArmyCommandCancelReq struct {
+	Id                   *ArmyId  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Index                uint32   `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ResourcesPlus) GetR4() int64 {
-	if m != nil {
-		return m.R4
-	}
-	return 0
+func (m *ArmyCommandCancelReq) Reset()         { *m = ArmyCommandCancelReq{} }
+func (m *ArmyCommandCancelReq) String() string { return proto.CompactTextString(m) }
+func (*ArmyCommandCancelReq) ProtoMessage()    {}
+func (*ArmyCommandCancelReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{12}
 }
 
-func (m *ResourcesPlus) GetR5() int64 {
-	if m != nil {
-		return m.R5
-	}
-	return 0
+func (m *ArmyCommandCancelReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ArmyCommandCancelReq.Unmarshal(m, b)
 }
-
-type ResourcesMult struct {
-	R0                   float64  `protobuf:"fixed64,1,opt,name=r0,proto3" json:"r0,omitempty"`
-	R1                   float64  `protobuf:"fixed64,2,opt,name=r1,proto3" json:"r1,omitempty"`
-	R2                   float64  `protobuf:"fixed64,3,opt,name=r2,proto3" json:"r2,omitempty"`
-	R3                   float64  `protobuf:"fixed64,4,opt,name=r3,proto3" json:"r3,omitempty"`
-	R4                   float64  `protobuf:"fixed64,5,opt,name=r4,proto3" json:"r4,omitempty"`
-	R5                   float64  `protobuf:"fixed64,6,opt,name=r5,proto3" json:"r5,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *ResourcesMult) Reset()         { *m = ResourcesMult{} }
-func (m *ResourcesMult) String() string { return proto.CompactTextString(m) }
-func (*ResourcesMult) ProtoMessage()    {}
-func (*ResourcesMult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6eef30384a8831dd, []int{10}
-}
-
-func (m *ResourcesMult) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ResourcesMult.Unmarshal(m, b)
-}
-func (m *ResourcesMult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ResourcesMult.Marshal(b, m, deterministic)
+func (m *ArmyCommandCancelReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ArmyCommandCancelReq.Marshal(b, m, deterministic)
 }
-func (m *ResourcesMult) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ResourcesMult.Merge(m, src)
+func (m *ArmyCommandCancelReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArmyCommandCancelReq.Merge(m, src)
 }
-func (m *ResourcesMult) XXX_Size() int {
-	return xxx_messageInfo_ResourcesMult.Size(m)
+func (m *ArmyCommandCancelReq) XXX_Size() int {
+	return xxx_messageInfo_ArmyCommandCancelReq.Size(m)
 }
-func (m *ResourcesMult) XXX_DiscardUnknown() {
-	xxx_messageInfo_ResourcesMult.DiscardUnknown(m)
+func (m *ArmyCommandCancelReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArmyCommandCancelReq.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ResourcesMult proto.InternalMessageInfo
+var xxx_messageInfo_ArmyCommandCancelReq proto.InternalMessageInfo
 
-func (m *ResourcesMult) GetR0() float64 {
+func (m *ArmyCommandCancelReq) GetId() *ArmyId {
 	if m != nil {
-		return m.R0
+		return m.Id
 	}
-	return 0
+	return nil
 }
 
-func (m *ResourcesMult) GetR1() float64 {
+func (m *ArmyCommandCancelReq) GetIndex() uint32 {
 	if m != nil {
-		return m.R1
+		return m.Index
 	}
 	return 0
 }
 
-func (m *ResourcesMult) GetR2() float64 {
-	if m != nil {
-		return m.R2
-	}
-	return 0
+type ArmyPostureReq struct {
+	Id *ArmyId `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The City targeted, 0 for the default posture
+	Target uint64 `protobuf:"varint,2,opt,name=target,proto3" json:"target,omitempty"`
+	// 1 to defend, -1 to assault, 0 to ignore
+	Posture              int64    `protobuf:"varint,3,opt,name=posture,proto3" json:"posture,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ResourcesMult) GetR3() float64 {
+func (m *ArmyPostureReq) Reset()         { *m = ArmyPostureReq{} }
+func (m *ArmyPostureReq) String() string { return proto.CompactTextString(m) }
+func (*ArmyPostureReq) ProtoMessage()    {}
+func (*ArmyPostureReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{13}
+}
+
+func (m *ArmyPostureReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ArmyPostureReq.Unmarshal(m, b)
+}
+func (m *ArmyPostureReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ArmyPostureReq.Marshal(b, m, deterministic)
+}
+func (m *ArmyPostureReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArmyPostureReq.Merge(m, src)
+}
+func (m *ArmyPostureReq) XXX_Size() int {
+	return xxx_messageInfo_ArmyPostureReq.Size(m)
+}
+func (m *ArmyPostureReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArmyPostureReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArmyPostureReq proto.InternalMessageInfo
+
+func (m *ArmyPostureReq) GetId() *ArmyId {
 	if m != nil {
-		return m.R3
+		return m.Id
 	}
-	return 0
+	return nil
 }
 
-func (m *ResourcesMult) GetR4() float64 {
+func (m *ArmyPostureReq) GetTarget() uint64 {
 	if m != nil {
-		return m.R4
+		return m.Target
 	}
 	return 0
 }
 
-func (m *ResourcesMult) GetR5() float64 {
+func (m *ArmyPostureReq) GetPosture() int64 {
 	if m != nil {
-		return m.R5
+		return m.Posture
 	}
 	return 0
 }
 
-type ResourcesMod struct {
-	Plus                 *ResourcesPlus `protobuf:"bytes,1,opt,name=plus,proto3" json:"plus,omitempty"`
-	Mult                 *ResourcesMult `protobuf:"bytes,2,opt,name=mult,proto3" json:"mult,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+// Identifies a City and Character who is
+type CityId struct {
+	Character            uint64   `protobuf:"varint,1,opt,name=character,proto3" json:"character,omitempty"`
+	City                 uint64   `protobuf:"varint,2,opt,name=city,proto3" json:"city,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ResourcesMod) Reset()         { *m = ResourcesMod{} }
-func (m *ResourcesMod) String() string { return proto.CompactTextString(m) }
-func (*ResourcesMod) ProtoMessage()    {}
-func (*ResourcesMod) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6eef30384a8831dd, []int{11}
+func (m *CityId) Reset()         { *m = CityId{} }
+func (m *CityId) String() string { return proto.CompactTextString(m) }
+func (*CityId) ProtoMessage()    {}
+func (*CityId) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{14}
 }
 
-func (m *ResourcesMod) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ResourcesMod.Unmarshal(m, b)
+func (m *CityId) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CityId.Unmarshal(m, b)
 }
-func (m *ResourcesMod) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ResourcesMod.Marshal(b, m, deterministic)
+func (m *CityId) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CityId.Marshal(b, m, deterministic)
 }
-func (m *ResourcesMod) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ResourcesMod.Merge(m, src)
+func (m *CityId) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CityId.Merge(m, src)
 }
-func (m *ResourcesMod) XXX_Size() int {
-	return xxx_messageInfo_ResourcesMod.Size(m)
+func (m *CityId) XXX_Size() int {
+	return xxx_messageInfo_CityId.Size(m)
 }
-func (m *ResourcesMod) XXX_DiscardUnknown() {
-	xxx_messageInfo_ResourcesMod.DiscardUnknown(m)
+func (m *CityId) XXX_DiscardUnknown() {
+	xxx_messageInfo_CityId.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ResourcesMod proto.InternalMessageInfo
+var xxx_messageInfo_CityId proto.InternalMessageInfo
 
-func (m *ResourcesMod) GetPlus() *ResourcesPlus {
+func (m *CityId) GetCharacter() uint64 {
 	if m != nil {
-		return m.Plus
+		return m.Character
 	}
-	return nil
+	return 0
 }
 
-func (m *ResourcesMod) GetMult() *ResourcesMult {
+func (m *CityId) GetCity() uint64 {
 	if m != nil {
-		return m.Mult
+		return m.City
 	}
-	return nil
+	return 0
 }
 
-type UnitTypeView struct {
-	Id                   uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+type ResourcesAbs struct {
+	R0                   uint64   `protobuf:"varint,1,opt,name=r0,proto3" json:"r0,omitempty"`
+	R1                   uint64   `protobuf:"varint,2,opt,name=r1,proto3" json:"r1,omitempty"`
+	R2                   uint64   `protobuf:"varint,3,opt,name=r2,proto3" json:"r2,omitempty"`
+	R3                   uint64   `protobuf:"varint,4,opt,name=r3,proto3" json:"r3,omitempty"`
+	R4                   uint64   `protobuf:"varint,5,opt,name=r4,proto3" json:"r4,omitempty"`
+	R5                   uint64   `protobuf:"varint,6,opt,name=r5,proto3" json:"r5,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *UnitTypeView) Reset()         { *m = UnitTypeView{} }
-func (m *UnitTypeView) String() string { return proto.CompactTextString(m) }
-func (*UnitTypeView) ProtoMessage()    {}
-func (*UnitTypeView) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6eef30384a8831dd, []int{12}
+func (m *ResourcesAbs) Reset()         { *m = ResourcesAbs{} }
+func (m *ResourcesAbs) String() string { return proto.CompactTextString(m) }
+func (*ResourcesAbs) ProtoMessage()    {}
+func (*ResourcesAbs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{15}
 }
 
-func (m *UnitTypeView) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UnitTypeView.Unmarshal(m, b)
+func (m *ResourcesAbs) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResourcesAbs.Unmarshal(m, b)
 }
-func (m *UnitTypeView) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UnitTypeView.Marshal(b, m, deterministic)
+func (m *ResourcesAbs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResourcesAbs.Marshal(b, m, deterministic)
 }
-func (m *UnitTypeView) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UnitTypeView.Merge(m, src)
+func (m *ResourcesAbs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourcesAbs.Merge(m, src)
 }
-func (m *UnitTypeView) XXX_Size() int {
-	return xxx_messageInfo_UnitTypeView.Size(m)
+func (m *ResourcesAbs) XXX_Size() int {
+	return xxx_messageInfo_ResourcesAbs.Size(m)
 }
-func (m *UnitTypeView) XXX_DiscardUnknown() {
-	xxx_messageInfo_UnitTypeView.DiscardUnknown(m)
+func (m *ResourcesAbs) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourcesAbs.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UnitTypeView proto.InternalMessageInfo
+var xxx_messageInfo_ResourcesAbs proto.InternalMessageInfo
 
-func (m *UnitTypeView) GetId() uint64 {
+func (m *ResourcesAbs) GetR0() uint64 {
 	if m != nil {
-		return m.Id
+		return m.R0
 	}
 	return 0
 }
 
-func (m *UnitTypeView) GetName() string {
+func (m *ResourcesAbs) GetR1() uint64 {
 	if m != nil {
-		return m.Name
+		return m.R1
 	}
-	return ""
-}
-
-type BuildingTypeView struct {
-	Id                   uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	return 0
 }
 
-func (m *BuildingTypeView) Reset()         { *m = BuildingTypeView{} }
-func (m *BuildingTypeView) String() string { return proto.CompactTextString(m) }
-func (*BuildingTypeView) ProtoMessage()    {}
-func (*BuildingTypeView) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6eef30384a8831dd, []int{13}
+func (m *ResourcesAbs) GetR2() uint64 {
+	if m != nil {
+		return m.R2
+	}
+	return 0
 }
 
-func (m *BuildingTypeView) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_BuildingTypeView.Unmarshal(m, b)
-}
-func (m *BuildingTypeView) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_BuildingTypeView.Marshal(b, m, deterministic)
-}
-func (m *BuildingTypeView) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BuildingTypeView.Merge(m, src)
-}
-func (m *BuildingTypeView) XXX_Size() int {
-	return xxx_messageInfo_BuildingTypeView.Size(m)
-}
-func (m *BuildingTypeView) XXX_DiscardUnknown() {
-	xxx_messageInfo_BuildingTypeView.DiscardUnknown(m)
+func (m *ResourcesAbs) GetR3() uint64 {
+	if m != nil {
+		return m.R3
+	}
+	return 0
 }
 
-var xxx_messageInfo_BuildingTypeView proto.InternalMessageInfo
-
-func (m *BuildingTypeView) GetId() uint64 {
+func (m *ResourcesAbs) GetR4() uint64 {
 	if m != nil {
-		return m.Id
+		return m.R4
 	}
 	return 0
 }
 
-func (m *BuildingTypeView) GetName() string {
+func (m *ResourcesAbs) GetR5() uint64 {
 	if m != nil {
-		return m.Name
+		return m.R5
 	}
-	return ""
+	return 0
 }
 
-type KnowledgeTypeView struct {
-	Id                   uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+type ResourcesPlus struct {
+	R0                   int64    `protobuf:"varint,1,opt,name=r0,proto3" json:"r0,omitempty"`
+	R1                   int64    `protobuf:"varint,2,opt,name=r1,proto3" json:"r1,omitempty"`
+	R2                   int64    `protobuf:"varint,3,opt,name=r2,proto3" json:"r2,omitempty"`
+	R3                   int64    `protobuf:"varint,4,opt,name=r3,proto3" json:"r3,omitempty"`
+	R4                   int64    `protobuf:"varint,5,opt,name=r4,proto3" json:"r4,omitempty"`
+	R5                   int64    `protobuf:"varint,6,opt,name=r5,proto3" json:"r5,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *KnowledgeTypeView) Reset()         { *m = KnowledgeTypeView{} }
-func (m *KnowledgeTypeView) String() string { return proto.CompactTextString(m) }
-func (*KnowledgeTypeView) ProtoMessage()    {}
-func (*KnowledgeTypeView) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6eef30384a8831dd, []int{14}
+func (m *ResourcesPlus) Reset()         { *m = ResourcesPlus{} }
+func (m *ResourcesPlus) String() string { return proto.CompactTextString(m) }
+func (*ResourcesPlus) ProtoMessage()    {}
+func (*ResourcesPlus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{16}
 }
 
-func (m *KnowledgeTypeView) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_KnowledgeTypeView.Unmarshal(m, b)
+func (m *ResourcesPlus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResourcesPlus.Unmarshal(m, b)
 }
-func (m *KnowledgeTypeView) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_KnowledgeTypeView.Marshal(b, m, deterministic)
+func (m *ResourcesPlus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResourcesPlus.Marshal(b, m, deterministic)
 }
-func (m *KnowledgeTypeView) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_KnowledgeTypeView.Merge(m, src)
+func (m *ResourcesPlus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourcesPlus.Merge(m, src)
 }
-func (m *KnowledgeTypeView) XXX_Size() int {
-	return xxx_messageInfo_KnowledgeTypeView.Size(m)
+func (m *ResourcesPlus) XXX_Size() int {
+	return xxx_messageInfo_ResourcesPlus.Size(m)
 }
-func (m *KnowledgeTypeView) XXX_DiscardUnknown() {
-	xxx_messageInfo_KnowledgeTypeView.DiscardUnknown(m)
+func (m *ResourcesPlus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourcesPlus.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_KnowledgeTypeView proto.InternalMessageInfo
+var xxx_messageInfo_ResourcesPlus proto.InternalMessageInfo
 
-func (m *KnowledgeTypeView) GetId() uint64 {
+func (m *ResourcesPlus) GetR0() int64 {
 	if m != nil {
-		return m.Id
+		return m.R0
 	}
 	return 0
 }
 
-func (m *KnowledgeTypeView) GetName() string {
-	if m != nil {
-		return m.Name
-	}
-	return ""
-}
-
-type UnitView struct {
-	Type                 *UnitTypeView `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
-	Id                   uint64        `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
-	IdType               uint64        `protobuf:"varint,3,opt,name=idType,proto3" json:"idType,omitempty"`
-	Ticks                uint32        `protobuf:"varint,4,opt,name=ticks,proto3" json:"ticks,omitempty"`
-	Health               uint32        `protobuf:"varint,5,opt,name=health,proto3" json:"health,omitempty"`
-	Name                 string        `protobuf:"bytes,6,opt,name=name,proto3" json:"name,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
-}
-
-func (m *UnitView) Reset()         { *m = UnitView{} }
-func (m *UnitView) String() string { return proto.CompactTextString(m) }
-func (*UnitView) ProtoMessage()    {}
-func (*UnitView) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6eef30384a8831dd, []int{15}
-}
-
-func (m *UnitView) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UnitView.Unmarshal(m, b)
-}
-func (m *UnitView) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UnitView.Marshal(b, m, deterministic)
-}
-func (m *UnitView) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UnitView.Merge(m, src)
-}
-func (m *UnitView) XXX_Size() int {
-	return xxx_messageInfo_UnitView.Size(m)
-}
-func (m *UnitView) XXX_DiscardUnknown() {
-	xxx_messageInfo_UnitView.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_UnitView proto.InternalMessageInfo
-
-func (m *UnitView) GetType() *UnitTypeView {
-	if m != nil {
-		return m.Type
-	}
-	return nil
-}
-
-func (m *UnitView) GetId() uint64 {
+func (m *ResourcesPlus) GetR1() int64 {
 	if m != nil {
-		return m.Id
+		return m.R1
 	}
 	return 0
 }
 
-func (m *UnitView) GetIdType() uint64 {
+func (m *ResourcesPlus) GetR2() int64 {
 	if m != nil {
-		return m.IdType
+		return m.R2
 	}
 	return 0
 }
 
-func (m *UnitView) GetTicks() uint32 {
+func (m *ResourcesPlus) GetR3() int64 {
 	if m != nil {
-		return m.Ticks
+		return m.R3
 	}
 	return 0
 }
 
-func (m *UnitView) GetHealth() uint32 {
+func (m *ResourcesPlus) GetR4() int64 {
 	if m != nil {
-		return m.Health
+		return m.R4
 	}
 	return 0
 }
 
-func (m *UnitView) GetName() string {
+func (m *ResourcesPlus) GetR5() int64 {
 	if m != nil {
-		return m.Name
+		return m.R5
 	}
-	return ""
+	return 0
 }
 
-type BuildingView struct {
-	Type                 *BuildingTypeView `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
-	Id                   uint64            `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
-	IdType               uint64            `protobuf:"varint,3,opt,name=idType,proto3" json:"idType,omitempty"`
-	Ticks                uint32            `protobuf:"varint,4,opt,name=ticks,proto3" json:"ticks,omitempty"`
-	Name                 string            `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+type ResourcesMult struct {
+	R0                   float64  `protobuf:"fixed64,1,opt,name=r0,proto3" json:"r0,omitempty"`
+	R1                   float64  `protobuf:"fixed64,2,opt,name=r1,proto3" json:"r1,omitempty"`
+	R2                   float64  `protobuf:"fixed64,3,opt,name=r2,proto3" json:"r2,omitempty"`
+	R3                   float64  `protobuf:"fixed64,4,opt,name=r3,proto3" json:"r3,omitempty"`
+	R4                   float64  `protobuf:"fixed64,5,opt,name=r4,proto3" json:"r4,omitempty"`
+	R5                   float64  `protobuf:"fixed64,6,opt,name=r5,proto3" json:"r5,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *BuildingView) Reset()         { *m = BuildingView{} }
-func (m *BuildingView) String() string { return proto.CompactTextString(m) }
-func (*BuildingView) ProtoMessage()    {}
-func (*BuildingView) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6eef30384a8831dd, []int{16}
+func (m *ResourcesMult) Reset()         { *m = ResourcesMult{} }
+func (m *ResourcesMult) String() string { return proto.CompactTextString(m) }
+func (*ResourcesMult) ProtoMessage()    {}
+func (*ResourcesMult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{17}
 }
 
-func (m *BuildingView) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_BuildingView.Unmarshal(m, b)
+func (m *ResourcesMult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResourcesMult.Unmarshal(m, b)
 }
-func (m *BuildingView) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_BuildingView.Marshal(b, m, deterministic)
+func (m *ResourcesMult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResourcesMult.Marshal(b, m, deterministic)
 }
-func (m *BuildingView) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BuildingView.Merge(m, src)
+func (m *ResourcesMult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourcesMult.Merge(m, src)
 }
-func (m *BuildingView) XXX_Size() int {
-	return xxx_messageInfo_BuildingView.Size(m)
+func (m *ResourcesMult) XXX_Size() int {
+	return xxx_messageInfo_ResourcesMult.Size(m)
 }
-func (m *BuildingView) XXX_DiscardUnknown() {
-	xxx_messageInfo_BuildingView.DiscardUnknown(m)
+func (m *ResourcesMult) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourcesMult.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BuildingView proto.InternalMessageInfo
+var xxx_messageInfo_ResourcesMult proto.InternalMessageInfo
 
-func (m *BuildingView) GetType() *BuildingTypeView {
+func (m *ResourcesMult) GetR0() float64 {
 	if m != nil {
-		return m.Type
+		return m.R0
 	}
-	return nil
+	return 0
 }
 
-func (m *BuildingView) GetId() uint64 {
+func (m *ResourcesMult) GetR1() float64 {
 	if m != nil {
-		return m.Id
+		return m.R1
 	}
 	return 0
 }
 
-func (m *BuildingView) GetIdType() uint64 {
+func (m *ResourcesMult) GetR2() float64 {
 	if m != nil {
-		return m.IdType
+		return m.R2
 	}
 	return 0
 }
 
-func (m *BuildingView) GetTicks() uint32 {
+func (m *ResourcesMult) GetR3() float64 {
 	if m != nil {
-		return m.Ticks
+		return m.R3
 	}
 	return 0
 }
 
-func (m *BuildingView) GetName() string {
+func (m *ResourcesMult) GetR4() float64 {
 	if m != nil {
-		return m.Name
+		return m.R4
 	}
-	return ""
+	return 0
 }
 
-type KnowledgeView struct {
-	Type                 *KnowledgeTypeView `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
-	Id                   uint64             `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
-	IdType               uint64             `protobuf:"varint,3,opt,name=idType,proto3" json:"idType,omitempty"`
-	Ticks                uint32             `protobuf:"varint,4,opt,name=ticks,proto3" json:"ticks,omitempty"`
-	Name                 string             `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
-	XXX_unrecognized     []byte             `json:"-"`
-	XXX_sizecache        int32              `json:"-"`
+func (m *ResourcesMult) GetR5() float64 {
+	if m != nil {
+		return m.R5
+	}
+	return 0
 }
 
-func (m *KnowledgeView) Reset()         { *m = KnowledgeView{} }
-func (m *KnowledgeView) String() string { return proto.CompactTextString(m) }
-func (*KnowledgeView) ProtoMessage()    {}
-func (*KnowledgeView) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6eef30384a8831dd, []int{17}
+type ResourcesMod struct {
+	Plus                 *ResourcesPlus `protobuf:"bytes,1,opt,name=plus,proto3" json:"plus,omitempty"`
+	Mult                 *ResourcesMult `protobuf:"bytes,2,opt,name=mult,proto3" json:"mult,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
-func (m *KnowledgeView) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_KnowledgeView.Unmarshal(m, b)
+func (m *ResourcesMod) Reset()         { *m = ResourcesMod{} }
+func (m *ResourcesMod) String() string { return proto.CompactTextString(m) }
+func (*ResourcesMod) ProtoMessage()    {}
+func (*ResourcesMod) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{18}
 }
-func (m *KnowledgeView) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_KnowledgeView.Marshal(b, m, deterministic)
+
+func (m *ResourcesMod) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResourcesMod.Unmarshal(m, b)
 }
-func (m *KnowledgeView) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_KnowledgeView.Merge(m, src)
+func (m *ResourcesMod) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResourcesMod.Marshal(b, m, deterministic)
 }
-func (m *KnowledgeView) XXX_Size() int {
-	return xxx_messageInfo_KnowledgeView.Size(m)
+func (m *ResourcesMod) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourcesMod.Merge(m, src)
 }
-func (m *KnowledgeView) XXX_DiscardUnknown() {
-	xxx_messageInfo_KnowledgeView.DiscardUnknown(m)
+func (m *ResourcesMod) XXX_Size() int {
+	return xxx_messageInfo_ResourcesMod.Size(m)
+}
+func (m *ResourcesMod) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourcesMod.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_KnowledgeView proto.InternalMessageInfo
+var xxx_messageInfo_ResourcesMod proto.InternalMessageInfo
 
-func (m *KnowledgeView) GetType() *KnowledgeTypeView {
+func (m *ResourcesMod) GetPlus() *ResourcesPlus {
 	if m != nil {
-		return m.Type
+		return m.Plus
 	}
 	return nil
 }
 
-func (m *KnowledgeView) GetId() uint64 {
+func (m *ResourcesMod) GetMult() *ResourcesMult {
 	if m != nil {
-		return m.Id
+		return m.Mult
 	}
-	return 0
+	return nil
 }
 
-func (m *KnowledgeView) GetIdType() uint64 {
-	if m != nil {
-		return m.IdType
-	}
-	return 0
+type UnitTypeView struct {
+	Id                   uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *KnowledgeView) GetTicks() uint32 {
-	if m != nil {
-		return m.Ticks
-	}
-	return 0
+func (m *UnitTypeView) Reset()         { *m = UnitTypeView{} }
+func (m *UnitTypeView) String() string { return proto.CompactTextString(m) }
+func (*UnitTypeView) ProtoMessage()    {}
+func (*UnitTypeView) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{19}
 }
 
-func (m *KnowledgeView) GetName() string {
-	if m != nil {
-		return m.Name
-	}
-	return ""
+func (m *UnitTypeView) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnitTypeView.Unmarshal(m, b)
 }
-
-type StockView struct {
-	Base                 *ResourcesAbs `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	Knowledge            *ResourcesMod `protobuf:"bytes,2,opt,name=knowledge,proto3" json:"knowledge,omitempty"`
-	Buildings            *ResourcesMod `protobuf:"bytes,3,opt,name=buildings,proto3" json:"buildings,omitempty"`
-	Troops               *ResourcesMod `protobuf:"bytes,4,opt,name=troops,proto3" json:"troops,omitempty"`
-	Actual               *ResourcesAbs `protobuf:"bytes,5,opt,name=actual,proto3" json:"actual,omitempty"`
-	Usage                *ResourcesAbs `protobuf:"bytes,6,opt,name=usage,proto3" json:"usage,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+func (m *UnitTypeView) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnitTypeView.Marshal(b, m, deterministic)
+}
+func (m *UnitTypeView) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnitTypeView.Merge(m, src)
+}
+func (m *UnitTypeView) XXX_Size() int {
+	return xxx_messageInfo_UnitTypeView.Size(m)
+}
+func (m *UnitTypeView) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnitTypeView.DiscardUnknown(m)
 }
 
-func (m *StockView) Reset()         { *m = StockView{} }
-func (m *StockView) String() string { return proto.CompactTextString(m) }
-func (*StockView) ProtoMessage()    {}
-func (*StockView) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6eef30384a8831dd, []int{18}
+var xxx_messageInfo_UnitTypeView proto.InternalMessageInfo
+
+func (m *UnitTypeView) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
 }
 
-func (m *StockView) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_StockView.Unmarshal(m, b)
+func (m *UnitTypeView) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
 }
-func (m *StockView) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_StockView.Marshal(b, m, deterministic)
+
+type BuildingTypeView struct {
+	Id                   uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (m *StockView) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StockView.Merge(m, src)
+
+func (m *BuildingTypeView) Reset()         { *m = BuildingTypeView{} }
+func (m *BuildingTypeView) String() string { return proto.CompactTextString(m) }
+func (*BuildingTypeView) ProtoMessage()    {}
+func (*BuildingTypeView) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{20}
 }
-func (m *StockView) XXX_Size() int {
-	return xxx_messageInfo_StockView.Size(m)
+
+func (m *BuildingTypeView) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BuildingTypeView.Unmarshal(m, b)
 }
-func (m *StockView) XXX_DiscardUnknown() {
-	xxx_messageInfo_StockView.DiscardUnknown(m)
+func (m *BuildingTypeView) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BuildingTypeView.Marshal(b, m, deterministic)
+}
+func (m *BuildingTypeView) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BuildingTypeView.Merge(m, src)
+}
+func (m *BuildingTypeView) XXX_Size() int {
+	return xxx_messageInfo_BuildingTypeView.Size(m)
+}
+func (m *BuildingTypeView) XXX_DiscardUnknown() {
+	xxx_messageInfo_BuildingTypeView.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_StockView proto.InternalMessageInfo
+var xxx_messageInfo_BuildingTypeView proto.InternalMessageInfo
 
-func (m *StockView) GetBase() *ResourcesAbs {
+func (m *BuildingTypeView) GetId() uint64 {
 	if m != nil {
-		return m.Base
+		return m.Id
 	}
-	return nil
+	return 0
 }
 
-func (m *StockView) GetKnowledge() *ResourcesMod {
+func (m *BuildingTypeView) GetName() string {
 	if m != nil {
-		return m.Knowledge
+		return m.Name
 	}
-	return nil
+	return ""
 }
 
-func (m *StockView) GetBuildings() *ResourcesMod {
-	if m != nil {
-		return m.Buildings
-	}
-	return nil
+type KnowledgeTypeView struct {
+	Id                   uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *StockView) GetTroops() *ResourcesMod {
-	if m != nil {
-		return m.Troops
-	}
-	return nil
+func (m *KnowledgeTypeView) Reset()         { *m = KnowledgeTypeView{} }
+func (m *KnowledgeTypeView) String() string { return proto.CompactTextString(m) }
+func (*KnowledgeTypeView) ProtoMessage()    {}
+func (*KnowledgeTypeView) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{21}
 }
 
-func (m *StockView) GetActual() *ResourcesAbs {
+func (m *KnowledgeTypeView) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_KnowledgeTypeView.Unmarshal(m, b)
+}
+func (m *KnowledgeTypeView) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_KnowledgeTypeView.Marshal(b, m, deterministic)
+}
+func (m *KnowledgeTypeView) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KnowledgeTypeView.Merge(m, src)
+}
+func (m *KnowledgeTypeView) XXX_Size() int {
+	return xxx_messageInfo_KnowledgeTypeView.Size(m)
+}
+func (m *KnowledgeTypeView) XXX_DiscardUnknown() {
+	xxx_messageInfo_KnowledgeTypeView.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KnowledgeTypeView proto.InternalMessageInfo
+
+func (m *KnowledgeTypeView) GetId() uint64 {
 	if m != nil {
-		return m.Actual
+		return m.Id
 	}
-	return nil
+	return 0
 }
 
-func (m *StockView) GetUsage() *ResourcesAbs {
+func (m *KnowledgeTypeView) GetName() string {
 	if m != nil {
-		return m.Usage
+		return m.Name
 	}
-	return nil
+	return ""
 }
 
-type ProductionView struct {
-	Base                 *ResourcesAbs `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	Knowledge            *ResourcesMod `protobuf:"bytes,2,opt,name=knowledge,proto3" json:"knowledge,omitempty"`
-	Buildings            *ResourcesMod `protobuf:"bytes,3,opt,name=buildings,proto3" json:"buildings,omitempty"`
-	Troops               *ResourcesMod `protobuf:"bytes,4,opt,name=troops,proto3" json:"troops,omitempty"`
-	Actual               *ResourcesAbs `protobuf:"bytes,5,opt,name=actual,proto3" json:"actual,omitempty"`
+type UnitView struct {
+	Type                 *UnitTypeView `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Id                   uint64        `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	IdType               uint64        `protobuf:"varint,3,opt,name=idType,proto3" json:"idType,omitempty"`
+	Ticks                uint32        `protobuf:"varint,4,opt,name=ticks,proto3" json:"ticks,omitempty"`
+	Health               uint32        `protobuf:"varint,5,opt,name=health,proto3" json:"health,omitempty"`
+	Name                 string        `protobuf:"bytes,6,opt,name=name,proto3" json:"name,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
 	XXX_unrecognized     []byte        `json:"-"`
 	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *ProductionView) Reset()         { *m = ProductionView{} }
-func (m *ProductionView) String() string { return proto.CompactTextString(m) }
-func (*ProductionView) ProtoMessage()    {}
-func (*ProductionView) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6eef30384a8831dd, []int{19}
+func (m *UnitView) Reset()         { *m = UnitView{} }
+func (m *UnitView) String() string { return proto.CompactTextString(m) }
+func (*UnitView) ProtoMessage()    {}
+func (*UnitView) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{22}
 }
 
-func (m *ProductionView) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ProductionView.Unmarshal(m, b)
+func (m *UnitView) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnitView.Unmarshal(m, b)
 }
-func (m *ProductionView) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ProductionView.Marshal(b, m, deterministic)
+func (m *UnitView) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnitView.Marshal(b, m, deterministic)
 }
-func (m *ProductionView) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ProductionView.Merge(m, src)
+func (m *UnitView) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnitView.Merge(m, src)
 }
-func (m *ProductionView) XXX_Size() int {
-	return xxx_messageInfo_ProductionView.Size(m)
+func (m *UnitView) XXX_Size() int {
+	return xxx_messageInfo_UnitView.Size(m)
 }
-func (m *ProductionView) XXX_DiscardUnknown() {
-	xxx_messageInfo_ProductionView.DiscardUnknown(m)
+func (m *UnitView) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnitView.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ProductionView proto.InternalMessageInfo
+var xxx_messageInfo_UnitView proto.InternalMessageInfo
 
-func (m *ProductionView) GetBase() *ResourcesAbs {
+func (m *UnitView) GetType() *UnitTypeView {
 	if m != nil {
-		return m.Base
+		return m.Type
 	}
 	return nil
 }
 
-func (m *ProductionView) GetKnowledge() *ResourcesMod {
+func (m *UnitView) GetId() uint64 {
 	if m != nil {
-		return m.Knowledge
+		return m.Id
 	}
-	return nil
+	return 0
 }
 
-func (m *ProductionView) GetBuildings() *ResourcesMod {
+func (m *UnitView) GetIdType() uint64 {
 	if m != nil {
-		return m.Buildings
+		return m.IdType
 	}
-	return nil
+	return 0
 }
 
-func (m *ProductionView) GetTroops() *ResourcesMod {
+func (m *UnitView) GetTicks() uint32 {
 	if m != nil {
-		return m.Troops
+		return m.Ticks
 	}
-	return nil
+	return 0
 }
 
-func (m *ProductionView) GetActual() *ResourcesAbs {
+func (m *UnitView) GetHealth() uint32 {
 	if m != nil {
-		return m.Actual
+		return m.Health
 	}
-	return nil
+	return 0
 }
 
-type CityEvolution struct {
-	KFrontier            []*KnowledgeTypeView `protobuf:"bytes,1,rep,name=kFrontier,proto3" json:"kFrontier,omitempty"`
-	BFrontier            []*BuildingTypeView  `protobuf:"bytes,2,rep,name=bFrontier,proto3" json:"bFrontier,omitempty"`
-	UFrontier            []*UnitTypeView      `protobuf:"bytes,3,rep,name=uFrontier,proto3" json:"uFrontier,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+func (m *UnitView) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
 }
 
-func (m *CityEvolution) Reset()         { *m = CityEvolution{} }
-func (m *CityEvolution) String() string { return proto.CompactTextString(m) }
-func (*CityEvolution) ProtoMessage()    {}
-func (*CityEvolution) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6eef30384a8831dd, []int{20}
+type BuildingView struct {
+	Type                 *BuildingTypeView `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Id                   uint64            `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	IdType               uint64            `protobuf:"varint,3,opt,name=idType,proto3" json:"idType,omitempty"`
+	Ticks                uint32            `protobuf:"varint,4,opt,name=ticks,proto3" json:"ticks,omitempty"`
+	Name                 string            `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *CityEvolution) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CityEvolution.Unmarshal(m, b)
+func (m *BuildingView) Reset()         { *m = BuildingView{} }
+func (m *BuildingView) String() string { return proto.CompactTextString(m) }
+func (*BuildingView) ProtoMessage()    {}
+func (*BuildingView) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{23}
 }
-func (m *CityEvolution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CityEvolution.Marshal(b, m, deterministic)
+
+func (m *BuildingView) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BuildingView.Unmarshal(m, b)
 }
-func (m *CityEvolution) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CityEvolution.Merge(m, src)
+func (m *BuildingView) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BuildingView.Marshal(b, m, deterministic)
 }
-func (m *CityEvolution) XXX_Size() int {
-	return xxx_messageInfo_CityEvolution.Size(m)
+func (m *BuildingView) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BuildingView.Merge(m, src)
 }
-func (m *CityEvolution) XXX_DiscardUnknown() {
-	xxx_messageInfo_CityEvolution.DiscardUnknown(m)
+func (m *BuildingView) XXX_Size() int {
+	return xxx_messageInfo_BuildingView.Size(m)
+}
+func (m *BuildingView) XXX_DiscardUnknown() {
+	xxx_messageInfo_BuildingView.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CityEvolution proto.InternalMessageInfo
+var xxx_messageInfo_BuildingView proto.InternalMessageInfo
 
-func (m *CityEvolution) GetKFrontier() []*KnowledgeTypeView {
+func (m *BuildingView) GetType() *BuildingTypeView {
 	if m != nil {
-		return m.KFrontier
+		return m.Type
 	}
 	return nil
 }
 
-func (m *CityEvolution) GetBFrontier() []*BuildingTypeView {
+func (m *BuildingView) GetId() uint64 {
 	if m != nil {
-		return m.BFrontier
+		return m.Id
 	}
-	return nil
+	return 0
 }
 
-func (m *CityEvolution) GetUFrontier() []*UnitTypeView {
+func (m *BuildingView) GetIdType() uint64 {
 	if m != nil {
-		return m.UFrontier
+		return m.IdType
 	}
-	return nil
+	return 0
 }
 
-type CityAssets struct {
-	Units                []*UnitView      `protobuf:"bytes,1,rep,name=units,proto3" json:"units,omitempty"`
-	Buildings            []*BuildingView  `protobuf:"bytes,2,rep,name=buildings,proto3" json:"buildings,omitempty"`
-	Knowledges           []*KnowledgeView `protobuf:"bytes,3,rep,name=knowledges,proto3" json:"knowledges,omitempty"`
-	Armies               []*ArmyView      `protobuf:"bytes,4,rep,name=armies,proto3" json:"armies,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+func (m *BuildingView) GetTicks() uint32 {
+	if m != nil {
+		return m.Ticks
+	}
+	return 0
 }
 
-func (m *CityAssets) Reset()         { *m = CityAssets{} }
-func (m *CityAssets) String() string { return proto.CompactTextString(m) }
-func (*CityAssets) ProtoMessage()    {}
-func (*CityAssets) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6eef30384a8831dd, []int{21}
+func (m *BuildingView) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
 }
 
-func (m *CityAssets) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CityAssets.Unmarshal(m, b)
+type KnowledgeView struct {
+	Type                 *KnowledgeTypeView `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Id                   uint64             `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	IdType               uint64             `protobuf:"varint,3,opt,name=idType,proto3" json:"idType,omitempty"`
+	Ticks                uint32             `protobuf:"varint,4,opt,name=ticks,proto3" json:"ticks,omitempty"`
+	Name                 string             `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
-func (m *CityAssets) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CityAssets.Marshal(b, m, deterministic)
+
+func (m *KnowledgeView) Reset()         { *m = KnowledgeView{} }
+func (m *KnowledgeView) String() string { return proto.CompactTextString(m) }
+func (*KnowledgeView) ProtoMessage()    {}
+func (*KnowledgeView) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{24}
 }
-func (m *CityAssets) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CityAssets.Merge(m, src)
+
+func (m *KnowledgeView) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_KnowledgeView.Unmarshal(m, b)
 }
-func (m *CityAssets) XXX_Size() int {
-	return xxx_messageInfo_CityAssets.Size(m)
+func (m *KnowledgeView) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_KnowledgeView.Marshal(b, m, deterministic)
 }
-func (m *CityAssets) XXX_DiscardUnknown() {
-	xxx_messageInfo_CityAssets.DiscardUnknown(m)
+func (m *KnowledgeView) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KnowledgeView.Merge(m, src)
 }
-
-var xxx_messageInfo_CityAssets proto.InternalMessageInfo
-
-func (m *CityAssets) GetUnits() []*UnitView {
-	if m != nil {
-		return m.Units
-	}
-	return nil
+func (m *KnowledgeView) XXX_Size() int {
+	return xxx_messageInfo_KnowledgeView.Size(m)
+}
+func (m *KnowledgeView) XXX_DiscardUnknown() {
+	xxx_messageInfo_KnowledgeView.DiscardUnknown(m)
 }
 
-func (m *CityAssets) GetBuildings() []*BuildingView {
+var xxx_messageInfo_KnowledgeView proto.InternalMessageInfo
+
+func (m *KnowledgeView) GetType() *KnowledgeTypeView {
 	if m != nil {
-		return m.Buildings
+		return m.Type
 	}
 	return nil
 }
 
-func (m *CityAssets) GetKnowledges() []*KnowledgeView {
+func (m *KnowledgeView) GetId() uint64 {
 	if m != nil {
-		return m.Knowledges
+		return m.Id
 	}
-	return nil
+	return 0
 }
 
-func (m *CityAssets) GetArmies() []*ArmyView {
+func (m *KnowledgeView) GetIdType() uint64 {
 	if m != nil {
-		return m.Armies
+		return m.IdType
 	}
-	return nil
-}
-
-type CityPolitics struct {
-	Overlord             uint64   `protobuf:"varint,1,opt,name=overlord,proto3" json:"overlord,omitempty"`
-	Lieges               []uint64 `protobuf:"varint,2,rep,packed,name=lieges,proto3" json:"lieges,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *CityPolitics) Reset()         { *m = CityPolitics{} }
-func (m *CityPolitics) String() string { return proto.CompactTextString(m) }
-func (*CityPolitics) ProtoMessage()    {}
-func (*CityPolitics) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6eef30384a8831dd, []int{22}
-}
-
-func (m *CityPolitics) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CityPolitics.Unmarshal(m, b)
-}
-func (m *CityPolitics) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CityPolitics.Marshal(b, m, deterministic)
-}
-func (m *CityPolitics) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CityPolitics.Merge(m, src)
-}
-func (m *CityPolitics) XXX_Size() int {
-	return xxx_messageInfo_CityPolitics.Size(m)
-}
-func (m *CityPolitics) XXX_DiscardUnknown() {
-	xxx_messageInfo_CityPolitics.DiscardUnknown(m)
+	return 0
 }
 
-var xxx_messageInfo_CityPolitics proto.InternalMessageInfo
-
-func (m *CityPolitics) GetOverlord() uint64 {
+func (m *KnowledgeView) GetTicks() uint32 {
 	if m != nil {
-		return m.Overlord
+		return m.Ticks
 	}
 	return 0
 }
 
-func (m *CityPolitics) GetLieges() []uint64 {
+func (m *KnowledgeView) GetName() string {
 	if m != nil {
-		return m.Lieges
+		return m.Name
 	}
-	return nil
+	return ""
 }
 
-type CityView struct {
-	Id            uint64        `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string        `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Owner         uint64        `protobuf:"varint,3,opt,name=owner,proto3" json:"owner,omitempty"`
-	Deputy        uint64        `protobuf:"varint,4,opt,name=deputy,proto3" json:"deputy,omitempty"`
-	Cult          uint32        `protobuf:"varint,5,opt,name=cult,proto3" json:"cult,omitempty"`
-	Chaotic       uint32        `protobuf:"varint,6,opt,name=chaotic,proto3" json:"chaotic,omitempty"`
-	Alignment     uint32        `protobuf:"varint,7,opt,name=alignment,proto3" json:"alignment,omitempty"`
-	EthnicGroup   uint32        `protobuf:"varint,8,opt,name=ethnicGroup,proto3" json:"ethnicGroup,omitempty"`
-	TickMassacres uint32        `protobuf:"varint,9,opt,name=tickMassacres,proto3" json:"tickMassacres,omitempty"`
-	Auto          bool          `protobuf:"varint,10,opt,name=auto,proto3" json:"auto,omitempty"`
-	Deleted       bool          `protobuf:"varint,11,opt,name=deleted,proto3" json:"deleted,omitempty"`
-	Politics      *CityPolitics `protobuf:"bytes,12,opt,name=politics,proto3" json:"politics,omitempty"`
-	// The resources owned by the City
-	Stock      *StockView      `protobuf:"bytes,13,opt,name=stock,proto3" json:"stock,omitempty"`
-	Production *ProductionView `protobuf:"bytes,14,opt,name=production,proto3" json:"production,omitempty"`
-	// All the things owned by the current city
-	Assets *CityAssets `protobuf:"bytes,16,opt,name=assets,proto3" json:"assets,omitempty"`
-	// All the things that the current may start to own
-	Evol                 *CityEvolution `protobuf:"bytes,15,opt,name=evol,proto3" json:"evol,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+type StockView struct {
+	Base                 *ResourcesAbs `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Knowledge            *ResourcesMod `protobuf:"bytes,2,opt,name=knowledge,proto3" json:"knowledge,omitempty"`
+	Buildings            *ResourcesMod `protobuf:"bytes,3,opt,name=buildings,proto3" json:"buildings,omitempty"`
+	Troops               *ResourcesMod `protobuf:"bytes,4,opt,name=troops,proto3" json:"troops,omitempty"`
+	Actual               *ResourcesAbs `protobuf:"bytes,5,opt,name=actual,proto3" json:"actual,omitempty"`
+	Usage                *ResourcesAbs `protobuf:"bytes,6,opt,name=usage,proto3" json:"usage,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *CityView) Reset()         { *m = CityView{} }
-func (m *CityView) String() string { return proto.CompactTextString(m) }
-func (*CityView) ProtoMessage()    {}
-func (*CityView) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6eef30384a8831dd, []int{23}
+func (m *StockView) Reset()         { *m = StockView{} }
+func (m *StockView) String() string { return proto.CompactTextString(m) }
+func (*StockView) ProtoMessage()    {}
+func (*StockView) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{25}
 }
 
-func (m *CityView) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CityView.Unmarshal(m, b)
+func (m *StockView) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StockView.Unmarshal(m, b)
 }
-func (m *CityView) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CityView.Marshal(b, m, deterministic)
+func (m *StockView) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StockView.Marshal(b, m, deterministic)
 }
-func (m *CityView) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CityView.Merge(m, src)
+func (m *StockView) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StockView.Merge(m, src)
 }
-func (m *CityView) XXX_Size() int {
-	return xxx_messageInfo_CityView.Size(m)
+func (m *StockView) XXX_Size() int {
+	return xxx_messageInfo_StockView.Size(m)
 }
-func (m *CityView) XXX_DiscardUnknown() {
-	xxx_messageInfo_CityView.DiscardUnknown(m)
+func (m *StockView) XXX_DiscardUnknown() {
+	xxx_messageInfo_StockView.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CityView proto.InternalMessageInfo
+var xxx_messageInfo_StockView proto.InternalMessageInfo
 
-func (m *CityView) GetId() uint64 {
+func (m *StockView) GetBase() *ResourcesAbs {
 	if m != nil {
-		return m.Id
+		return m.Base
 	}
-	return 0
+	return nil
 }
 
-func (m *CityView) GetName() string {
+func (m *StockView) GetKnowledge() *ResourcesMod {
 	if m != nil {
-		return m.Name
+		return m.Knowledge
 	}
-	return ""
+	return nil
 }
 
-func (m *CityView) GetOwner() uint64 {
+func (m *StockView) GetBuildings() *ResourcesMod {
 	if m != nil {
-		return m.Owner
+		return m.Buildings
 	}
-	return 0
+	return nil
 }
 
-func (m *CityView) GetDeputy() uint64 {
+func (m *StockView) GetTroops() *ResourcesMod {
 	if m != nil {
-		return m.Deputy
+		return m.Troops
 	}
-	return 0
+	return nil
 }
 
-func (m *CityView) GetCult() uint32 {
+func (m *StockView) GetActual() *ResourcesAbs {
 	if m != nil {
-		return m.Cult
+		return m.Actual
 	}
-	return 0
+	return nil
 }
 
-func (m *CityView) GetChaotic() uint32 {
+func (m *StockView) GetUsage() *ResourcesAbs {
 	if m != nil {
-		return m.Chaotic
+		return m.Usage
 	}
-	return 0
+	return nil
 }
 
-func (m *CityView) GetAlignment() uint32 {
-	if m != nil {
-		return m.Alignment
-	}
-	return 0
+type ProductionView struct {
+	Base                 *ResourcesAbs `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Knowledge            *ResourcesMod `protobuf:"bytes,2,opt,name=knowledge,proto3" json:"knowledge,omitempty"`
+	Buildings            *ResourcesMod `protobuf:"bytes,3,opt,name=buildings,proto3" json:"buildings,omitempty"`
+	Troops               *ResourcesMod `protobuf:"bytes,4,opt,name=troops,proto3" json:"troops,omitempty"`
+	Actual               *ResourcesAbs `protobuf:"bytes,5,opt,name=actual,proto3" json:"actual,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *CityView) GetEthnicGroup() uint32 {
-	if m != nil {
-		return m.EthnicGroup
-	}
-	return 0
+func (m *ProductionView) Reset()         { *m = ProductionView{} }
+func (m *ProductionView) String() string { return proto.CompactTextString(m) }
+func (*ProductionView) ProtoMessage()    {}
+func (*ProductionView) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{26}
 }
 
-func (m *CityView) GetTickMassacres() uint32 {
-	if m != nil {
-		return m.TickMassacres
-	}
-	return 0
+func (m *ProductionView) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProductionView.Unmarshal(m, b)
 }
-
-func (m *CityView) GetAuto() bool {
-	if m != nil {
-		return m.Auto
-	}
-	return false
+func (m *ProductionView) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProductionView.Marshal(b, m, deterministic)
 }
-
-func (m *CityView) GetDeleted() bool {
-	if m != nil {
-		return m.Deleted
-	}
-	return false
+func (m *ProductionView) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProductionView.Merge(m, src)
+}
+func (m *ProductionView) XXX_Size() int {
+	return xxx_messageInfo_ProductionView.Size(m)
+}
+func (m *ProductionView) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProductionView.DiscardUnknown(m)
 }
 
-func (m *CityView) GetPolitics() *CityPolitics {
+var xxx_messageInfo_ProductionView proto.InternalMessageInfo
+
+func (m *ProductionView) GetBase() *ResourcesAbs {
 	if m != nil {
-		return m.Politics
+		return m.Base
 	}
 	return nil
 }
 
-func (m *CityView) GetStock() *StockView {
+func (m *ProductionView) GetKnowledge() *ResourcesMod {
 	if m != nil {
-		return m.Stock
+		return m.Knowledge
 	}
 	return nil
 }
 
-func (m *CityView) GetProduction() *ProductionView {
+func (m *ProductionView) GetBuildings() *ResourcesMod {
 	if m != nil {
-		return m.Production
+		return m.Buildings
 	}
 	return nil
 }
 
-func (m *CityView) GetAssets() *CityAssets {
+func (m *ProductionView) GetTroops() *ResourcesMod {
 	if m != nil {
-		return m.Assets
+		return m.Troops
 	}
 	return nil
 }
 
-func (m *CityView) GetEvol() *CityEvolution {
+func (m *ProductionView) GetActual() *ResourcesAbs {
 	if m != nil {
-		return m.Evol
+		return m.Actual
 	}
 	return nil
 }
 
-type StudyReq struct {
-	Character            uint64   `protobuf:"varint,1,opt,name=character,proto3" json:"character,omitempty"`
-	City                 uint64   `protobuf:"varint,2,opt,name=city,proto3" json:"city,omitempty"`
-	KnowledgeType        uint64   `protobuf:"varint,3,opt,name=knowledgeType,proto3" json:"knowledgeType,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type CityEvolution struct {
+	KFrontier            []*KnowledgeTypeView `protobuf:"bytes,1,rep,name=kFrontier,proto3" json:"kFrontier,omitempty"`
+	BFrontier            []*BuildingTypeView  `protobuf:"bytes,2,rep,name=bFrontier,proto3" json:"bFrontier,omitempty"`
+	UFrontier            []*UnitTypeView      `protobuf:"bytes,3,rep,name=uFrontier,proto3" json:"uFrontier,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
-func (m *StudyReq) Reset()         { *m = StudyReq{} }
-func (m *StudyReq) String() string { return proto.CompactTextString(m) }
-func (*StudyReq) ProtoMessage()    {}
-func (*StudyReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6eef30384a8831dd, []int{24}
+func (m *CityEvolution) Reset()         { *m = CityEvolution{} }
+func (m *CityEvolution) String() string { return proto.CompactTextString(m) }
+func (*CityEvolution) ProtoMessage()    {}
+func (*CityEvolution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{27}
 }
 
-func (m *StudyReq) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_StudyReq.Unmarshal(m, b)
+func (m *CityEvolution) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CityEvolution.Unmarshal(m, b)
 }
-func (m *StudyReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_StudyReq.Marshal(b, m, deterministic)
+func (m *CityEvolution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CityEvolution.Marshal(b, m, deterministic)
 }
-func (m *StudyReq) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StudyReq.Merge(m, src)
+func (m *CityEvolution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CityEvolution.Merge(m, src)
 }
-func (m *StudyReq) XXX_Size() int {
-	return xxx_messageInfo_StudyReq.Size(m)
+func (m *CityEvolution) XXX_Size() int {
+	return xxx_messageInfo_CityEvolution.Size(m)
 }
-func (m *StudyReq) XXX_DiscardUnknown() {
-	xxx_messageInfo_StudyReq.DiscardUnknown(m)
+func (m *CityEvolution) XXX_DiscardUnknown() {
+	xxx_messageInfo_CityEvolution.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_StudyReq proto.InternalMessageInfo
+var xxx_messageInfo_CityEvolution proto.InternalMessageInfo
 
-func (m *StudyReq) GetCharacter() uint64 {
+func (m *CityEvolution) GetKFrontier() []*KnowledgeTypeView {
 	if m != nil {
-		return m.Character
+		return m.KFrontier
 	}
-	return 0
+	return nil
 }
 
-func (m *StudyReq) GetCity() uint64 {
+func (m *CityEvolution) GetBFrontier() []*BuildingTypeView {
 	if m != nil {
-		return m.City
+		return m.BFrontier
 	}
-	return 0
+	return nil
 }
 
-func (m *StudyReq) GetKnowledgeType() uint64 {
+func (m *CityEvolution) GetUFrontier() []*UnitTypeView {
 	if m != nil {
-		return m.KnowledgeType
+		return m.UFrontier
 	}
-	return 0
+	return nil
 }
 
-type TrainReq struct {
-	Character            uint64   `protobuf:"varint,1,opt,name=character,proto3" json:"character,omitempty"`
-	City                 uint64   `protobuf:"varint,2,opt,name=city,proto3" json:"city,omitempty"`
-	UnitType             uint64   `protobuf:"varint,3,opt,name=unitType,proto3" json:"unitType,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type CityAssets struct {
+	Units                []*UnitView      `protobuf:"bytes,1,rep,name=units,proto3" json:"units,omitempty"`
+	Buildings            []*BuildingView  `protobuf:"bytes,2,rep,name=buildings,proto3" json:"buildings,omitempty"`
+	Knowledges           []*KnowledgeView `protobuf:"bytes,3,rep,name=knowledges,proto3" json:"knowledges,omitempty"`
+	Armies               []*ArmyView      `protobuf:"bytes,4,rep,name=armies,proto3" json:"armies,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
-func (m *TrainReq) Reset()         { *m = TrainReq{} }
-func (m *TrainReq) String() string { return proto.CompactTextString(m) }
-func (*TrainReq) ProtoMessage()    {}
-func (*TrainReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6eef30384a8831dd, []int{25}
+func (m *CityAssets) Reset()         { *m = CityAssets{} }
+func (m *CityAssets) String() string { return proto.CompactTextString(m) }
+func (*CityAssets) ProtoMessage()    {}
+func (*CityAssets) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{28}
 }
 
-func (m *TrainReq) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_TrainReq.Unmarshal(m, b)
+func (m *CityAssets) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CityAssets.Unmarshal(m, b)
 }
-func (m *TrainReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_TrainReq.Marshal(b, m, deterministic)
+func (m *CityAssets) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CityAssets.Marshal(b, m, deterministic)
 }
-func (m *TrainReq) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TrainReq.Merge(m, src)
+func (m *CityAssets) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CityAssets.Merge(m, src)
 }
-func (m *TrainReq) XXX_Size() int {
-	return xxx_messageInfo_TrainReq.Size(m)
+func (m *CityAssets) XXX_Size() int {
+	return xxx_messageInfo_CityAssets.Size(m)
 }
-func (m *TrainReq) XXX_DiscardUnknown() {
-	xxx_messageInfo_TrainReq.DiscardUnknown(m)
+func (m *CityAssets) XXX_DiscardUnknown() {
+	xxx_messageInfo_CityAssets.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TrainReq proto.InternalMessageInfo
+var xxx_messageInfo_CityAssets proto.InternalMessageInfo
 
-func (m *TrainReq) GetCharacter() uint64 {
+func (m *CityAssets) GetUnits() []*UnitView {
 	if m != nil {
-		return m.Character
+		return m.Units
 	}
-	return 0
+	return nil
 }
 
-func (m *TrainReq) GetCity() uint64 {
+func (m *CityAssets) GetBuildings() []*BuildingView {
 	if m != nil {
-		return m.City
+		return m.Buildings
 	}
-	return 0
+	return nil
 }
 
-func (m *TrainReq) GetUnitType() uint64 {
+func (m *CityAssets) GetKnowledges() []*KnowledgeView {
 	if m != nil {
-		return m.UnitType
+		return m.Knowledges
 	}
-	return 0
+	return nil
 }
 
-type BuildReq struct {
-	Character            uint64   `protobuf:"varint,1,opt,name=character,proto3" json:"character,omitempty"`
-	City                 uint64   `protobuf:"varint,2,opt,name=city,proto3" json:"city,omitempty"`
-	BuildingType         uint64   `protobuf:"varint,3,opt,name=buildingType,proto3" json:"buildingType,omitempty"`
+func (m *CityAssets) GetArmies() []*ArmyView {
+	if m != nil {
+		return m.Armies
+	}
+	return nil
+}
+
+type CityPolitics struct {
+	Overlord             uint64   `protobuf:"varint,1,opt,name=overlord,proto3" json:"overlord,omitempty"`
+	Lieges               []uint64 `protobuf:"varint,2,rep,packed,name=lieges,proto3" json:"lieges,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *BuildReq) Reset()         { *m = BuildReq{} }
-func (m *BuildReq) String() string { return proto.CompactTextString(m) }
-func (*BuildReq) ProtoMessage()    {}
-func (*BuildReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6eef30384a8831dd, []int{26}
+func (m *CityPolitics) Reset()         { *m = CityPolitics{} }
+func (m *CityPolitics) String() string { return proto.CompactTextString(m) }
+func (*CityPolitics) ProtoMessage()    {}
+func (*CityPolitics) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{29}
 }
 
-func (m *BuildReq) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_BuildReq.Unmarshal(m, b)
+func (m *CityPolitics) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CityPolitics.Unmarshal(m, b)
 }
-func (m *BuildReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_BuildReq.Marshal(b, m, deterministic)
+func (m *CityPolitics) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CityPolitics.Marshal(b, m, deterministic)
 }
-func (m *BuildReq) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BuildReq.Merge(m, src)
+func (m *CityPolitics) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CityPolitics.Merge(m, src)
 }
-func (m *BuildReq) XXX_Size() int {
-	return xxx_messageInfo_BuildReq.Size(m)
+func (m *CityPolitics) XXX_Size() int {
+	return xxx_messageInfo_CityPolitics.Size(m)
 }
-func (m *BuildReq) XXX_DiscardUnknown() {
-	xxx_messageInfo_BuildReq.DiscardUnknown(m)
+func (m *CityPolitics) XXX_DiscardUnknown() {
+	xxx_messageInfo_CityPolitics.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BuildReq proto.InternalMessageInfo
+var xxx_messageInfo_CityPolitics proto.InternalMessageInfo
 
-func (m *BuildReq) GetCharacter() uint64 {
+func (m *CityPolitics) GetOverlord() uint64 {
 	if m != nil {
-		return m.Character
+		return m.Overlord
 	}
 	return 0
 }
 
-func (m *BuildReq) GetCity() uint64 {
+func (m *CityPolitics) GetLieges() []uint64 {
 	if m != nil {
-		return m.City
-	}
-	return 0
-}
-
-func (m *BuildReq) GetBuildingType() uint64 {
-	if m != nil {
-		return m.BuildingType
+		return m.Lieges
 	}
-	return 0
+	return nil
 }
 
-type CreateTransportReq struct {
-	Character            uint64        `protobuf:"varint,1,opt,name=character,proto3" json:"character,omitempty"`
-	City                 uint64        `protobuf:"varint,2,opt,name=city,proto3" json:"city,omitempty"`
-	Name                 string        `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Stock                *ResourcesAbs `protobuf:"bytes,4,opt,name=stock,proto3" json:"stock,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+type CityView struct {
+	Id            uint64        `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string        `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Owner         uint64        `protobuf:"varint,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	Deputy        uint64        `protobuf:"varint,4,opt,name=deputy,proto3" json:"deputy,omitempty"`
+	Cult          uint32        `protobuf:"varint,5,opt,name=cult,proto3" json:"cult,omitempty"`
+	Chaotic       uint32        `protobuf:"varint,6,opt,name=chaotic,proto3" json:"chaotic,omitempty"`
+	Alignment     uint32        `protobuf:"varint,7,opt,name=alignment,proto3" json:"alignment,omitempty"`
+	EthnicGroup   uint32        `protobuf:"varint,8,opt,name=ethnicGroup,proto3" json:"ethnicGroup,omitempty"`
+	TickMassacres uint32        `protobuf:"varint,9,opt,name=tickMassacres,proto3" json:"tickMassacres,omitempty"`
+	Auto          bool          `protobuf:"varint,10,opt,name=auto,proto3" json:"auto,omitempty"`
+	Deleted       bool          `protobuf:"varint,11,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	Politics      *CityPolitics `protobuf:"bytes,12,opt,name=politics,proto3" json:"politics,omitempty"`
+	// The resources owned by the City
+	Stock      *StockView      `protobuf:"bytes,13,opt,name=stock,proto3" json:"stock,omitempty"`
+	Production *ProductionView `protobuf:"bytes,14,opt,name=production,proto3" json:"production,omitempty"`
+	// All the things owned by the current city
+	Assets *CityAssets `protobuf:"bytes,16,opt,name=assets,proto3" json:"assets,omitempty"`
+	// All the things that the current may start to own
+	Evol *CityEvolution `protobuf:"bytes,15,opt,name=evol,proto3" json:"evol,omitempty"`
+	// The Cell the City is built on
+	Cell                 uint64   `protobuf:"varint,17,opt,name=cell,proto3" json:"cell,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CreateTransportReq) Reset()         { *m = CreateTransportReq{} }
-func (m *CreateTransportReq) String() string { return proto.CompactTextString(m) }
-func (*CreateTransportReq) ProtoMessage()    {}
-func (*CreateTransportReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6eef30384a8831dd, []int{27}
+func (m *CityView) Reset()         { *m = CityView{} }
+func (m *CityView) String() string { return proto.CompactTextString(m) }
+func (*CityView) ProtoMessage()    {}
+func (*CityView) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{30}
 }
 
-func (m *CreateTransportReq) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CreateTransportReq.Unmarshal(m, b)
+func (m *CityView) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CityView.Unmarshal(m, b)
 }
-func (m *CreateTransportReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CreateTransportReq.Marshal(b, m, deterministic)
+func (m *CityView) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CityView.Marshal(b, m, deterministic)
 }
-func (m *CreateTransportReq) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateTransportReq.Merge(m, src)
+func (m *CityView) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CityView.Merge(m, src)
 }
-func (m *CreateTransportReq) XXX_Size() int {
-	return xxx_messageInfo_CreateTransportReq.Size(m)
+func (m *CityView) XXX_Size() int {
+	return xxx_messageInfo_CityView.Size(m)
 }
-func (m *CreateTransportReq) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateTransportReq.DiscardUnknown(m)
+func (m *CityView) XXX_DiscardUnknown() {
+	xxx_messageInfo_CityView.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreateTransportReq proto.InternalMessageInfo
+var xxx_messageInfo_CityView proto.InternalMessageInfo
 
-func (m *CreateTransportReq) GetCharacter() uint64 {
+func (m *CityView) GetId() uint64 {
 	if m != nil {
-		return m.Character
+		return m.Id
 	}
 	return 0
 }
 
-func (m *CreateTransportReq) GetCity() uint64 {
+func (m *CityView) GetName() string {
 	if m != nil {
-		return m.City
+		return m.Name
 	}
-	return 0
+	return ""
 }
 
-func (m *CreateTransportReq) GetName() string {
+func (m *CityView) GetOwner() uint64 {
 	if m != nil {
-		return m.Name
+		return m.Owner
 	}
-	return ""
+	return 0
 }
 
-func (m *CreateTransportReq) GetStock() *ResourcesAbs {
+func (m *CityView) GetDeputy() uint64 {
 	if m != nil {
-		return m.Stock
+		return m.Deputy
 	}
-	return nil
+	return 0
 }
 
-type CreateArmyReq struct {
-	Character            uint64   `protobuf:"varint,1,opt,name=character,proto3" json:"character,omitempty"`
-	City                 uint64   `protobuf:"varint,2,opt,name=city,proto3" json:"city,omitempty"`
-	Name                 string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Unit                 []uint64 `protobuf:"varint,4,rep,packed,name=unit,proto3" json:"unit,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *CityView) GetCult() uint32 {
+	if m != nil {
+		return m.Cult
+	}
+	return 0
 }
 
-func (m *CreateArmyReq) Reset()         { *m = CreateArmyReq{} }
-func (m *CreateArmyReq) String() string { return proto.CompactTextString(m) }
-func (*CreateArmyReq) ProtoMessage()    {}
-func (*CreateArmyReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6eef30384a8831dd, []int{28}
+func (m *CityView) GetChaotic() uint32 {
+	if m != nil {
+		return m.Chaotic
+	}
+	return 0
 }
 
-func (m *CreateArmyReq) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CreateArmyReq.Unmarshal(m, b)
+func (m *CityView) GetAlignment() uint32 {
+	if m != nil {
+		return m.Alignment
+	}
+	return 0
 }
-func (m *CreateArmyReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CreateArmyReq.Marshal(b, m, deterministic)
+
+func (m *CityView) GetEthnicGroup() uint32 {
+	if m != nil {
+		return m.EthnicGroup
+	}
+	return 0
 }
-func (m *CreateArmyReq) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateArmyReq.Merge(m, src)
+
+func (m *CityView) GetTickMassacres() uint32 {
+	if m != nil {
+		return m.TickMassacres
+	}
+	return 0
 }
-func (m *CreateArmyReq) XXX_Size() int {
-	return xxx_messageInfo_CreateArmyReq.Size(m)
+
+func (m *CityView) GetAuto() bool {
+	if m != nil {
+		return m.Auto
+	}
+	return false
 }
-func (m *CreateArmyReq) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateArmyReq.DiscardUnknown(m)
+
+func (m *CityView) GetDeleted() bool {
+	if m != nil {
+		return m.Deleted
+	}
+	return false
 }
 
-var xxx_messageInfo_CreateArmyReq proto.InternalMessageInfo
+func (m *CityView) GetPolitics() *CityPolitics {
+	if m != nil {
+		return m.Politics
+	}
+	return nil
+}
 
-func (m *CreateArmyReq) GetCharacter() uint64 {
+func (m *CityView) GetStock() *StockView {
 	if m != nil {
-		return m.Character
+		return m.Stock
 	}
-	return 0
+	return nil
 }
 
-func (m *CreateArmyReq) GetCity() uint64 {
+func (m *CityView) GetProduction() *ProductionView {
 	if m != nil {
-		return m.City
+		return m.Production
 	}
-	return 0
+	return nil
 }
 
-func (m *CreateArmyReq) GetName() string {
+func (m *CityView) GetAssets() *CityAssets {
 	if m != nil {
-		return m.Name
+		return m.Assets
 	}
-	return ""
+	return nil
 }
 
-func (m *CreateArmyReq) GetUnit() []uint64 {
+func (m *CityView) GetEvol() *CityEvolution {
 	if m != nil {
-		return m.Unit
+		return m.Evol
 	}
 	return nil
 }
 
-type TransferUnitReq struct {
+func (m *CityView) GetCell() uint64 {
+	if m != nil {
+		return m.Cell
+	}
+	return 0
+}
+
+type StudyReq struct {
 	Character            uint64   `protobuf:"varint,1,opt,name=character,proto3" json:"character,omitempty"`
 	City                 uint64   `protobuf:"varint,2,opt,name=city,proto3" json:"city,omitempty"`
-	Army                 uint64   `protobuf:"varint,3,opt,name=army,proto3" json:"army,omitempty"`
-	Unit                 []uint64 `protobuf:"varint,4,rep,packed,name=unit,proto3" json:"unit,omitempty"`
+	KnowledgeType        uint64   `protobuf:"varint,3,opt,name=knowledgeType,proto3" json:"knowledgeType,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *TransferUnitReq) Reset()         { *m = TransferUnitReq{} }
-func (m *TransferUnitReq) String() string { return proto.CompactTextString(m) }
-func (*TransferUnitReq) ProtoMessage()    {}
-func (*TransferUnitReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6eef30384a8831dd, []int{29}
+func (m *StudyReq) Reset()         { *m = StudyReq{} }
+func (m *StudyReq) String() string { return proto.CompactTextString(m) }
+func (*StudyReq) ProtoMessage()    {}
+func (*StudyReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{31}
 }
 
-func (m *TransferUnitReq) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_TransferUnitReq.Unmarshal(m, b)
+func (m *StudyReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StudyReq.Unmarshal(m, b)
 }
-func (m *TransferUnitReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_TransferUnitReq.Marshal(b, m, deterministic)
+func (m *StudyReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StudyReq.Marshal(b, m, deterministic)
 }
-func (m *TransferUnitReq) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TransferUnitReq.Merge(m, src)
+func (m *StudyReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StudyReq.Merge(m, src)
 }
-func (m *TransferUnitReq) XXX_Size() int {
-	return xxx_messageInfo_TransferUnitReq.Size(m)
+func (m *StudyReq) XXX_Size() int {
+	return xxx_messageInfo_StudyReq.Size(m)
 }
-func (m *TransferUnitReq) XXX_DiscardUnknown() {
-	xxx_messageInfo_TransferUnitReq.DiscardUnknown(m)
+func (m *StudyReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_StudyReq.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TransferUnitReq proto.InternalMessageInfo
+var xxx_messageInfo_StudyReq proto.InternalMessageInfo
 
-func (m *TransferUnitReq) GetCharacter() uint64 {
+func (m *StudyReq) GetCharacter() uint64 {
 	if m != nil {
 		return m.Character
 	}
 	return 0
 }
 
-func (m *TransferUnitReq) GetCity() uint64 {
+func (m *StudyReq) GetCity() uint64 {
 	if m != nil {
 		return m.City
 	}
 	return 0
 }
 
-func (m *TransferUnitReq) GetArmy() uint64 {
+func (m *StudyReq) GetKnowledgeType() uint64 {
 	if m != nil {
-		return m.Army
+		return m.KnowledgeType
 	}
 	return 0
 }
 
-func (m *TransferUnitReq) GetUnit() []uint64 {
-	if m != nil {
-		return m.Unit
-	}
-	return nil
+type TrainReq struct {
+	Character            uint64   `protobuf:"varint,1,opt,name=character,proto3" json:"character,omitempty"`
+	City                 uint64   `protobuf:"varint,2,opt,name=city,proto3" json:"city,omitempty"`
+	UnitType             uint64   `protobuf:"varint,3,opt,name=unitType,proto3" json:"unitType,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type TransferResourcesReq struct {
-	Character            uint64        `protobuf:"varint,1,opt,name=character,proto3" json:"character,omitempty"`
-	City                 uint64        `protobuf:"varint,2,opt,name=city,proto3" json:"city,omitempty"`
-	Army                 uint64        `protobuf:"varint,3,opt,name=army,proto3" json:"army,omitempty"`
-	Stock                *ResourcesAbs `protobuf:"bytes,4,opt,name=stock,proto3" json:"stock,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
-}
-
-func (m *TransferResourcesReq) Reset()         { *m = TransferResourcesReq{} }
-func (m *TransferResourcesReq) String() string { return proto.CompactTextString(m) }
-func (*TransferResourcesReq) ProtoMessage()    {}
-func (*TransferResourcesReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6eef30384a8831dd, []int{30}
+func (m *TrainReq) Reset()         { *m = TrainReq{} }
+func (m *TrainReq) String() string { return proto.CompactTextString(m) }
+func (*TrainReq) ProtoMessage()    {}
+func (*TrainReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{32}
 }
 
-func (m *TransferResourcesReq) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_TransferResourcesReq.Unmarshal(m, b)
+func (m *TrainReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TrainReq.Unmarshal(m, b)
 }
-func (m *TransferResourcesReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_TransferResourcesReq.Marshal(b, m, deterministic)
+func (m *TrainReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TrainReq.Marshal(b, m, deterministic)
 }
-func (m *TransferResourcesReq) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TransferResourcesReq.Merge(m, src)
+func (m *TrainReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrainReq.Merge(m, src)
 }
-func (m *TransferResourcesReq) XXX_Size() int {
-	return xxx_messageInfo_TransferResourcesReq.Size(m)
+func (m *TrainReq) XXX_Size() int {
+	return xxx_messageInfo_TrainReq.Size(m)
 }
-func (m *TransferResourcesReq) XXX_DiscardUnknown() {
-	xxx_messageInfo_TransferResourcesReq.DiscardUnknown(m)
+func (m *TrainReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrainReq.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TransferResourcesReq proto.InternalMessageInfo
+var xxx_messageInfo_TrainReq proto.InternalMessageInfo
 
-func (m *TransferResourcesReq) GetCharacter() uint64 {
+func (m *TrainReq) GetCharacter() uint64 {
 	if m != nil {
 		return m.Character
 	}
 	return 0
 }
 
-func (m *TransferResourcesReq) GetCity() uint64 {
+func (m *TrainReq) GetCity() uint64 {
 	if m != nil {
 		return m.City
 	}
 	return 0
 }
 
-func (m *TransferResourcesReq) GetArmy() uint64 {
+func (m *TrainReq) GetUnitType() uint64 {
 	if m != nil {
-		return m.Army
+		return m.UnitType
 	}
 	return 0
 }
 
-func (m *TransferResourcesReq) GetStock() *ResourcesAbs {
-	if m != nil {
-		return m.Stock
-	}
-	return nil
-}
-
-type ListReq struct {
+type BuildReq struct {
 	Character            uint64   `protobuf:"varint,1,opt,name=character,proto3" json:"character,omitempty"`
+	City                 uint64   `protobuf:"varint,2,opt,name=city,proto3" json:"city,omitempty"`
+	BuildingType         uint64   `protobuf:"varint,3,opt,name=buildingType,proto3" json:"buildingType,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ListReq) Reset()         { *m = ListReq{} }
-func (m *ListReq) String() string { return proto.CompactTextString(m) }
-func (*ListReq) ProtoMessage()    {}
-func (*ListReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6eef30384a8831dd, []int{31}
+func (m *BuildReq) Reset()         { *m = BuildReq{} }
+func (m *BuildReq) String() string { return proto.CompactTextString(m) }
+func (*BuildReq) ProtoMessage()    {}
+func (*BuildReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{33}
 }
 
-func (m *ListReq) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ListReq.Unmarshal(m, b)
+func (m *BuildReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BuildReq.Unmarshal(m, b)
 }
-func (m *ListReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ListReq.Marshal(b, m, deterministic)
+func (m *BuildReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BuildReq.Marshal(b, m, deterministic)
 }
-func (m *ListReq) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListReq.Merge(m, src)
+func (m *BuildReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BuildReq.Merge(m, src)
 }
-func (m *ListReq) XXX_Size() int {
-	return xxx_messageInfo_ListReq.Size(m)
+func (m *BuildReq) XXX_Size() int {
+	return xxx_messageInfo_BuildReq.Size(m)
 }
-func (m *ListReq) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListReq.DiscardUnknown(m)
+func (m *BuildReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_BuildReq.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListReq proto.InternalMessageInfo
+var xxx_messageInfo_BuildReq proto.InternalMessageInfo
 
-func (m *ListReq) GetCharacter() uint64 {
+func (m *BuildReq) GetCharacter() uint64 {
 	if m != nil {
 		return m.Character
 	}
 	return 0
 }
 
-type ListSetReq struct {
-	Characters           []uint64 `protobuf:"varint,1,rep,packed,name=characters,proto3" json:"characters,omitempty"`
+func (m *BuildReq) GetCity() uint64 {
+	if m != nil {
+		return m.City
+	}
+	return 0
+}
+
+func (m *BuildReq) GetBuildingType() uint64 {
+	if m != nil {
+		return m.BuildingType
+	}
+	return 0
+}
+
+type CreateTransportReq struct {
+	Character uint64        `protobuf:"varint,1,opt,name=character,proto3" json:"character,omitempty"`
+	City      uint64        `protobuf:"varint,2,opt,name=city,proto3" json:"city,omitempty"`
+	Name      string        `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Stock     *ResourcesAbs `protobuf:"bytes,4,opt,name=stock,proto3" json:"stock,omitempty"`
+	// The City the Resources are delivered to
+	Target               uint64   `protobuf:"varint,5,opt,name=target,proto3" json:"target,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ListSetReq) Reset()         { *m = ListSetReq{} }
-func (m *ListSetReq) String() string { return proto.CompactTextString(m) }
-func (*ListSetReq) ProtoMessage()    {}
-func (*ListSetReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6eef30384a8831dd, []int{32}
+func (m *CreateTransportReq) Reset()         { *m = CreateTransportReq{} }
+func (m *CreateTransportReq) String() string { return proto.CompactTextString(m) }
+func (*CreateTransportReq) ProtoMessage()    {}
+func (*CreateTransportReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{34}
 }
 
-func (m *ListSetReq) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ListSetReq.Unmarshal(m, b)
+func (m *CreateTransportReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateTransportReq.Unmarshal(m, b)
 }
-func (m *ListSetReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ListSetReq.Marshal(b, m, deterministic)
+func (m *CreateTransportReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateTransportReq.Marshal(b, m, deterministic)
 }
-func (m *ListSetReq) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListSetReq.Merge(m, src)
+func (m *CreateTransportReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateTransportReq.Merge(m, src)
 }
-func (m *ListSetReq) XXX_Size() int {
-	return xxx_messageInfo_ListSetReq.Size(m)
+func (m *CreateTransportReq) XXX_Size() int {
+	return xxx_messageInfo_CreateTransportReq.Size(m)
 }
-func (m *ListSetReq) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListSetReq.DiscardUnknown(m)
+func (m *CreateTransportReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateTransportReq.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListSetReq proto.InternalMessageInfo
+var xxx_messageInfo_CreateTransportReq proto.InternalMessageInfo
 
-func (m *ListSetReq) GetCharacters() []uint64 {
+func (m *CreateTransportReq) GetCharacter() uint64 {
 	if m != nil {
-		return m.Characters
+		return m.Character
 	}
-	return nil
+	return 0
 }
 
-type None struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *CreateTransportReq) GetCity() uint64 {
+	if m != nil {
+		return m.City
+	}
+	return 0
 }
 
-func (m *None) Reset()         { *m = None{} }
-func (m *None) String() string { return proto.CompactTextString(m) }
-func (*None) ProtoMessage()    {}
-func (*None) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6eef30384a8831dd, []int{33}
+func (m *CreateTransportReq) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
 }
 
-func (m *None) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_None.Unmarshal(m, b)
-}
-func (m *None) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_None.Marshal(b, m, deterministic)
-}
-func (m *None) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_None.Merge(m, src)
-}
-func (m *None) XXX_Size() int {
-	return xxx_messageInfo_None.Size(m)
-}
-func (m *None) XXX_DiscardUnknown() {
-	xxx_messageInfo_None.DiscardUnknown(m)
+func (m *CreateTransportReq) GetStock() *ResourcesAbs {
+	if m != nil {
+		return m.Stock
+	}
+	return nil
 }
 
-var xxx_messageInfo_None proto.InternalMessageInfo
+func (m *CreateTransportReq) GetTarget() uint64 {
+	if m != nil {
+		return m.Target
+	}
+	return 0
+}
 
-type PaginatedQuery struct {
-	Marker               uint64   `protobuf:"varint,1,opt,name=marker,proto3" json:"marker,omitempty"`
-	Max                  uint32   `protobuf:"varint,2,opt,name=max,proto3" json:"max,omitempty"`
+type CreateArmyReq struct {
+	Character            uint64   `protobuf:"varint,1,opt,name=character,proto3" json:"character,omitempty"`
+	City                 uint64   `protobuf:"varint,2,opt,name=city,proto3" json:"city,omitempty"`
+	Name                 string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Unit                 []uint64 `protobuf:"varint,4,rep,packed,name=unit,proto3" json:"unit,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PaginatedQuery) Reset()         { *m = PaginatedQuery{} }
-func (m *PaginatedQuery) String() string { return proto.CompactTextString(m) }
-func (*PaginatedQuery) ProtoMessage()    {}
-func (*PaginatedQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6eef30384a8831dd, []int{34}
+func (m *CreateArmyReq) Reset()         { *m = CreateArmyReq{} }
+func (m *CreateArmyReq) String() string { return proto.CompactTextString(m) }
+func (*CreateArmyReq) ProtoMessage()    {}
+func (*CreateArmyReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{35}
 }
 
-func (m *PaginatedQuery) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PaginatedQuery.Unmarshal(m, b)
+func (m *CreateArmyReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateArmyReq.Unmarshal(m, b)
 }
-func (m *PaginatedQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PaginatedQuery.Marshal(b, m, deterministic)
+func (m *CreateArmyReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateArmyReq.Marshal(b, m, deterministic)
 }
-func (m *PaginatedQuery) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PaginatedQuery.Merge(m, src)
+func (m *CreateArmyReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateArmyReq.Merge(m, src)
 }
-func (m *PaginatedQuery) XXX_Size() int {
-	return xxx_messageInfo_PaginatedQuery.Size(m)
+func (m *CreateArmyReq) XXX_Size() int {
+	return xxx_messageInfo_CreateArmyReq.Size(m)
 }
-func (m *PaginatedQuery) XXX_DiscardUnknown() {
-	xxx_messageInfo_PaginatedQuery.DiscardUnknown(m)
+func (m *CreateArmyReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateArmyReq.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PaginatedQuery proto.InternalMessageInfo
+var xxx_messageInfo_CreateArmyReq proto.InternalMessageInfo
 
-func (m *PaginatedQuery) GetMarker() uint64 {
+func (m *CreateArmyReq) GetCharacter() uint64 {
 	if m != nil {
-		return m.Marker
+		return m.Character
 	}
 	return 0
 }
 
-func (m *PaginatedQuery) GetMax() uint32 {
+func (m *CreateArmyReq) GetCity() uint64 {
 	if m != nil {
-		return m.Max
+		return m.City
 	}
 	return 0
 }
 
-type ListOfUnitTypes struct {
-	Items                []*UnitTypeView `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+func (m *CreateArmyReq) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
 }
 
-func (m *ListOfUnitTypes) Reset()         { *m = ListOfUnitTypes{} }
-func (m *ListOfUnitTypes) String() string { return proto.CompactTextString(m) }
-func (*ListOfUnitTypes) ProtoMessage()    {}
-func (*ListOfUnitTypes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6eef30384a8831dd, []int{35}
+func (m *CreateArmyReq) GetUnit() []uint64 {
+	if m != nil {
+		return m.Unit
+	}
+	return nil
 }
 
-func (m *ListOfUnitTypes) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ListOfUnitTypes.Unmarshal(m, b)
-}
-func (m *ListOfUnitTypes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ListOfUnitTypes.Marshal(b, m, deterministic)
+type TransferUnitReq struct {
+	Character            uint64   `protobuf:"varint,1,opt,name=character,proto3" json:"character,omitempty"`
+	City                 uint64   `protobuf:"varint,2,opt,name=city,proto3" json:"city,omitempty"`
+	Army                 uint64   `protobuf:"varint,3,opt,name=army,proto3" json:"army,omitempty"`
+	Unit                 []uint64 `protobuf:"varint,4,rep,packed,name=unit,proto3" json:"unit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (m *ListOfUnitTypes) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListOfUnitTypes.Merge(m, src)
+
+func (m *TransferUnitReq) Reset()         { *m = TransferUnitReq{} }
+func (m *TransferUnitReq) String() string { return proto.CompactTextString(m) }
+func (*TransferUnitReq) ProtoMessage()    {}
+func (*TransferUnitReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{36}
 }
-func (m *ListOfUnitTypes) XXX_Size() int {
-	return xxx_messageInfo_ListOfUnitTypes.Size(m)
+
+func (m *TransferUnitReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TransferUnitReq.Unmarshal(m, b)
 }
-func (m *ListOfUnitTypes) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListOfUnitTypes.DiscardUnknown(m)
+func (m *TransferUnitReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TransferUnitReq.Marshal(b, m, deterministic)
+}
+func (m *TransferUnitReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferUnitReq.Merge(m, src)
+}
+func (m *TransferUnitReq) XXX_Size() int {
+	return xxx_messageInfo_TransferUnitReq.Size(m)
+}
+func (m *TransferUnitReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferUnitReq.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListOfUnitTypes proto.InternalMessageInfo
+var xxx_messageInfo_TransferUnitReq proto.InternalMessageInfo
 
-func (m *ListOfUnitTypes) GetItems() []*UnitTypeView {
+func (m *TransferUnitReq) GetCharacter() uint64 {
 	if m != nil {
-		return m.Items
+		return m.Character
+	}
+	return 0
+}
+
+func (m *TransferUnitReq) GetCity() uint64 {
+	if m != nil {
+		return m.City
+	}
+	return 0
+}
+
+func (m *TransferUnitReq) GetArmy() uint64 {
+	if m != nil {
+		return m.Army
+	}
+	return 0
+}
+
+func (m *TransferUnitReq) GetUnit() []uint64 {
+	if m != nil {
+		return m.Unit
 	}
 	return nil
 }
 
-type ListOfBuildingTypes struct {
-	Items                []*BuildingTypeView `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+type TransferResourcesReq struct {
+	Character            uint64        `protobuf:"varint,1,opt,name=character,proto3" json:"character,omitempty"`
+	City                 uint64        `protobuf:"varint,2,opt,name=city,proto3" json:"city,omitempty"`
+	Army                 uint64        `protobuf:"varint,3,opt,name=army,proto3" json:"army,omitempty"`
+	Stock                *ResourcesAbs `protobuf:"bytes,4,opt,name=stock,proto3" json:"stock,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *ListOfBuildingTypes) Reset()         { *m = ListOfBuildingTypes{} }
-func (m *ListOfBuildingTypes) String() string { return proto.CompactTextString(m) }
-func (*ListOfBuildingTypes) ProtoMessage()    {}
-func (*ListOfBuildingTypes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6eef30384a8831dd, []int{36}
+func (m *TransferResourcesReq) Reset()         { *m = TransferResourcesReq{} }
+func (m *TransferResourcesReq) String() string { return proto.CompactTextString(m) }
+func (*TransferResourcesReq) ProtoMessage()    {}
+func (*TransferResourcesReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{37}
 }
 
-func (m *ListOfBuildingTypes) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ListOfBuildingTypes.Unmarshal(m, b)
+func (m *TransferResourcesReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TransferResourcesReq.Unmarshal(m, b)
 }
-func (m *ListOfBuildingTypes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ListOfBuildingTypes.Marshal(b, m, deterministic)
+func (m *TransferResourcesReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TransferResourcesReq.Marshal(b, m, deterministic)
 }
-func (m *ListOfBuildingTypes) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListOfBuildingTypes.Merge(m, src)
+func (m *TransferResourcesReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferResourcesReq.Merge(m, src)
 }
-func (m *ListOfBuildingTypes) XXX_Size() int {
-	return xxx_messageInfo_ListOfBuildingTypes.Size(m)
+func (m *TransferResourcesReq) XXX_Size() int {
+	return xxx_messageInfo_TransferResourcesReq.Size(m)
 }
-func (m *ListOfBuildingTypes) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListOfBuildingTypes.DiscardUnknown(m)
+func (m *TransferResourcesReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferResourcesReq.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListOfBuildingTypes proto.InternalMessageInfo
+var xxx_messageInfo_TransferResourcesReq proto.InternalMessageInfo
 
-func (m *ListOfBuildingTypes) GetItems() []*BuildingTypeView {
+func (m *TransferResourcesReq) GetCharacter() uint64 {
 	if m != nil {
-		return m.Items
+		return m.Character
+	}
+	return 0
+}
+
+func (m *TransferResourcesReq) GetCity() uint64 {
+	if m != nil {
+		return m.City
+	}
+	return 0
+}
+
+func (m *TransferResourcesReq) GetArmy() uint64 {
+	if m != nil {
+		return m.Army
+	}
+	return 0
+}
+
+func (m *TransferResourcesReq) GetStock() *ResourcesAbs {
+	if m != nil {
+		return m.Stock
 	}
 	return nil
 }
 
-type ListOfKnowledgeTypes struct {
-	Items                []*KnowledgeTypeView `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+type ListReq struct {
+	Character            uint64   `protobuf:"varint,1,opt,name=character,proto3" json:"character,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ListOfKnowledgeTypes) Reset()         { *m = ListOfKnowledgeTypes{} }
-func (m *ListOfKnowledgeTypes) String() string { return proto.CompactTextString(m) }
-func (*ListOfKnowledgeTypes) ProtoMessage()    {}
-func (*ListOfKnowledgeTypes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6eef30384a8831dd, []int{37}
+func (m *ListReq) Reset()         { *m = ListReq{} }
+func (m *ListReq) String() string { return proto.CompactTextString(m) }
+func (*ListReq) ProtoMessage()    {}
+func (*ListReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{38}
 }
 
-func (m *ListOfKnowledgeTypes) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ListOfKnowledgeTypes.Unmarshal(m, b)
+func (m *ListReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListReq.Unmarshal(m, b)
 }
-func (m *ListOfKnowledgeTypes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ListOfKnowledgeTypes.Marshal(b, m, deterministic)
+func (m *ListReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListReq.Marshal(b, m, deterministic)
 }
-func (m *ListOfKnowledgeTypes) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListOfKnowledgeTypes.Merge(m, src)
+func (m *ListReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListReq.Merge(m, src)
 }
-func (m *ListOfKnowledgeTypes) XXX_Size() int {
-	return xxx_messageInfo_ListOfKnowledgeTypes.Size(m)
+func (m *ListReq) XXX_Size() int {
+	return xxx_messageInfo_ListReq.Size(m)
 }
-func (m *ListOfKnowledgeTypes) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListOfKnowledgeTypes.DiscardUnknown(m)
+func (m *ListReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListReq.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListOfKnowledgeTypes proto.InternalMessageInfo
+var xxx_messageInfo_ListReq proto.InternalMessageInfo
 
-func (m *ListOfKnowledgeTypes) GetItems() []*KnowledgeTypeView {
+func (m *ListReq) GetCharacter() uint64 {
 	if m != nil {
-		return m.Items
+		return m.Character
 	}
-	return nil
+	return 0
 }
 
-func init() {
-	proto.RegisterType((*ScoredCity)(nil), "hegemonie.region.proto.ScoredCity")
-	proto.RegisterType((*ScoreBoard)(nil), "hegemonie.region.proto.ScoreBoard")
-	proto.RegisterType((*NamedItem)(nil), "hegemonie.region.proto.NamedItem")
-	proto.RegisterType((*ListOfNamedItems)(nil), "hegemonie.region.proto.ListOfNamedItems")
-	proto.RegisterType((*ArmyId)(nil), "hegemonie.region.proto.ArmyId")
-	proto.RegisterType((*ArmyView)(nil), "hegemonie.region.proto.ArmyView")
-	proto.RegisterType((*ArmyCommandReq)(nil), "hegemonie.region.proto.ArmyCommandReq")
-	proto.RegisterType((*CityId)(nil), "hegemonie.region.proto.CityId")
-	proto.RegisterType((*ResourcesAbs)(nil), "hegemonie.region.proto.ResourcesAbs")
-	proto.RegisterType((*ResourcesPlus)(nil), "hegemonie.region.proto.ResourcesPlus")
-	proto.RegisterType((*ResourcesMult)(nil), "hegemonie.region.proto.ResourcesMult")
-	proto.RegisterType((*ResourcesMod)(nil), "hegemonie.region.proto.ResourcesMod")
-	proto.RegisterType((*UnitTypeView)(nil), "hegemonie.region.proto.UnitTypeView")
-	proto.RegisterType((*BuildingTypeView)(nil), "hegemonie.region.proto.BuildingTypeView")
-	proto.RegisterType((*KnowledgeTypeView)(nil), "hegemonie.region.proto.KnowledgeTypeView")
-	proto.RegisterType((*UnitView)(nil), "hegemonie.region.proto.UnitView")
-	proto.RegisterType((*BuildingView)(nil), "hegemonie.region.proto.BuildingView")
-	proto.RegisterType((*KnowledgeView)(nil), "hegemonie.region.proto.KnowledgeView")
-	proto.RegisterType((*StockView)(nil), "hegemonie.region.proto.StockView")
-	proto.RegisterType((*ProductionView)(nil), "hegemonie.region.proto.ProductionView")
-	proto.RegisterType((*CityEvolution)(nil), "hegemonie.region.proto.CityEvolution")
-	proto.RegisterType((*CityAssets)(nil), "hegemonie.region.proto.CityAssets")
-	proto.RegisterType((*CityPolitics)(nil), "hegemonie.region.proto.CityPolitics")
-	proto.RegisterType((*CityView)(nil), "hegemonie.region.proto.CityView")
-	proto.RegisterType((*StudyReq)(nil), "hegemonie.region.proto.StudyReq")
-	proto.RegisterType((*TrainReq)(nil), "hegemonie.region.proto.TrainReq")
-	proto.RegisterType((*BuildReq)(nil), "hegemonie.region.proto.BuildReq")
-	proto.RegisterType((*CreateTransportReq)(nil), "hegemonie.region.proto.CreateTransportReq")
-	proto.RegisterType((*CreateArmyReq)(nil), "hegemonie.region.proto.CreateArmyReq")
-	proto.RegisterType((*TransferUnitReq)(nil), "hegemonie.region.proto.TransferUnitReq")
-	proto.RegisterType((*TransferResourcesReq)(nil), "hegemonie.region.proto.TransferResourcesReq")
-	proto.RegisterType((*ListReq)(nil), "hegemonie.region.proto.ListReq")
-	proto.RegisterType((*ListSetReq)(nil), "hegemonie.region.proto.ListSetReq")
-	proto.RegisterType((*None)(nil), "hegemonie.region.proto.None")
-	proto.RegisterType((*PaginatedQuery)(nil), "hegemonie.region.proto.PaginatedQuery")
-	proto.RegisterType((*ListOfUnitTypes)(nil), "hegemonie.region.proto.ListOfUnitTypes")
-	proto.RegisterType((*ListOfBuildingTypes)(nil), "hegemonie.region.proto.ListOfBuildingTypes")
-	proto.RegisterType((*ListOfKnowledgeTypes)(nil), "hegemonie.region.proto.ListOfKnowledgeTypes")
+type ListSetReq struct {
+	Characters           []uint64 `protobuf:"varint,1,rep,packed,name=characters,proto3" json:"characters,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func init() { proto.RegisterFile("region.proto", fileDescriptor_6eef30384a8831dd) }
-
-var fileDescriptor_6eef30384a8831dd = []byte{
-	// 1699 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0xcd, 0x72, 0xdb, 0xb6,
-	0x16, 0x36, 0x25, 0x4a, 0x96, 0x8e, 0x25, 0xdb, 0xc1, 0xf5, 0x64, 0x38, 0x9e, 0x4c, 0xae, 0x2e,
-	0xc6, 0xb9, 0xf1, 0xbd, 0xcd, 0xb8, 0x89, 0xf2, 0x5b, 0x4f, 0xfa, 0x63, 0x27, 0x71, 0xc6, 0x4d,
-	0x9c, 0x38, 0x74, 0xd2, 0x64, 0xd3, 0x4e, 0x69, 0x11, 0x96, 0x30, 0xa6, 0x08, 0x95, 0x04, 0xed,
-	0x6a, 0xdf, 0x2e, 0xfa, 0x02, 0x9d, 0xae, 0x3a, 0x7d, 0x82, 0xee, 0xba, 0x6d, 0xdf, 0xa0, 0x8b,
-	0xae, 0xfa, 0x00, 0x9d, 0xe9, 0x6b, 0x74, 0x00, 0x82, 0x14, 0xa9, 0x84, 0x3f, 0xb6, 0xd3, 0x5d,
-	0x77, 0x3c, 0x10, 0xce, 0xf7, 0x1d, 0x1c, 0x00, 0x1f, 0x0e, 0x20, 0x68, 0x79, 0xa4, 0x4f, 0x99,
-	0xbb, 0x36, 0xf2, 0x18, 0x67, 0xe8, 0xfc, 0x80, 0xf4, 0xc9, 0x90, 0xb9, 0x94, 0xac, 0x25, 0xdb,
-	0xf1, 0x2d, 0x80, 0xbd, 0x1e, 0xf3, 0x88, 0x7d, 0x8f, 0xf2, 0x31, 0x42, 0xa0, 0xf7, 0x28, 0x1f,
-	0x1b, 0x5a, 0x47, 0x5b, 0xd5, 0x4d, 0xf9, 0x8d, 0x96, 0xa0, 0xe6, 0x8b, 0x1e, 0x46, 0xa5, 0xa3,
-	0xad, 0x56, 0xcd, 0xd0, 0xc0, 0x5b, 0xca, 0x6f, 0x93, 0x59, 0x9e, 0x8d, 0xee, 0x40, 0x8d, 0x72,
-	0x32, 0xf4, 0x0d, 0xad, 0x53, 0x5d, 0x9d, 0xeb, 0xe2, 0xb5, 0x37, 0xb3, 0xad, 0x4d, 0xa8, 0xcc,
-	0xd0, 0x01, 0xbf, 0x0b, 0xcd, 0x27, 0xd6, 0x90, 0xd8, 0xdb, 0x9c, 0x0c, 0xd1, 0x3c, 0x54, 0xa8,
-	0xad, 0xc8, 0x2b, 0xd4, 0x16, 0xe1, 0xb8, 0xd6, 0x30, 0x64, 0x6e, 0x9a, 0xf2, 0x1b, 0x3f, 0x82,
-	0xc5, 0xc7, 0xd4, 0xe7, 0x4f, 0x0f, 0x62, 0x37, 0x1f, 0xdd, 0x4e, 0xd3, 0xff, 0x27, 0x8b, 0x3e,
-	0x76, 0x89, 0xd8, 0x9f, 0x40, 0x7d, 0xc3, 0x1b, 0x8e, 0xb7, 0x6d, 0x74, 0x01, 0x9a, 0xbd, 0x81,
-	0xe5, 0x59, 0x3d, 0x4e, 0x3c, 0x15, 0xc1, 0xa4, 0x21, 0xce, 0x4b, 0x25, 0x91, 0x17, 0x04, 0xba,
-	0xe5, 0x0d, 0xc7, 0x46, 0x35, 0x6c, 0x13, 0xdf, 0xf8, 0x67, 0x0d, 0x1a, 0x02, 0xf0, 0x13, 0x4a,
-	0x8e, 0xcb, 0x8c, 0x06, 0x2d, 0x43, 0xc3, 0x61, 0x3d, 0x8b, 0x53, 0xe6, 0x2a, 0xa0, 0xd8, 0x46,
-	0xeb, 0x50, 0xf3, 0x39, 0xeb, 0x1d, 0x1a, 0x7a, 0x47, 0x5b, 0x9d, 0xeb, 0xae, 0x64, 0x8d, 0xca,
-	0x24, 0x3e, 0x0b, 0xbc, 0x1e, 0xf1, 0x37, 0xf6, 0x7d, 0x33, 0x74, 0x41, 0xb7, 0xa0, 0x16, 0xb8,
-	0x94, 0xfb, 0x46, 0x4d, 0x66, 0xa4, 0x93, 0xe5, 0xfb, 0xc2, 0xa5, 0x5c, 0x04, 0x6b, 0x86, 0xdd,
-	0xf1, 0x08, 0xe6, 0x45, 0xfc, 0xf7, 0xd8, 0x70, 0x68, 0xb9, 0xb6, 0x49, 0xbe, 0x40, 0x6b, 0xf1,
-	0x28, 0xe6, 0xba, 0x17, 0xb3, 0x60, 0xc2, 0x24, 0xca, 0x51, 0x9e, 0x87, 0x3a, 0xb7, 0xbc, 0x3e,
-	0xe1, 0x2a, 0x59, 0xca, 0x12, 0xed, 0x56, 0x2f, 0x31, 0x4e, 0x65, 0xe1, 0x75, 0xa8, 0x8b, 0xf5,
-	0x70, 0x9a, 0x29, 0xc0, 0x2e, 0xb4, 0x92, 0x83, 0x17, 0x19, 0xf7, 0xae, 0x46, 0x19, 0xf7, 0xae,
-	0x4a, 0xfb, 0x9a, 0xf2, 0xa8, 0x78, 0xd7, 0xa4, 0xdd, 0x55, 0xfc, 0x15, 0xaf, 0x2b, 0xed, 0xeb,
-	0x32, 0xbd, 0xc2, 0xbe, 0x2e, 0xed, 0x1b, 0x46, 0x4d, 0xd9, 0x37, 0xa4, 0x7d, 0xd3, 0xa8, 0x2b,
-	0xfb, 0x26, 0x66, 0xd0, 0x8e, 0xf9, 0x76, 0x9d, 0x20, 0x49, 0x58, 0x9d, 0x22, 0xac, 0x4e, 0x11,
-	0x56, 0xa7, 0x08, 0xab, 0x53, 0x84, 0xd5, 0x29, 0xc2, 0xea, 0x6b, 0x84, 0x3b, 0x81, 0xc3, 0x13,
-	0x84, 0xda, 0x14, 0xa1, 0x36, 0x45, 0xa8, 0x4d, 0x11, 0x6a, 0x53, 0x84, 0xda, 0x14, 0xa1, 0x26,
-	0x09, 0xbf, 0xd2, 0x12, 0x29, 0xdd, 0x61, 0x36, 0x7a, 0x0f, 0xf4, 0x91, 0x13, 0xf8, 0x6a, 0x01,
-	0x5c, 0x2a, 0x5c, 0x83, 0x22, 0x2d, 0xa6, 0x74, 0x11, 0xae, 0xc3, 0xc0, 0x09, 0xd7, 0x41, 0x19,
-	0x57, 0x31, 0x40, 0x53, 0xba, 0xe0, 0x2e, 0xb4, 0xc4, 0xca, 0x7c, 0x3e, 0x1e, 0x91, 0xb2, 0x5b,
-	0x09, 0xdf, 0x82, 0xc5, 0xcd, 0x80, 0x3a, 0x36, 0x75, 0xfb, 0x27, 0xf2, 0xbb, 0x0d, 0xe7, 0x1e,
-	0xb9, 0xec, 0xd8, 0x21, 0x76, 0x9f, 0x9c, 0xc8, 0xf1, 0x47, 0x0d, 0x1a, 0xd1, 0xfe, 0x41, 0x77,
-	0x40, 0xe7, 0xe3, 0x11, 0x51, 0x79, 0x5a, 0xc9, 0xdb, 0x6f, 0x11, 0x89, 0x29, 0x3d, 0x14, 0x55,
-	0x25, 0xa6, 0x3a, 0x0f, 0x75, 0x6a, 0x8b, 0x3e, 0xd1, 0x46, 0x09, 0x2d, 0xa1, 0xc3, 0x9c, 0xf6,
-	0x0e, 0x7d, 0x39, 0x9b, 0x6d, 0x33, 0x34, 0x44, 0xef, 0x01, 0xb1, 0x1c, 0x3e, 0x90, 0x93, 0xda,
-	0x36, 0x95, 0x15, 0x07, 0x5c, 0x4f, 0x04, 0xfc, 0xbd, 0x06, 0xad, 0x28, 0x45, 0x32, 0xe8, 0xbb,
-	0xa9, 0xa0, 0x57, 0xb3, 0x82, 0x9e, 0x4e, 0xeb, 0x5b, 0x09, 0x3c, 0x0a, 0xb0, 0x96, 0x08, 0xf0,
-	0x07, 0x0d, 0xda, 0xf1, 0x5c, 0xc8, 0x08, 0xdf, 0x4f, 0x45, 0xf8, 0xbf, 0xac, 0x08, 0x5f, 0x9b,
-	0xc0, 0xbf, 0x2d, 0xc4, 0xaf, 0xab, 0xd0, 0xdc, 0x13, 0x12, 0x1b, 0xcd, 0xfa, 0xbe, 0xe5, 0x17,
-	0xce, 0x7a, 0x4a, 0xa1, 0xa5, 0x07, 0xda, 0x84, 0xe6, 0x61, 0x14, 0xb4, 0xda, 0x21, 0xc5, 0xee,
-	0x3b, 0xcc, 0x36, 0x27, 0x6e, 0x02, 0x63, 0x5f, 0x4d, 0x8d, 0x2f, 0x07, 0x54, 0x1a, 0x23, 0x76,
-	0x43, 0x77, 0xa1, 0xce, 0x3d, 0xc6, 0x46, 0x7e, 0xe9, 0x53, 0x46, 0x00, 0x28, 0x1f, 0xe1, 0x6d,
-	0xf5, 0x78, 0x60, 0x39, 0x32, 0x47, 0x65, 0x33, 0xa0, 0x7c, 0xc4, 0x01, 0x17, 0xf8, 0x56, 0x3f,
-	0x5c, 0xa4, 0xa5, 0x0f, 0x38, 0xe9, 0x82, 0x7f, 0xad, 0xc0, 0xfc, 0xae, 0xc7, 0xec, 0x40, 0x9e,
-	0x22, 0xff, 0x4c, 0xc6, 0x59, 0x27, 0x03, 0xff, 0xa1, 0x41, 0x5b, 0x1c, 0xc4, 0x0f, 0x8e, 0x98,
-	0x13, 0xc8, 0xfa, 0xe3, 0x21, 0x34, 0x0f, 0xb7, 0x3c, 0xe6, 0x72, 0x2a, 0xcf, 0xe3, 0xea, 0xc9,
-	0x36, 0xe0, 0xc4, 0x17, 0x6d, 0x41, 0x73, 0x3f, 0x06, 0xaa, 0x48, 0xa0, 0xf2, 0x5a, 0x33, 0x71,
-	0x15, 0x29, 0x0e, 0x62, 0x9c, 0xaa, 0xc4, 0x29, 0x27, 0xb4, 0x13, 0x37, 0xfc, 0x4d, 0x05, 0x40,
-	0x0c, 0x73, 0xc3, 0xf7, 0x09, 0xf7, 0x27, 0x75, 0x92, 0x76, 0xa2, 0x3a, 0x29, 0x3d, 0xdb, 0x95,
-	0xfc, 0x50, 0x92, 0x92, 0x9b, 0x9c, 0xed, 0x07, 0x00, 0xf1, 0xf2, 0xf1, 0xd5, 0x78, 0x2e, 0x15,
-	0x26, 0x58, 0xa2, 0x24, 0x1c, 0xd1, 0x1d, 0xa8, 0x5b, 0xde, 0x90, 0x12, 0xb1, 0x68, 0x72, 0xc7,
-	0x10, 0x15, 0xa6, 0xa6, 0xea, 0x8f, 0x37, 0xa1, 0x25, 0x52, 0xb1, 0xcb, 0x1c, 0xca, 0x69, 0xcf,
-	0x17, 0xc5, 0x28, 0x3b, 0x22, 0x9e, 0xc3, 0xbc, 0xe8, 0xe8, 0x8b, 0x6d, 0xa1, 0x9c, 0x0e, 0x25,
-	0x22, 0x50, 0x31, 0x5a, 0xdd, 0x54, 0x16, 0xfe, 0x5d, 0x87, 0x86, 0x00, 0x29, 0x5d, 0xf1, 0x2e,
-	0x41, 0x8d, 0x1d, 0xbb, 0x72, 0x02, 0x45, 0xb7, 0xd0, 0x10, 0xf0, 0x36, 0x19, 0x05, 0x7c, 0xac,
-	0xaa, 0x31, 0x65, 0xc9, 0xaa, 0x4f, 0xd4, 0x10, 0xe1, 0xe1, 0x26, 0xbf, 0x91, 0x01, 0xb3, 0xbd,
-	0x81, 0xc5, 0x38, 0xed, 0x49, 0xe1, 0x68, 0x9b, 0x91, 0x29, 0x2a, 0x48, 0xcb, 0xa1, 0x7d, 0x77,
-	0x48, 0x5c, 0x6e, 0xcc, 0xca, 0xdf, 0x26, 0x0d, 0xa8, 0x03, 0x73, 0x84, 0x0f, 0x5c, 0xda, 0x7b,
-	0xe8, 0xb1, 0x60, 0x64, 0x34, 0xe4, 0xef, 0xc9, 0x26, 0xb4, 0x02, 0x6d, 0xa1, 0xfc, 0x3b, 0x96,
-	0xef, 0x5b, 0x3d, 0x8f, 0xf8, 0x46, 0x53, 0xf6, 0x49, 0x37, 0xca, 0xc2, 0x3f, 0xe0, 0xcc, 0x80,
-	0x8e, 0xb6, 0xda, 0x30, 0xe5, 0xb7, 0x88, 0xc9, 0x26, 0x0e, 0xe1, 0xc4, 0x36, 0xe6, 0x64, 0x73,
-	0x64, 0xa2, 0x8f, 0xa0, 0x31, 0x52, 0x09, 0x36, 0x5a, 0xf9, 0xfb, 0x32, 0x39, 0x19, 0x66, 0xec,
-	0x25, 0x6e, 0x37, 0xe1, 0x3d, 0xa0, 0x2d, 0xdd, 0x33, 0x6f, 0x37, 0xf1, 0xb1, 0x14, 0x5d, 0x02,
-	0xb6, 0x00, 0x46, 0xb1, 0x44, 0x1a, 0xf3, 0xd2, 0xfb, 0xbf, 0x59, 0xde, 0x69, 0x31, 0x35, 0x13,
-	0x9e, 0x68, 0x1d, 0xea, 0x96, 0xdc, 0x2e, 0xc6, 0xa2, 0xc4, 0xc0, 0x79, 0x03, 0x08, 0x37, 0x96,
-	0xa9, 0x3c, 0x44, 0x11, 0x48, 0x8e, 0x98, 0x63, 0x2c, 0xe4, 0x17, 0x81, 0x29, 0xe5, 0x31, 0xa5,
-	0x0b, 0xde, 0x87, 0xc6, 0x1e, 0x0f, 0xec, 0xb1, 0xb8, 0x85, 0x9c, 0xfc, 0x7a, 0xb6, 0x02, 0xed,
-	0xc3, 0xa4, 0x28, 0xa9, 0xf5, 0x96, 0x6e, 0xc4, 0xaf, 0xa0, 0xf1, 0xdc, 0xb3, 0xa8, 0x7b, 0x3a,
-	0x8e, 0x65, 0x68, 0x04, 0x4a, 0x67, 0xa2, 0xdb, 0x5b, 0x64, 0xe3, 0xcf, 0xa1, 0x21, 0x37, 0xfe,
-	0xe9, 0x90, 0x31, 0xb4, 0xf6, 0x13, 0x4a, 0xa8, 0xd0, 0x53, 0x6d, 0xf8, 0x5b, 0x0d, 0xd0, 0x3d,
-	0x8f, 0x58, 0x9c, 0x3c, 0xf7, 0x2c, 0xd7, 0x1f, 0x31, 0x8f, 0x9f, 0x8e, 0x2c, 0xda, 0xa6, 0xd5,
-	0xc4, 0x36, 0x3d, 0xc3, 0xe5, 0x13, 0x53, 0x68, 0x87, 0x71, 0x09, 0xc5, 0x79, 0x7b, 0x21, 0x21,
-	0xd0, 0x45, 0x76, 0xa5, 0xcc, 0xe9, 0xa6, 0xfc, 0xc6, 0x87, 0xb0, 0x20, 0x07, 0x7f, 0x40, 0x3c,
-	0x21, 0xd1, 0xa7, 0x26, 0x9b, 0xbe, 0xc9, 0xbf, 0x91, 0xec, 0x3b, 0x0d, 0x96, 0x22, 0xb6, 0x78,
-	0xdc, 0x6f, 0x8f, 0xf2, 0x2c, 0x29, 0xbf, 0x0c, 0xb3, 0x8f, 0xa9, 0x5f, 0x3c, 0x7e, 0x7c, 0x05,
-	0x40, 0x74, 0xdc, 0x23, 0xb2, 0xef, 0x45, 0x80, 0xf8, 0xa7, 0xf0, 0x0c, 0xd4, 0xcd, 0x44, 0x0b,
-	0xae, 0x83, 0xfe, 0x84, 0xb9, 0x04, 0xaf, 0xc3, 0xfc, 0xae, 0xd5, 0xa7, 0xae, 0xc5, 0x89, 0xfd,
-	0x2c, 0x20, 0xde, 0x58, 0x08, 0xf6, 0xd0, 0xf2, 0x0e, 0x63, 0x0a, 0x65, 0xa1, 0x45, 0xa8, 0x0e,
-	0xad, 0x2f, 0xe5, 0x58, 0xdb, 0xa6, 0xf8, 0xc4, 0x3b, 0xb0, 0x10, 0x3e, 0xd8, 0x44, 0x47, 0xb2,
-	0x2f, 0x46, 0x9a, 0x7c, 0xaf, 0x29, 0x77, 0x88, 0xab, 0x27, 0x9b, 0x17, 0xf0, 0xaf, 0x10, 0x2e,
-	0x59, 0x29, 0xf8, 0xe8, 0x83, 0x34, 0x64, 0xf9, 0xfa, 0x42, 0xc1, 0xbe, 0x84, 0xa5, 0x10, 0x36,
-	0x55, 0xc9, 0xf8, 0xe8, 0xc3, 0x34, 0xee, 0x09, 0x0a, 0xa0, 0xd0, 0xaf, 0xfb, 0x5b, 0x1d, 0x74,
-	0xf9, 0xb6, 0xb6, 0x07, 0xba, 0x60, 0x40, 0xff, 0xce, 0x82, 0x50, 0x13, 0xb8, 0xbc, 0x9a, 0xd7,
-	0x21, 0xf9, 0xee, 0x85, 0x67, 0xd0, 0xc7, 0xa0, 0xef, 0x0d, 0xd8, 0x31, 0xba, 0x98, 0x27, 0xac,
-	0xdb, 0xf6, 0x72, 0x27, 0xef, 0x77, 0x11, 0x2e, 0x9e, 0x41, 0xdb, 0x50, 0x93, 0x7a, 0x8b, 0x3a,
-	0xd9, 0x27, 0x4c, 0x28, 0xc7, 0xcb, 0x17, 0x32, 0x5f, 0xd8, 0xc4, 0x6a, 0x91, 0x50, 0x32, 0xd1,
-	0xd9, 0x50, 0x91, 0x36, 0x96, 0x81, 0x92, 0x0a, 0x9d, 0x0d, 0x15, 0x09, 0x78, 0x21, 0xd4, 0x1e,
-	0xc0, 0x44, 0x97, 0x50, 0xf6, 0x59, 0x94, 0xd4, 0xae, 0x42, 0xd0, 0x4f, 0x61, 0x61, 0x4a, 0x84,
-	0xd1, 0xff, 0xf3, 0x91, 0x93, 0x6a, 0x5d, 0x08, 0xff, 0x12, 0x5a, 0x49, 0x81, 0x43, 0x97, 0x73,
-	0xb2, 0x90, 0x94, 0xc1, 0x42, 0x60, 0x0b, 0xce, 0xbd, 0xa6, 0x65, 0xe8, 0x4a, 0x11, 0x7a, 0x52,
-	0xf6, 0x0a, 0x29, 0x5e, 0x85, 0x5a, 0xb3, 0x21, 0xab, 0xcd, 0xc2, 0x25, 0x7a, 0x82, 0x65, 0xdf,
-	0xfd, 0xa5, 0x02, 0x73, 0xf7, 0xc9, 0x01, 0x75, 0xa9, 0xa8, 0x17, 0x7c, 0xf4, 0x19, 0x34, 0x45,
-	0xaf, 0x17, 0xb2, 0x36, 0xcf, 0x2e, 0x71, 0x52, 0x12, 0xb6, 0x7c, 0x39, 0x9f, 0x30, 0x96, 0x2b,
-	0x3c, 0x83, 0x0e, 0xa0, 0x2d, 0x1a, 0x37, 0xe3, 0xda, 0xbd, 0x2c, 0xc7, 0x3b, 0xf9, 0x1c, 0x29,
-	0x0d, 0xc3, 0x33, 0x68, 0x00, 0xf3, 0xe2, 0x87, 0x47, 0x93, 0xea, 0xbe, 0x2c, 0xd1, 0x95, 0x7c,
-	0xa2, 0xb4, 0xaa, 0xe1, 0x99, 0xee, 0x9f, 0x1a, 0xd4, 0x36, 0xec, 0x21, 0x15, 0xd7, 0xbc, 0xd9,
-	0xb0, 0xf6, 0x23, 0x28, 0x77, 0x42, 0x0b, 0xa7, 0xfb, 0x3e, 0xe8, 0x3b, 0xec, 0xe8, 0xac, 0x28,
-	0x4f, 0xa1, 0xf9, 0x90, 0x70, 0xf9, 0x47, 0x81, 0x5f, 0x00, 0x95, 0xff, 0x37, 0x83, 0xfc, 0x67,
-	0x02, 0xcf, 0x74, 0x7f, 0xaa, 0x80, 0x2e, 0x37, 0x7c, 0xa1, 0x56, 0x86, 0xaf, 0xd8, 0xcb, 0x85,
-	0x17, 0x28, 0x3c, 0x83, 0xb6, 0x40, 0xdf, 0x72, 0x88, 0x55, 0x88, 0x55, 0x34, 0x5a, 0x89, 0x43,
-	0x47, 0x67, 0xc6, 0x79, 0x06, 0xb3, 0xea, 0xcd, 0x3e, 0x7b, 0xc5, 0xa4, 0x1f, 0xf6, 0x8b, 0x20,
-	0xf7, 0xeb, 0xd2, 0xba, 0xfe, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff, 0x62, 0x9d, 0xcd, 0xc9, 0x48,
-	0x1a, 0x00, 0x00,
+func (m *ListSetReq) Reset()         { *m = ListSetReq{} }
+func (m *ListSetReq) String() string { return proto.CompactTextString(m) }
+func (*ListSetReq) ProtoMessage()    {}
+func (*ListSetReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{39}
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *ListSetReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListSetReq.Unmarshal(m, b)
+}
+func (m *ListSetReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListSetReq.Marshal(b, m, deterministic)
+}
+func (m *ListSetReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSetReq.Merge(m, src)
+}
+func (m *ListSetReq) XXX_Size() int {
+	return xxx_messageInfo_ListSetReq.Size(m)
+}
+func (m *ListSetReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSetReq.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_ListSetReq proto.InternalMessageInfo
 
-// CityClient is the client API for City service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+func (m *ListSetReq) GetCharacters() []uint64 {
+	if m != nil {
+		return m.Characters
+	}
+	return nil
+}
+
+type None struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *None) Reset()         { *m = None{} }
+func (m *None) String() string { return proto.CompactTextString(m) }
+func (*None) ProtoMessage()    {}
+func (*None) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{40}
+}
+
+func (m *None) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_None.Unmarshal(m, b)
+}
+func (m *None) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_None.Marshal(b, m, deterministic)
+}
+func (m *None) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_None.Merge(m, src)
+}
+func (m *None) XXX_Size() int {
+	return xxx_messageInfo_None.Size(m)
+}
+func (m *None) XXX_DiscardUnknown() {
+	xxx_messageInfo_None.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_None proto.InternalMessageInfo
+
+type PaginatedQuery struct {
+	Marker               uint64   `protobuf:"varint,1,opt,name=marker,proto3" json:"marker,omitempty"`
+	Max                  uint32   `protobuf:"varint,2,opt,name=max,proto3" json:"max,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PaginatedQuery) Reset()         { *m = PaginatedQuery{} }
+func (m *PaginatedQuery) String() string { return proto.CompactTextString(m) }
+func (*PaginatedQuery) ProtoMessage()    {}
+func (*PaginatedQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{41}
+}
+
+func (m *PaginatedQuery) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PaginatedQuery.Unmarshal(m, b)
+}
+func (m *PaginatedQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PaginatedQuery.Marshal(b, m, deterministic)
+}
+func (m *PaginatedQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaginatedQuery.Merge(m, src)
+}
+func (m *PaginatedQuery) XXX_Size() int {
+	return xxx_messageInfo_PaginatedQuery.Size(m)
+}
+func (m *PaginatedQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaginatedQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaginatedQuery proto.InternalMessageInfo
+
+func (m *PaginatedQuery) GetMarker() uint64 {
+	if m != nil {
+		return m.Marker
+	}
+	return 0
+}
+
+func (m *PaginatedQuery) GetMax() uint32 {
+	if m != nil {
+		return m.Max
+	}
+	return 0
+}
+
+type ListOfUnitTypes struct {
+	Items                []*UnitTypeView `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ListOfUnitTypes) Reset()         { *m = ListOfUnitTypes{} }
+func (m *ListOfUnitTypes) String() string { return proto.CompactTextString(m) }
+func (*ListOfUnitTypes) ProtoMessage()    {}
+func (*ListOfUnitTypes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{42}
+}
+
+func (m *ListOfUnitTypes) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListOfUnitTypes.Unmarshal(m, b)
+}
+func (m *ListOfUnitTypes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListOfUnitTypes.Marshal(b, m, deterministic)
+}
+func (m *ListOfUnitTypes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListOfUnitTypes.Merge(m, src)
+}
+func (m *ListOfUnitTypes) XXX_Size() int {
+	return xxx_messageInfo_ListOfUnitTypes.Size(m)
+}
+func (m *ListOfUnitTypes) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListOfUnitTypes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListOfUnitTypes proto.InternalMessageInfo
+
+func (m *ListOfUnitTypes) GetItems() []*UnitTypeView {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type ListOfBuildingTypes struct {
+	Items                []*BuildingTypeView `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *ListOfBuildingTypes) Reset()         { *m = ListOfBuildingTypes{} }
+func (m *ListOfBuildingTypes) String() string { return proto.CompactTextString(m) }
+func (*ListOfBuildingTypes) ProtoMessage()    {}
+func (*ListOfBuildingTypes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{43}
+}
+
+func (m *ListOfBuildingTypes) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListOfBuildingTypes.Unmarshal(m, b)
+}
+func (m *ListOfBuildingTypes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListOfBuildingTypes.Marshal(b, m, deterministic)
+}
+func (m *ListOfBuildingTypes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListOfBuildingTypes.Merge(m, src)
+}
+func (m *ListOfBuildingTypes) XXX_Size() int {
+	return xxx_messageInfo_ListOfBuildingTypes.Size(m)
+}
+func (m *ListOfBuildingTypes) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListOfBuildingTypes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListOfBuildingTypes proto.InternalMessageInfo
+
+func (m *ListOfBuildingTypes) GetItems() []*BuildingTypeView {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type ListOfKnowledgeTypes struct {
+	Items                []*KnowledgeTypeView `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ListOfKnowledgeTypes) Reset()         { *m = ListOfKnowledgeTypes{} }
+func (m *ListOfKnowledgeTypes) String() string { return proto.CompactTextString(m) }
+func (*ListOfKnowledgeTypes) ProtoMessage()    {}
+func (*ListOfKnowledgeTypes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{44}
+}
+
+func (m *ListOfKnowledgeTypes) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListOfKnowledgeTypes.Unmarshal(m, b)
+}
+func (m *ListOfKnowledgeTypes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListOfKnowledgeTypes.Marshal(b, m, deterministic)
+}
+func (m *ListOfKnowledgeTypes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListOfKnowledgeTypes.Merge(m, src)
+}
+func (m *ListOfKnowledgeTypes) XXX_Size() int {
+	return xxx_messageInfo_ListOfKnowledgeTypes.Size(m)
+}
+func (m *ListOfKnowledgeTypes) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListOfKnowledgeTypes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListOfKnowledgeTypes proto.InternalMessageInfo
+
+func (m *ListOfKnowledgeTypes) GetItems() []*KnowledgeTypeView {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+// Identifies a transfer by the Region the Army leaves and its ID there
+type HandoffId struct {
+	Region               string   `protobuf:"bytes,1,opt,name=region,proto3" json:"region,omitempty"`
+	Id                   uint64   `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HandoffId) Reset()         { *m = HandoffId{} }
+func (m *HandoffId) String() string { return proto.CompactTextString(m) }
+func (*HandoffId) ProtoMessage()    {}
+func (*HandoffId) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{45}
+}
+
+func (m *HandoffId) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HandoffId.Unmarshal(m, b)
+}
+func (m *HandoffId) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HandoffId.Marshal(b, m, deterministic)
+}
+func (m *HandoffId) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HandoffId.Merge(m, src)
+}
+func (m *HandoffId) XXX_Size() int {
+	return xxx_messageInfo_HandoffId.Size(m)
+}
+func (m *HandoffId) XXX_DiscardUnknown() {
+	xxx_messageInfo_HandoffId.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HandoffId proto.InternalMessageInfo
+
+func (m *HandoffId) GetRegion() string {
+	if m != nil {
+		return m.Region
+	}
+	return ""
+}
+
+func (m *HandoffId) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type HandoffUnit struct {
+	IdType               uint64   `protobuf:"varint,1,opt,name=idType,proto3" json:"idType,omitempty"`
+	Ticks                uint32   `protobuf:"varint,2,opt,name=ticks,proto3" json:"ticks,omitempty"`
+	Health               uint32   `protobuf:"varint,3,opt,name=health,proto3" json:"health,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HandoffUnit) Reset()         { *m = HandoffUnit{} }
+func (m *HandoffUnit) String() string { return proto.CompactTextString(m) }
+func (*HandoffUnit) ProtoMessage()    {}
+func (*HandoffUnit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{46}
+}
+
+func (m *HandoffUnit) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HandoffUnit.Unmarshal(m, b)
+}
+func (m *HandoffUnit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HandoffUnit.Marshal(b, m, deterministic)
+}
+func (m *HandoffUnit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HandoffUnit.Merge(m, src)
+}
+func (m *HandoffUnit) XXX_Size() int {
+	return xxx_messageInfo_HandoffUnit.Size(m)
+}
+func (m *HandoffUnit) XXX_DiscardUnknown() {
+	xxx_messageInfo_HandoffUnit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HandoffUnit proto.InternalMessageInfo
+
+func (m *HandoffUnit) GetIdType() uint64 {
+	if m != nil {
+		return m.IdType
+	}
+	return 0
+}
+
+func (m *HandoffUnit) GetTicks() uint32 {
+	if m != nil {
+		return m.Ticks
+	}
+	return 0
+}
+
+func (m *HandoffUnit) GetHealth() uint32 {
+	if m != nil {
+		return m.Health
+	}
+	return 0
+}
+
+type HandoffCommand struct {
+	// The Region of the Cell, always set
+	Region               string   `protobuf:"bytes,1,opt,name=region,proto3" json:"region,omitempty"`
+	Cell                 uint64   `protobuf:"varint,2,opt,name=cell,proto3" json:"cell,omitempty"`
+	Action               uint64   `protobuf:"varint,3,opt,name=action,proto3" json:"action,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HandoffCommand) Reset()         { *m = HandoffCommand{} }
+func (m *HandoffCommand) String() string { return proto.CompactTextString(m) }
+func (*HandoffCommand) ProtoMessage()    {}
+func (*HandoffCommand) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{47}
+}
+
+func (m *HandoffCommand) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HandoffCommand.Unmarshal(m, b)
+}
+func (m *HandoffCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HandoffCommand.Marshal(b, m, deterministic)
+}
+func (m *HandoffCommand) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HandoffCommand.Merge(m, src)
+}
+func (m *HandoffCommand) XXX_Size() int {
+	return xxx_messageInfo_HandoffCommand.Size(m)
+}
+func (m *HandoffCommand) XXX_DiscardUnknown() {
+	xxx_messageInfo_HandoffCommand.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HandoffCommand proto.InternalMessageInfo
+
+func (m *HandoffCommand) GetRegion() string {
+	if m != nil {
+		return m.Region
+	}
+	return ""
+}
+
+func (m *HandoffCommand) GetCell() uint64 {
+	if m != nil {
+		return m.Cell
+	}
+	return 0
+}
+
+func (m *HandoffCommand) GetAction() uint64 {
+	if m != nil {
+		return m.Action
+	}
+	return 0
+}
+
+type HandoffArmy struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The City controlling the Army, in its home Region
+	City                 uint64            `protobuf:"varint,2,opt,name=city,proto3" json:"city,omitempty"`
+	Region               string            `protobuf:"bytes,3,opt,name=region,proto3" json:"region,omitempty"`
+	Stock                *ResourcesAbs     `protobuf:"bytes,4,opt,name=stock,proto3" json:"stock,omitempty"`
+	Units                []*HandoffUnit    `protobuf:"bytes,5,rep,name=units,proto3" json:"units,omitempty"`
+	Targets              []*HandoffCommand `protobuf:"bytes,6,rep,name=targets,proto3" json:"targets,omitempty"`
+	Postures             []int64           `protobuf:"varint,7,rep,packed,name=postures,proto3" json:"postures,omitempty"`
+	DefaultPosture       int64             `protobuf:"varint,8,opt,name=default_posture,json=defaultPosture,proto3" json:"default_posture,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *HandoffArmy) Reset()         { *m = HandoffArmy{} }
+func (m *HandoffArmy) String() string { return proto.CompactTextString(m) }
+func (*HandoffArmy) ProtoMessage()    {}
+func (*HandoffArmy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{48}
+}
+
+func (m *HandoffArmy) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HandoffArmy.Unmarshal(m, b)
+}
+func (m *HandoffArmy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HandoffArmy.Marshal(b, m, deterministic)
+}
+func (m *HandoffArmy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HandoffArmy.Merge(m, src)
+}
+func (m *HandoffArmy) XXX_Size() int {
+	return xxx_messageInfo_HandoffArmy.Size(m)
+}
+func (m *HandoffArmy) XXX_DiscardUnknown() {
+	xxx_messageInfo_HandoffArmy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HandoffArmy proto.InternalMessageInfo
+
+func (m *HandoffArmy) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *HandoffArmy) GetCity() uint64 {
+	if m != nil {
+		return m.City
+	}
+	return 0
+}
+
+func (m *HandoffArmy) GetRegion() string {
+	if m != nil {
+		return m.Region
+	}
+	return ""
+}
+
+func (m *HandoffArmy) GetStock() *ResourcesAbs {
+	if m != nil {
+		return m.Stock
+	}
+	return nil
+}
+
+func (m *HandoffArmy) GetUnits() []*HandoffUnit {
+	if m != nil {
+		return m.Units
+	}
+	return nil
+}
+
+func (m *HandoffArmy) GetTargets() []*HandoffCommand {
+	if m != nil {
+		return m.Targets
+	}
+	return nil
+}
+
+func (m *HandoffArmy) GetPostures() []int64 {
+	if m != nil {
+		return m.Postures
+	}
+	return nil
+}
+
+func (m *HandoffArmy) GetDefaultPosture() int64 {
+	if m != nil {
+		return m.DefaultPosture
+	}
+	return 0
+}
+
+type HandoffReq struct {
+	Id *HandoffId `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The Cell where the Army enters
+	Cell                 uint64       `protobuf:"varint,2,opt,name=cell,proto3" json:"cell,omitempty"`
+	Army                 *HandoffArmy `protobuf:"bytes,3,opt,name=army,proto3" json:"army,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *HandoffReq) Reset()         { *m = HandoffReq{} }
+func (m *HandoffReq) String() string { return proto.CompactTextString(m) }
+func (*HandoffReq) ProtoMessage()    {}
+func (*HandoffReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{49}
+}
+
+func (m *HandoffReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HandoffReq.Unmarshal(m, b)
+}
+func (m *HandoffReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HandoffReq.Marshal(b, m, deterministic)
+}
+func (m *HandoffReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HandoffReq.Merge(m, src)
+}
+func (m *HandoffReq) XXX_Size() int {
+	return xxx_messageInfo_HandoffReq.Size(m)
+}
+func (m *HandoffReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_HandoffReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HandoffReq proto.InternalMessageInfo
+
+func (m *HandoffReq) GetId() *HandoffId {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+func (m *HandoffReq) GetCell() uint64 {
+	if m != nil {
+		return m.Cell
+	}
+	return 0
+}
+
+func (m *HandoffReq) GetArmy() *HandoffArmy {
+	if m != nil {
+		return m.Army
+	}
+	return nil
+}
+
+type HandoffRep struct {
+	Army                 uint64   `protobuf:"varint,1,opt,name=army,proto3" json:"army,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HandoffRep) Reset()         { *m = HandoffRep{} }
+func (m *HandoffRep) String() string { return proto.CompactTextString(m) }
+func (*HandoffRep) ProtoMessage()    {}
+func (*HandoffRep) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{50}
+}
+
+func (m *HandoffRep) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HandoffRep.Unmarshal(m, b)
+}
+func (m *HandoffRep) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HandoffRep.Marshal(b, m, deterministic)
+}
+func (m *HandoffRep) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HandoffRep.Merge(m, src)
+}
+func (m *HandoffRep) XXX_Size() int {
+	return xxx_messageInfo_HandoffRep.Size(m)
+}
+func (m *HandoffRep) XXX_DiscardUnknown() {
+	xxx_messageInfo_HandoffRep.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HandoffRep proto.InternalMessageInfo
+
+func (m *HandoffRep) GetArmy() uint64 {
+	if m != nil {
+		return m.Army
+	}
+	return 0
+}
+
+// The outcome of a transfer, journaled by the Region the Army leaves
+type HandoffStatus struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The ID of the Army in the Region it entered
+	Army                 uint64   `protobuf:"varint,2,opt,name=army,proto3" json:"army,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HandoffStatus) Reset()         { *m = HandoffStatus{} }
+func (m *HandoffStatus) String() string { return proto.CompactTextString(m) }
+func (*HandoffStatus) ProtoMessage()    {}
+func (*HandoffStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{51}
+}
+
+func (m *HandoffStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HandoffStatus.Unmarshal(m, b)
+}
+func (m *HandoffStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HandoffStatus.Marshal(b, m, deterministic)
+}
+func (m *HandoffStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HandoffStatus.Merge(m, src)
+}
+func (m *HandoffStatus) XXX_Size() int {
+	return xxx_messageInfo_HandoffStatus.Size(m)
+}
+func (m *HandoffStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_HandoffStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HandoffStatus proto.InternalMessageInfo
+
+func (m *HandoffStatus) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *HandoffStatus) GetArmy() uint64 {
+	if m != nil {
+		return m.Army
+	}
+	return 0
+}
+
+type MapReq struct {
+	Marker uint64 `protobuf:"varint,1,opt,name=marker,proto3" json:"marker,omitempty"`
+	Max    uint32 `protobuf:"varint,2,opt,name=max,proto3" json:"max,omitempty"`
+	// Zero for the whole map
+	Center               uint64   `protobuf:"varint,3,opt,name=center,proto3" json:"center,omitempty"`
+	Radius               uint32   `protobuf:"varint,4,opt,name=radius,proto3" json:"radius,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MapReq) Reset()         { *m = MapReq{} }
+func (m *MapReq) String() string { return proto.CompactTextString(m) }
+func (*MapReq) ProtoMessage()    {}
+func (*MapReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{52}
+}
+
+func (m *MapReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MapReq.Unmarshal(m, b)
+}
+func (m *MapReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MapReq.Marshal(b, m, deterministic)
+}
+func (m *MapReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MapReq.Merge(m, src)
+}
+func (m *MapReq) XXX_Size() int {
+	return xxx_messageInfo_MapReq.Size(m)
+}
+func (m *MapReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_MapReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MapReq proto.InternalMessageInfo
+
+func (m *MapReq) GetMarker() uint64 {
+	if m != nil {
+		return m.Marker
+	}
+	return 0
+}
+
+func (m *MapReq) GetMax() uint32 {
+	if m != nil {
+		return m.Max
+	}
+	return 0
+}
+
+func (m *MapReq) GetCenter() uint64 {
+	if m != nil {
+		return m.Center
+	}
+	return 0
+}
+
+func (m *MapReq) GetRadius() uint32 {
+	if m != nil {
+		return m.Radius
+	}
+	return 0
+}
+
+type CellView struct {
+	Id    uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Biome uint64 `protobuf:"varint,2,opt,name=biome,proto3" json:"biome,omitempty"`
+	X     uint64 `protobuf:"varint,3,opt,name=x,proto3" json:"x,omitempty"`
+	Y     uint64 `protobuf:"varint,4,opt,name=y,proto3" json:"y,omitempty"`
+	// The City on the Cell, if any
+	City uint64 `protobuf:"varint,5,opt,name=city,proto3" json:"city,omitempty"`
+	// Set on the border Cells, to the Region beyond
+	Link                 string   `protobuf:"bytes,6,opt,name=link,proto3" json:"link,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CellView) Reset()         { *m = CellView{} }
+func (m *CellView) String() string { return proto.CompactTextString(m) }
+func (*CellView) ProtoMessage()    {}
+func (*CellView) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{53}
+}
+
+func (m *CellView) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CellView.Unmarshal(m, b)
+}
+func (m *CellView) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CellView.Marshal(b, m, deterministic)
+}
+func (m *CellView) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CellView.Merge(m, src)
+}
+func (m *CellView) XXX_Size() int {
+	return xxx_messageInfo_CellView.Size(m)
+}
+func (m *CellView) XXX_DiscardUnknown() {
+	xxx_messageInfo_CellView.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CellView proto.InternalMessageInfo
+
+func (m *CellView) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *CellView) GetBiome() uint64 {
+	if m != nil {
+		return m.Biome
+	}
+	return 0
+}
+
+func (m *CellView) GetX() uint64 {
+	if m != nil {
+		return m.X
+	}
+	return 0
+}
+
+func (m *CellView) GetY() uint64 {
+	if m != nil {
+		return m.Y
+	}
+	return 0
+}
+
+func (m *CellView) GetCity() uint64 {
+	if m != nil {
+		return m.City
+	}
+	return 0
+}
+
+func (m *CellView) GetLink() string {
+	if m != nil {
+		return m.Link
+	}
+	return ""
+}
+
+type RoadView struct {
+	Src uint64 `protobuf:"varint,1,opt,name=src,proto3" json:"src,omitempty"`
+	Dst uint64 `protobuf:"varint,2,opt,name=dst,proto3" json:"dst,omitempty"`
+	// Movement points needed to cross the road, the Biome of dst included
+	Cost                 uint64   `protobuf:"varint,3,opt,name=cost,proto3" json:"cost,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RoadView) Reset()         { *m = RoadView{} }
+func (m *RoadView) String() string { return proto.CompactTextString(m) }
+func (*RoadView) ProtoMessage()    {}
+func (*RoadView) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{54}
+}
+
+func (m *RoadView) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RoadView.Unmarshal(m, b)
+}
+func (m *RoadView) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RoadView.Marshal(b, m, deterministic)
+}
+func (m *RoadView) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoadView.Merge(m, src)
+}
+func (m *RoadView) XXX_Size() int {
+	return xxx_messageInfo_RoadView.Size(m)
+}
+func (m *RoadView) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoadView.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RoadView proto.InternalMessageInfo
+
+func (m *RoadView) GetSrc() uint64 {
+	if m != nil {
+		return m.Src
+	}
+	return 0
+}
+
+func (m *RoadView) GetDst() uint64 {
+	if m != nil {
+		return m.Dst
+	}
+	return 0
+}
+
+func (m *RoadView) GetCost() uint64 {
+	if m != nil {
+		return m.Cost
+	}
+	return 0
+}
+
+type MapView struct {
+	Cells []*CellView `protobuf:"bytes,1,rep,name=cells,proto3" json:"cells,omitempty"`
+	// The open Roads leaving the Cells, toward the Cells of the filter
+	Roads                []*RoadView `protobuf:"bytes,2,rep,name=roads,proto3" json:"roads,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *MapView) Reset()         { *m = MapView{} }
+func (m *MapView) String() string { return proto.CompactTextString(m) }
+func (*MapView) ProtoMessage()    {}
+func (*MapView) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{55}
+}
+
+func (m *MapView) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MapView.Unmarshal(m, b)
+}
+func (m *MapView) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MapView.Marshal(b, m, deterministic)
+}
+func (m *MapView) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MapView.Merge(m, src)
+}
+func (m *MapView) XXX_Size() int {
+	return xxx_messageInfo_MapView.Size(m)
+}
+func (m *MapView) XXX_DiscardUnknown() {
+	xxx_messageInfo_MapView.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MapView proto.InternalMessageInfo
+
+func (m *MapView) GetCells() []*CellView {
+	if m != nil {
+		return m.Cells
+	}
+	return nil
+}
+
+func (m *MapView) GetRoads() []*RoadView {
+	if m != nil {
+		return m.Roads
+	}
+	return nil
+}
+
+type CityPosition struct {
+	Id                   uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Cell                 uint64   `protobuf:"varint,3,opt,name=cell,proto3" json:"cell,omitempty"`
+	X                    uint64   `protobuf:"varint,4,opt,name=x,proto3" json:"x,omitempty"`
+	Y                    uint64   `protobuf:"varint,5,opt,name=y,proto3" json:"y,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CityPosition) Reset()         { *m = CityPosition{} }
+func (m *CityPosition) String() string { return proto.CompactTextString(m) }
+func (*CityPosition) ProtoMessage()    {}
+func (*CityPosition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{56}
+}
+
+func (m *CityPosition) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CityPosition.Unmarshal(m, b)
+}
+func (m *CityPosition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CityPosition.Marshal(b, m, deterministic)
+}
+func (m *CityPosition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CityPosition.Merge(m, src)
+}
+func (m *CityPosition) XXX_Size() int {
+	return xxx_messageInfo_CityPosition.Size(m)
+}
+func (m *CityPosition) XXX_DiscardUnknown() {
+	xxx_messageInfo_CityPosition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CityPosition proto.InternalMessageInfo
+
+func (m *CityPosition) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *CityPosition) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CityPosition) GetCell() uint64 {
+	if m != nil {
+		return m.Cell
+	}
+	return 0
+}
+
+func (m *CityPosition) GetX() uint64 {
+	if m != nil {
+		return m.X
+	}
+	return 0
+}
+
+func (m *CityPosition) GetY() uint64 {
+	if m != nil {
+		return m.Y
+	}
+	return 0
+}
+
+type ListOfCityPositions struct {
+	Items                []*CityPosition `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ListOfCityPositions) Reset()         { *m = ListOfCityPositions{} }
+func (m *ListOfCityPositions) String() string { return proto.CompactTextString(m) }
+func (*ListOfCityPositions) ProtoMessage()    {}
+func (*ListOfCityPositions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{57}
+}
+
+func (m *ListOfCityPositions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListOfCityPositions.Unmarshal(m, b)
+}
+func (m *ListOfCityPositions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListOfCityPositions.Marshal(b, m, deterministic)
+}
+func (m *ListOfCityPositions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListOfCityPositions.Merge(m, src)
+}
+func (m *ListOfCityPositions) XXX_Size() int {
+	return xxx_messageInfo_ListOfCityPositions.Size(m)
+}
+func (m *ListOfCityPositions) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListOfCityPositions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListOfCityPositions proto.InternalMessageInfo
+
+func (m *ListOfCityPositions) GetItems() []*CityPosition {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type MarketOrderReq struct {
+	Character uint64 `protobuf:"varint,1,opt,name=character,proto3" json:"character,omitempty"`
+	City      uint64 `protobuf:"varint,2,opt,name=city,proto3" json:"city,omitempty"`
+	Resource  uint32 `protobuf:"varint,3,opt,name=resource,proto3" json:"resource,omitempty"`
+	// Buy when set, sell otherwise
+	Bid      bool   `protobuf:"varint,4,opt,name=bid,proto3" json:"bid,omitempty"`
+	Quantity uint64 `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// Per unit, paid with the currency Resource of the Region
+	Price                uint64   `protobuf:"varint,6,opt,name=price,proto3" json:"price,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MarketOrderReq) Reset()         { *m = MarketOrderReq{} }
+func (m *MarketOrderReq) String() string { return proto.CompactTextString(m) }
+func (*MarketOrderReq) ProtoMessage()    {}
+func (*MarketOrderReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{58}
+}
+
+func (m *MarketOrderReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MarketOrderReq.Unmarshal(m, b)
+}
+func (m *MarketOrderReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MarketOrderReq.Marshal(b, m, deterministic)
+}
+func (m *MarketOrderReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketOrderReq.Merge(m, src)
+}
+func (m *MarketOrderReq) XXX_Size() int {
+	return xxx_messageInfo_MarketOrderReq.Size(m)
+}
+func (m *MarketOrderReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketOrderReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarketOrderReq proto.InternalMessageInfo
+
+func (m *MarketOrderReq) GetCharacter() uint64 {
+	if m != nil {
+		return m.Character
+	}
+	return 0
+}
+
+func (m *MarketOrderReq) GetCity() uint64 {
+	if m != nil {
+		return m.City
+	}
+	return 0
+}
+
+func (m *MarketOrderReq) GetResource() uint32 {
+	if m != nil {
+		return m.Resource
+	}
+	return 0
+}
+
+func (m *MarketOrderReq) GetBid() bool {
+	if m != nil {
+		return m.Bid
+	}
+	return false
+}
+
+func (m *MarketOrderReq) GetQuantity() uint64 {
+	if m != nil {
+		return m.Quantity
+	}
+	return 0
+}
+
+func (m *MarketOrderReq) GetPrice() uint64 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
+}
+
+type MarketOrderId struct {
+	Character            uint64   `protobuf:"varint,1,opt,name=character,proto3" json:"character,omitempty"`
+	City                 uint64   `protobuf:"varint,2,opt,name=city,proto3" json:"city,omitempty"`
+	Order                uint64   `protobuf:"varint,3,opt,name=order,proto3" json:"order,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MarketOrderId) Reset()         { *m = MarketOrderId{} }
+func (m *MarketOrderId) String() string { return proto.CompactTextString(m) }
+func (*MarketOrderId) ProtoMessage()    {}
+func (*MarketOrderId) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{59}
+}
+
+func (m *MarketOrderId) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MarketOrderId.Unmarshal(m, b)
+}
+func (m *MarketOrderId) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MarketOrderId.Marshal(b, m, deterministic)
+}
+func (m *MarketOrderId) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketOrderId.Merge(m, src)
+}
+func (m *MarketOrderId) XXX_Size() int {
+	return xxx_messageInfo_MarketOrderId.Size(m)
+}
+func (m *MarketOrderId) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketOrderId.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarketOrderId proto.InternalMessageInfo
+
+func (m *MarketOrderId) GetCharacter() uint64 {
+	if m != nil {
+		return m.Character
+	}
+	return 0
+}
+
+func (m *MarketOrderId) GetCity() uint64 {
+	if m != nil {
+		return m.City
+	}
+	return 0
+}
+
+func (m *MarketOrderId) GetOrder() uint64 {
+	if m != nil {
+		return m.Order
+	}
+	return 0
+}
+
+type MarketOrderView struct {
+	Id                   uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	City                 uint64   `protobuf:"varint,2,opt,name=city,proto3" json:"city,omitempty"`
+	Resource             uint32   `protobuf:"varint,3,opt,name=resource,proto3" json:"resource,omitempty"`
+	Bid                  bool     `protobuf:"varint,4,opt,name=bid,proto3" json:"bid,omitempty"`
+	Quantity             uint64   `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Price                uint64   `protobuf:"varint,6,opt,name=price,proto3" json:"price,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MarketOrderView) Reset()         { *m = MarketOrderView{} }
+func (m *MarketOrderView) String() string { return proto.CompactTextString(m) }
+func (*MarketOrderView) ProtoMessage()    {}
+func (*MarketOrderView) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{60}
+}
+
+func (m *MarketOrderView) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MarketOrderView.Unmarshal(m, b)
+}
+func (m *MarketOrderView) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MarketOrderView.Marshal(b, m, deterministic)
+}
+func (m *MarketOrderView) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketOrderView.Merge(m, src)
+}
+func (m *MarketOrderView) XXX_Size() int {
+	return xxx_messageInfo_MarketOrderView.Size(m)
+}
+func (m *MarketOrderView) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketOrderView.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarketOrderView proto.InternalMessageInfo
+
+func (m *MarketOrderView) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *MarketOrderView) GetCity() uint64 {
+	if m != nil {
+		return m.City
+	}
+	return 0
+}
+
+func (m *MarketOrderView) GetResource() uint32 {
+	if m != nil {
+		return m.Resource
+	}
+	return 0
+}
+
+func (m *MarketOrderView) GetBid() bool {
+	if m != nil {
+		return m.Bid
+	}
+	return false
+}
+
+func (m *MarketOrderView) GetQuantity() uint64 {
+	if m != nil {
+		return m.Quantity
+	}
+	return 0
+}
+
+func (m *MarketOrderView) GetPrice() uint64 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
+}
+
+type ListOfMarketOrders struct {
+	Items                []*MarketOrderView `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Currency             uint32             `protobuf:"varint,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *ListOfMarketOrders) Reset()         { *m = ListOfMarketOrders{} }
+func (m *ListOfMarketOrders) String() string { return proto.CompactTextString(m) }
+func (*ListOfMarketOrders) ProtoMessage()    {}
+func (*ListOfMarketOrders) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{61}
+}
+
+func (m *ListOfMarketOrders) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListOfMarketOrders.Unmarshal(m, b)
+}
+func (m *ListOfMarketOrders) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListOfMarketOrders.Marshal(b, m, deterministic)
+}
+func (m *ListOfMarketOrders) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListOfMarketOrders.Merge(m, src)
+}
+func (m *ListOfMarketOrders) XXX_Size() int {
+	return xxx_messageInfo_ListOfMarketOrders.Size(m)
+}
+func (m *ListOfMarketOrders) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListOfMarketOrders.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListOfMarketOrders proto.InternalMessageInfo
+
+func (m *ListOfMarketOrders) GetItems() []*MarketOrderView {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+func (m *ListOfMarketOrders) GetCurrency() uint32 {
+	if m != nil {
+		return m.Currency
+	}
+	return 0
+}
+
+type TreatyReq struct {
+	Character uint64 `protobuf:"varint,1,opt,name=character,proto3" json:"character,omitempty"`
+	City      uint64 `protobuf:"varint,2,opt,name=city,proto3" json:"city,omitempty"`
+	Target    uint64 `protobuf:"varint,3,opt,name=target,proto3" json:"target,omitempty"`
+	// 1 for an alliance, 2 for a peace, 3 for a war
+	Kind                 uint32   `protobuf:"varint,4,opt,name=kind,proto3" json:"kind,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TreatyReq) Reset()         { *m = TreatyReq{} }
+func (m *TreatyReq) String() string { return proto.CompactTextString(m) }
+func (*TreatyReq) ProtoMessage()    {}
+func (*TreatyReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{62}
+}
+
+func (m *TreatyReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TreatyReq.Unmarshal(m, b)
+}
+func (m *TreatyReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TreatyReq.Marshal(b, m, deterministic)
+}
+func (m *TreatyReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TreatyReq.Merge(m, src)
+}
+func (m *TreatyReq) XXX_Size() int {
+	return xxx_messageInfo_TreatyReq.Size(m)
+}
+func (m *TreatyReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_TreatyReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TreatyReq proto.InternalMessageInfo
+
+func (m *TreatyReq) GetCharacter() uint64 {
+	if m != nil {
+		return m.Character
+	}
+	return 0
+}
+
+func (m *TreatyReq) GetCity() uint64 {
+	if m != nil {
+		return m.City
+	}
+	return 0
+}
+
+func (m *TreatyReq) GetTarget() uint64 {
+	if m != nil {
+		return m.Target
+	}
+	return 0
+}
+
+func (m *TreatyReq) GetKind() uint32 {
+	if m != nil {
+		return m.Kind
+	}
+	return 0
+}
+
+type TreatyId struct {
+	Character            uint64   `protobuf:"varint,1,opt,name=character,proto3" json:"character,omitempty"`
+	City                 uint64   `protobuf:"varint,2,opt,name=city,proto3" json:"city,omitempty"`
+	Treaty               uint64   `protobuf:"varint,3,opt,name=treaty,proto3" json:"treaty,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TreatyId) Reset()         { *m = TreatyId{} }
+func (m *TreatyId) String() string { return proto.CompactTextString(m) }
+func (*TreatyId) ProtoMessage()    {}
+func (*TreatyId) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{63}
+}
+
+func (m *TreatyId) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TreatyId.Unmarshal(m, b)
+}
+func (m *TreatyId) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TreatyId.Marshal(b, m, deterministic)
+}
+func (m *TreatyId) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TreatyId.Merge(m, src)
+}
+func (m *TreatyId) XXX_Size() int {
+	return xxx_messageInfo_TreatyId.Size(m)
+}
+func (m *TreatyId) XXX_DiscardUnknown() {
+	xxx_messageInfo_TreatyId.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TreatyId proto.InternalMessageInfo
+
+func (m *TreatyId) GetCharacter() uint64 {
+	if m != nil {
+		return m.Character
+	}
+	return 0
+}
+
+func (m *TreatyId) GetCity() uint64 {
+	if m != nil {
+		return m.City
+	}
+	return 0
+}
+
+func (m *TreatyId) GetTreaty() uint64 {
+	if m != nil {
+		return m.Treaty
+	}
+	return 0
+}
+
+type TreatyView struct {
+	Id                   uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Kind                 uint32   `protobuf:"varint,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Proposer             uint64   `protobuf:"varint,3,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	Target               uint64   `protobuf:"varint,4,opt,name=target,proto3" json:"target,omitempty"`
+	Accepted             bool     `protobuf:"varint,5,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TreatyView) Reset()         { *m = TreatyView{} }
+func (m *TreatyView) String() string { return proto.CompactTextString(m) }
+func (*TreatyView) ProtoMessage()    {}
+func (*TreatyView) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{64}
+}
+
+func (m *TreatyView) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TreatyView.Unmarshal(m, b)
+}
+func (m *TreatyView) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TreatyView.Marshal(b, m, deterministic)
+}
+func (m *TreatyView) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TreatyView.Merge(m, src)
+}
+func (m *TreatyView) XXX_Size() int {
+	return xxx_messageInfo_TreatyView.Size(m)
+}
+func (m *TreatyView) XXX_DiscardUnknown() {
+	xxx_messageInfo_TreatyView.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TreatyView proto.InternalMessageInfo
+
+func (m *TreatyView) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *TreatyView) GetKind() uint32 {
+	if m != nil {
+		return m.Kind
+	}
+	return 0
+}
+
+func (m *TreatyView) GetProposer() uint64 {
+	if m != nil {
+		return m.Proposer
+	}
+	return 0
+}
+
+func (m *TreatyView) GetTarget() uint64 {
+	if m != nil {
+		return m.Target
+	}
+	return 0
+}
+
+func (m *TreatyView) GetAccepted() bool {
+	if m != nil {
+		return m.Accepted
+	}
+	return false
+}
+
+type ListOfTreaties struct {
+	Items                []*TreatyView `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ListOfTreaties) Reset()         { *m = ListOfTreaties{} }
+func (m *ListOfTreaties) String() string { return proto.CompactTextString(m) }
+func (*ListOfTreaties) ProtoMessage()    {}
+func (*ListOfTreaties) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{65}
+}
+
+func (m *ListOfTreaties) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListOfTreaties.Unmarshal(m, b)
+}
+func (m *ListOfTreaties) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListOfTreaties.Marshal(b, m, deterministic)
+}
+func (m *ListOfTreaties) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListOfTreaties.Merge(m, src)
+}
+func (m *ListOfTreaties) XXX_Size() int {
+	return xxx_messageInfo_ListOfTreaties.Size(m)
+}
+func (m *ListOfTreaties) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListOfTreaties.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListOfTreaties proto.InternalMessageInfo
+
+func (m *ListOfTreaties) GetItems() []*TreatyView {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ScoredCity)(nil), "hegemonie.region.proto.ScoredCity")
+	proto.RegisterType((*ScoreBoard)(nil), "hegemonie.region.proto.ScoreBoard")
+	proto.RegisterType((*ClockView)(nil), "hegemonie.region.proto.ClockView")
+	proto.RegisterType((*CatchUpReq)(nil), "hegemonie.region.proto.CatchUpReq")
+	proto.RegisterType((*NamedItem)(nil), "hegemonie.region.proto.NamedItem")
+	proto.RegisterType((*ListOfNamedItems)(nil), "hegemonie.region.proto.ListOfNamedItems")
+	proto.RegisterType((*ArmyId)(nil), "hegemonie.region.proto.ArmyId")
+	proto.RegisterType((*ArmyView)(nil), "hegemonie.region.proto.ArmyView")
+	proto.RegisterType((*ArmyCommandReq)(nil), "hegemonie.region.proto.ArmyCommandReq")
+	proto.RegisterType((*ArmyCommandView)(nil), "hegemonie.region.proto.ArmyCommandView")
+	proto.RegisterType((*ListOfArmyCommands)(nil), "hegemonie.region.proto.ListOfArmyCommands")
+	proto.RegisterType((*ArmyCommandReorderReq)(nil), "hegemonie.region.proto.ArmyCommandReorderReq")
+	proto.RegisterType((*ArmyCommandCancelReq)(nil), "hegemonie.region.proto.ArmyCommandCancelReq")
+	proto.RegisterType((*ArmyPostureReq)(nil), "hegemonie.region.proto.ArmyPostureReq")
+	proto.RegisterType((*CityId)(nil), "hegemonie.region.proto.CityId")
+	proto.RegisterType((*ResourcesAbs)(nil), "hegemonie.region.proto.ResourcesAbs")
+	proto.RegisterType((*ResourcesPlus)(nil), "hegemonie.region.proto.ResourcesPlus")
+	proto.RegisterType((*ResourcesMult)(nil), "hegemonie.region.proto.ResourcesMult")
+	proto.RegisterType((*ResourcesMod)(nil), "hegemonie.region.proto.ResourcesMod")
+	proto.RegisterType((*UnitTypeView)(nil), "hegemonie.region.proto.UnitTypeView")
+	proto.RegisterType((*BuildingTypeView)(nil), "hegemonie.region.proto.BuildingTypeView")
+	proto.RegisterType((*KnowledgeTypeView)(nil), "hegemonie.region.proto.KnowledgeTypeView")
+	proto.RegisterType((*UnitView)(nil), "hegemonie.region.proto.UnitView")
+	proto.RegisterType((*BuildingView)(nil), "hegemonie.region.proto.BuildingView")
+	proto.RegisterType((*KnowledgeView)(nil), "hegemonie.region.proto.KnowledgeView")
+	proto.RegisterType((*StockView)(nil), "hegemonie.region.proto.StockView")
+	proto.RegisterType((*ProductionView)(nil), "hegemonie.region.proto.ProductionView")
+	proto.RegisterType((*CityEvolution)(nil), "hegemonie.region.proto.CityEvolution")
+	proto.RegisterType((*CityAssets)(nil), "hegemonie.region.proto.CityAssets")
+	proto.RegisterType((*CityPolitics)(nil), "hegemonie.region.proto.CityPolitics")
+	proto.RegisterType((*CityView)(nil), "hegemonie.region.proto.CityView")
+	proto.RegisterType((*StudyReq)(nil), "hegemonie.region.proto.StudyReq")
+	proto.RegisterType((*TrainReq)(nil), "hegemonie.region.proto.TrainReq")
+	proto.RegisterType((*BuildReq)(nil), "hegemonie.region.proto.BuildReq")
+	proto.RegisterType((*CreateTransportReq)(nil), "hegemonie.region.proto.CreateTransportReq")
+	proto.RegisterType((*CreateArmyReq)(nil), "hegemonie.region.proto.CreateArmyReq")
+	proto.RegisterType((*TransferUnitReq)(nil), "hegemonie.region.proto.TransferUnitReq")
+	proto.RegisterType((*TransferResourcesReq)(nil), "hegemonie.region.proto.TransferResourcesReq")
+	proto.RegisterType((*ListReq)(nil), "hegemonie.region.proto.ListReq")
+	proto.RegisterType((*ListSetReq)(nil), "hegemonie.region.proto.ListSetReq")
+	proto.RegisterType((*None)(nil), "hegemonie.region.proto.None")
+	proto.RegisterType((*PaginatedQuery)(nil), "hegemonie.region.proto.PaginatedQuery")
+	proto.RegisterType((*ListOfUnitTypes)(nil), "hegemonie.region.proto.ListOfUnitTypes")
+	proto.RegisterType((*ListOfBuildingTypes)(nil), "hegemonie.region.proto.ListOfBuildingTypes")
+	proto.RegisterType((*ListOfKnowledgeTypes)(nil), "hegemonie.region.proto.ListOfKnowledgeTypes")
+	proto.RegisterType((*HandoffId)(nil), "hegemonie.region.proto.HandoffId")
+	proto.RegisterType((*HandoffUnit)(nil), "hegemonie.region.proto.HandoffUnit")
+	proto.RegisterType((*HandoffCommand)(nil), "hegemonie.region.proto.HandoffCommand")
+	proto.RegisterType((*HandoffArmy)(nil), "hegemonie.region.proto.HandoffArmy")
+	proto.RegisterType((*HandoffReq)(nil), "hegemonie.region.proto.HandoffReq")
+	proto.RegisterType((*HandoffRep)(nil), "hegemonie.region.proto.HandoffRep")
+	proto.RegisterType((*HandoffStatus)(nil), "hegemonie.region.proto.HandoffStatus")
+	proto.RegisterType((*MapReq)(nil), "hegemonie.region.proto.MapReq")
+	proto.RegisterType((*CellView)(nil), "hegemonie.region.proto.CellView")
+	proto.RegisterType((*RoadView)(nil), "hegemonie.region.proto.RoadView")
+	proto.RegisterType((*MapView)(nil), "hegemonie.region.proto.MapView")
+	proto.RegisterType((*CityPosition)(nil), "hegemonie.region.proto.CityPosition")
+	proto.RegisterType((*ListOfCityPositions)(nil), "hegemonie.region.proto.ListOfCityPositions")
+	proto.RegisterType((*MarketOrderReq)(nil), "hegemonie.region.proto.MarketOrderReq")
+	proto.RegisterType((*MarketOrderId)(nil), "hegemonie.region.proto.MarketOrderId")
+	proto.RegisterType((*MarketOrderView)(nil), "hegemonie.region.proto.MarketOrderView")
+	proto.RegisterType((*ListOfMarketOrders)(nil), "hegemonie.region.proto.ListOfMarketOrders")
+	proto.RegisterType((*TreatyReq)(nil), "hegemonie.region.proto.TreatyReq")
+	proto.RegisterType((*TreatyId)(nil), "hegemonie.region.proto.TreatyId")
+	proto.RegisterType((*TreatyView)(nil), "hegemonie.region.proto.TreatyView")
+	proto.RegisterType((*ListOfTreaties)(nil), "hegemonie.region.proto.ListOfTreaties")
+}
+
+func init() { proto.RegisterFile("region.proto", fileDescriptor_6eef30384a8831dd) }
+
+var fileDescriptor_6eef30384a8831dd = []byte{
+	// 2831 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3a, 0x4b, 0x73, 0x24, 0x47,
+	0xd1, 0xea, 0xc7, 0x8c, 0x66, 0x52, 0x1a, 0x69, 0x5d, 0x9f, 0xbe, 0x8d, 0x09, 0x85, 0x63, 0x3d,
+	0x2e, 0x6c, 0xaf, 0x30, 0xcb, 0x62, 0x6b, 0xfd, 0x58, 0x3b, 0x6c, 0xb0, 0x24, 0x5b, 0x8b, 0x76,
+	0x91, 0x2d, 0xb7, 0xb4, 0xd8, 0x07, 0xc3, 0x52, 0xea, 0x2e, 0x49, 0x1d, 0xd3, 0xd3, 0x3d, 0xee,
+	0xae, 0xde, 0x5d, 0x9d, 0xe0, 0x00, 0x07, 0x22, 0xb8, 0x70, 0x20, 0x82, 0x03, 0x41, 0x00, 0x37,
+	0x38, 0x70, 0xe0, 0x0f, 0x70, 0xe3, 0x42, 0x04, 0x07, 0xfe, 0x00, 0x17, 0x8e, 0xfc, 0x08, 0xa2,
+	0xaa, 0xab, 0xba, 0xab, 0x67, 0xd5, 0x0f, 0x3d, 0xe0, 0xc4, 0xad, 0xb2, 0xba, 0xf2, 0x51, 0x99,
+	0x59, 0x59, 0x99, 0xd9, 0x05, 0x8b, 0x31, 0x3d, 0xf6, 0xa3, 0xf0, 0xf6, 0x34, 0x8e, 0x58, 0x84,
+	0xae, 0x9f, 0xd0, 0x63, 0x3a, 0x89, 0x42, 0x9f, 0xde, 0xd6, 0xe7, 0xf1, 0x7d, 0x80, 0x7d, 0x37,
+	0x8a, 0xa9, 0xb7, 0xe5, 0xb3, 0x53, 0x84, 0xc0, 0x76, 0x7d, 0x76, 0x3a, 0x34, 0x46, 0xc6, 0x9a,
+	0xed, 0x88, 0x31, 0x5a, 0x81, 0x4e, 0xc2, 0x57, 0x0c, 0xcd, 0x91, 0xb1, 0x66, 0x39, 0x19, 0xc0,
+	0x57, 0x86, 0x64, 0x42, 0x87, 0xd6, 0xc8, 0x58, 0xeb, 0x3b, 0x62, 0x8c, 0xb7, 0x25, 0xad, 0xcd,
+	0x88, 0xc4, 0x1e, 0xba, 0x0b, 0x1d, 0x9f, 0xd1, 0x49, 0x32, 0x34, 0x46, 0xd6, 0xda, 0xc2, 0x3a,
+	0xbe, 0x7d, 0xb6, 0x04, 0xb7, 0x0b, 0xf6, 0x4e, 0x86, 0x80, 0x7f, 0x61, 0x40, 0x7f, 0x2b, 0x88,
+	0xdc, 0xf1, 0x77, 0x7d, 0xfa, 0x84, 0x73, 0x62, 0xbe, 0x3b, 0x56, 0x32, 0xf1, 0x31, 0xba, 0x01,
+	0x30, 0x8d, 0x23, 0x2f, 0x75, 0x99, 0x1f, 0x85, 0x42, 0x30, 0xdb, 0xd1, 0x66, 0xd0, 0x2a, 0xf4,
+	0x42, 0xfa, 0x94, 0xed, 0x46, 0x8f, 0x33, 0x09, 0x2d, 0x27, 0x87, 0xd1, 0x08, 0x16, 0xf8, 0x78,
+	0x4f, 0xac, 0xa6, 0x43, 0x5b, 0x7c, 0xd6, 0xa7, 0xd0, 0x75, 0xe8, 0x4e, 0x49, 0x9a, 0x50, 0x6f,
+	0xd8, 0x19, 0x19, 0x6b, 0x3d, 0x47, 0x42, 0x18, 0x03, 0x6c, 0x11, 0xe6, 0x9e, 0x3c, 0x9c, 0x3a,
+	0xf4, 0x4b, 0xae, 0x17, 0x2e, 0x4b, 0x22, 0x04, 0x1b, 0x38, 0x19, 0x80, 0xbf, 0x01, 0xfd, 0x8f,
+	0xc9, 0x84, 0x7a, 0x3b, 0x8c, 0x4e, 0xd0, 0x12, 0x98, 0xbe, 0x27, 0x05, 0x37, 0x7d, 0x2f, 0x57,
+	0x9a, 0xa9, 0x29, 0xed, 0x01, 0x5c, 0xfb, 0x8e, 0x9f, 0xb0, 0x4f, 0x8e, 0x72, 0xb4, 0x04, 0xbd,
+	0x5d, 0x56, 0xdd, 0x8b, 0x55, 0xaa, 0xcb, 0x51, 0x94, 0xe6, 0x3e, 0x86, 0xee, 0x46, 0x3c, 0x39,
+	0xdd, 0xf1, 0xd0, 0xf3, 0xd0, 0x77, 0x4f, 0x48, 0x4c, 0x5c, 0x46, 0x63, 0x29, 0x41, 0x31, 0x91,
+	0xdb, 0xd9, 0xd4, 0xec, 0x8c, 0xc0, 0x26, 0xf1, 0xe4, 0x54, 0xe8, 0xcb, 0x76, 0xc4, 0x18, 0xff,
+	0xd6, 0x84, 0x1e, 0x27, 0x28, 0x0c, 0xd1, 0x62, 0x37, 0x5c, 0xf1, 0x41, 0xe4, 0x12, 0x61, 0x96,
+	0x8c, 0x50, 0x0e, 0xa3, 0x77, 0xa1, 0x93, 0xb0, 0xc8, 0x1d, 0x0b, 0x95, 0x2f, 0xac, 0xbf, 0x54,
+	0xb5, 0x2b, 0x87, 0x26, 0x51, 0x1a, 0xbb, 0x34, 0xd9, 0x38, 0x4c, 0x9c, 0x0c, 0x05, 0xbd, 0x05,
+	0x9d, 0x34, 0xf4, 0x59, 0x32, 0xec, 0x08, 0x8d, 0x8c, 0xaa, 0x70, 0x1f, 0x86, 0x3e, 0xe3, 0xc2,
+	0x3a, 0xd9, 0x72, 0xae, 0x06, 0x16, 0x93, 0x30, 0x99, 0x46, 0x31, 0x1b, 0x76, 0x85, 0x35, 0x8b,
+	0x09, 0x2e, 0xed, 0x34, 0x4a, 0x58, 0x1a, 0xd3, 0x64, 0x38, 0x3f, 0xb2, 0xb8, 0x9b, 0x28, 0x18,
+	0xdd, 0x84, 0x65, 0x8f, 0x1e, 0x91, 0x34, 0x60, 0x8f, 0xe4, 0xdc, 0xb0, 0x27, 0x5c, 0x65, 0x49,
+	0x4e, 0xef, 0x65, 0xb3, 0xf8, 0x57, 0x06, 0x2c, 0x71, 0x1d, 0x6d, 0x45, 0x93, 0x09, 0x09, 0x3d,
+	0xee, 0x1a, 0xb7, 0x73, 0x4d, 0x2d, 0xac, 0xdf, 0xa8, 0x12, 0x35, 0x33, 0x94, 0xd0, 0xe4, 0x75,
+	0xe8, 0x32, 0x12, 0x1f, 0x53, 0x26, 0x0d, 0x22, 0x21, 0x3e, 0x4f, 0x5c, 0x4d, 0x97, 0x12, 0xe2,
+	0xf3, 0x19, 0x29, 0xa1, 0xca, 0xbe, 0x23, 0x21, 0x61, 0x56, 0x1a, 0x04, 0xc3, 0x8e, 0x34, 0x2b,
+	0x0d, 0x02, 0xfc, 0x43, 0x58, 0xd6, 0xa4, 0x13, 0x86, 0x5c, 0x81, 0x8e, 0x1f, 0x7a, 0xf4, 0xa9,
+	0xf2, 0x5c, 0x01, 0xe4, 0xc8, 0x66, 0x81, 0x9c, 0xfb, 0x89, 0xa5, 0xf9, 0x49, 0x21, 0x94, 0x5d,
+	0x21, 0x54, 0x47, 0x17, 0x0a, 0xef, 0x03, 0xca, 0x1c, 0x5c, 0x13, 0x23, 0x41, 0xef, 0x97, 0x5d,
+	0xfc, 0x66, 0x9d, 0x96, 0x34, 0xd9, 0x95, 0xa3, 0x8f, 0xe1, 0xff, 0x4b, 0x3a, 0x8f, 0x62, 0x8f,
+	0xc6, 0x17, 0x51, 0x3d, 0x02, 0xfb, 0x28, 0x8e, 0x26, 0x62, 0xd7, 0x03, 0x47, 0x8c, 0xb9, 0xa3,
+	0xb3, 0x48, 0xec, 0x79, 0xe0, 0x98, 0x2c, 0xc2, 0x5f, 0xc0, 0x8a, 0xc6, 0x6c, 0x8b, 0x84, 0x2e,
+	0x0d, 0x2e, 0xc2, 0x2b, 0xd7, 0xbb, 0xa9, 0xe9, 0x1d, 0xc7, 0x99, 0xfb, 0x48, 0x77, 0xba, 0x4a,
+	0xf7, 0x19, 0xc2, 0xbc, 0x72, 0xdd, 0x2c, 0x08, 0x2a, 0x10, 0xbf, 0x0b, 0x5d, 0x1e, 0x70, 0x2f,
+	0x12, 0x27, 0x70, 0x08, 0x8b, 0xfa, 0x09, 0xe5, 0xda, 0x8a, 0x5f, 0x53, 0x61, 0x21, 0x7e, 0x4d,
+	0xc0, 0xaf, 0x4b, 0x0c, 0x33, 0x7e, 0x5d, 0xc0, 0xeb, 0xd2, 0x83, 0xcc, 0x78, 0x5d, 0xc0, 0x77,
+	0xa4, 0xef, 0x98, 0xf1, 0x1d, 0x01, 0xbf, 0x21, 0x5d, 0xd6, 0x8c, 0xdf, 0x10, 0xf0, 0x9b, 0xc3,
+	0xae, 0x84, 0xdf, 0xc4, 0x11, 0x0c, 0x72, 0x7e, 0x7b, 0x41, 0xaa, 0x33, 0xb4, 0x66, 0x18, 0x5a,
+	0x33, 0x0c, 0xad, 0x19, 0x86, 0xd6, 0x0c, 0x43, 0x6b, 0x86, 0xa1, 0xf5, 0x0c, 0xc3, 0xdd, 0x34,
+	0x60, 0x1a, 0x43, 0x63, 0x86, 0xa1, 0x31, 0xc3, 0xd0, 0x98, 0x61, 0x68, 0xcc, 0x30, 0x34, 0x66,
+	0x18, 0x1a, 0x82, 0xe1, 0x8f, 0x0d, 0x4d, 0xa5, 0xbb, 0x91, 0x87, 0xde, 0x01, 0x7b, 0x1a, 0xa4,
+	0x89, 0x74, 0x81, 0x97, 0x1b, 0x03, 0x25, 0x57, 0x8b, 0x23, 0x50, 0x38, 0xea, 0x24, 0x0d, 0x32,
+	0x4f, 0x68, 0x83, 0xca, 0x37, 0xe8, 0x08, 0x14, 0xbc, 0x0e, 0x8b, 0x3c, 0x7c, 0x1e, 0x9c, 0x4e,
+	0x69, 0xdb, 0x78, 0x8f, 0xdf, 0x82, 0x6b, 0x9b, 0xa9, 0x1f, 0x78, 0x7e, 0x78, 0x7c, 0x2e, 0xbc,
+	0xb7, 0xe1, 0xb9, 0x07, 0x61, 0xf4, 0x24, 0xa0, 0xde, 0x31, 0x3d, 0x17, 0xe2, 0x1f, 0x0d, 0xe8,
+	0xa9, 0x20, 0x8f, 0xee, 0x82, 0xcd, 0x4e, 0xa7, 0x74, 0x68, 0xd4, 0x5f, 0x28, 0xfa, 0xae, 0x1c,
+	0x81, 0x21, 0x59, 0x99, 0x39, 0xab, 0xeb, 0xd0, 0xf5, 0x3d, 0xbe, 0x46, 0x45, 0xda, 0x0c, 0x2a,
+	0x2e, 0x79, 0x5b, 0xbb, 0xe4, 0xf9, 0xea, 0x13, 0x4a, 0x02, 0x76, 0x22, 0x8c, 0x3a, 0x70, 0x24,
+	0x94, 0x0b, 0xdc, 0xd5, 0x04, 0xfe, 0xb5, 0x01, 0x8b, 0x4a, 0x45, 0x42, 0xe8, 0xf7, 0x4a, 0x42,
+	0xaf, 0x55, 0x09, 0x3d, 0xab, 0xd6, 0x2b, 0x11, 0x5c, 0x09, 0xd8, 0xd1, 0x04, 0xfc, 0x8d, 0x01,
+	0x83, 0xdc, 0x16, 0x42, 0xc2, 0xf7, 0x4b, 0x12, 0x7e, 0xb5, 0x4a, 0xc2, 0x67, 0x0c, 0xf8, 0x1f,
+	0x13, 0xf1, 0x27, 0x16, 0xf4, 0xf7, 0x99, 0x4a, 0x08, 0xef, 0x82, 0x7d, 0x48, 0x92, 0x46, 0xab,
+	0x97, 0xd2, 0x08, 0x81, 0x81, 0x36, 0xa1, 0x3f, 0x56, 0x42, 0x0f, 0xcd, 0x96, 0xe8, 0xbb, 0x91,
+	0xe7, 0x14, 0x68, 0x9c, 0xc6, 0xa1, 0x34, 0x4d, 0x32, 0xb4, 0xce, 0x43, 0x23, 0x47, 0x43, 0xef,
+	0x41, 0x97, 0xc5, 0x51, 0x34, 0x4d, 0x86, 0xf6, 0x39, 0x08, 0x48, 0x1c, 0x8e, 0x4d, 0x5c, 0x96,
+	0x92, 0xec, 0x9e, 0x6f, 0xab, 0x01, 0x89, 0xc3, 0xb3, 0xb0, 0x34, 0x21, 0xc7, 0x99, 0x93, 0xb6,
+	0xce, 0xc2, 0x04, 0x0a, 0xfe, 0x9b, 0x09, 0x4b, 0x7b, 0x79, 0x96, 0xfd, 0x3f, 0x63, 0x5c, 0xd6,
+	0x18, 0xf8, 0x9f, 0x06, 0x0c, 0xf8, 0x45, 0xfc, 0xd1, 0xe3, 0x28, 0x48, 0x45, 0x16, 0x75, 0x0f,
+	0xfa, 0xe3, 0xed, 0x38, 0x0a, 0x99, 0x4f, 0x63, 0x99, 0x1b, 0x9d, 0xe3, 0x00, 0x16, 0xb8, 0x68,
+	0x1b, 0xfa, 0x87, 0x39, 0x21, 0x73, 0x64, 0x9d, 0x2b, 0xd6, 0x14, 0xa8, 0x5c, 0xc5, 0x69, 0x4e,
+	0xc7, 0x1a, 0x59, 0x75, 0x7b, 0x2c, 0x05, 0xda, 0x02, 0x0d, 0xff, 0xd4, 0x04, 0xe0, 0xdb, 0xdc,
+	0x48, 0x12, 0xca, 0x92, 0x22, 0x99, 0x37, 0xce, 0x97, 0xcc, 0x97, 0xac, 0x6d, 0xd6, 0x8b, 0xa2,
+	0x87, 0x5c, 0xdd, 0xda, 0x1f, 0x01, 0xe4, 0xee, 0x93, 0xc8, 0xfd, 0xbc, 0xdc, 0xa8, 0x60, 0x41,
+	0x45, 0x43, 0x44, 0x77, 0xa1, 0x4b, 0xe2, 0x89, 0x4f, 0xb9, 0xd3, 0xd4, 0xee, 0x41, 0x55, 0x4f,
+	0x8e, 0x5c, 0x8f, 0x37, 0x61, 0x91, 0xab, 0x62, 0x2f, 0x0a, 0x7c, 0xe6, 0xbb, 0x09, 0xaf, 0x41,
+	0xa2, 0xc7, 0x34, 0x0e, 0xa2, 0x58, 0x5d, 0x7d, 0x39, 0xcc, 0x23, 0x67, 0xe0, 0xd3, 0x63, 0x9a,
+	0xed, 0xd6, 0x76, 0x24, 0x84, 0xff, 0x65, 0x43, 0x8f, 0x13, 0x69, 0x5d, 0x96, 0xad, 0x40, 0x27,
+	0x7a, 0x12, 0x0a, 0x03, 0xf2, 0x65, 0x19, 0xc0, 0xc9, 0x7b, 0x74, 0x9a, 0xb2, 0x53, 0x95, 0xc9,
+	0x67, 0x90, 0xc8, 0xfa, 0x78, 0x0e, 0x91, 0x5d, 0x6e, 0x62, 0xcc, 0x73, 0x49, 0xf7, 0x84, 0x44,
+	0xcc, 0x77, 0x45, 0xe0, 0x18, 0x38, 0x0a, 0xe4, 0x19, 0x24, 0x09, 0xfc, 0xe3, 0x70, 0x42, 0x43,
+	0x36, 0x9c, 0x17, 0xdf, 0x8a, 0x09, 0x5e, 0x6d, 0x53, 0x76, 0x12, 0xfa, 0xee, 0xbd, 0x38, 0x4a,
+	0xa7, 0xa2, 0x84, 0x1a, 0x38, 0xfa, 0x14, 0x7a, 0x09, 0x06, 0x3c, 0xf2, 0xef, 0x92, 0x24, 0x21,
+	0x2e, 0xaf, 0xc4, 0xfa, 0x62, 0x4d, 0x79, 0x52, 0x54, 0xa7, 0x29, 0x8b, 0x86, 0x20, 0x6a, 0x38,
+	0x31, 0xe6, 0x32, 0x79, 0x34, 0xa0, 0x8c, 0x7a, 0xc3, 0x05, 0x31, 0xad, 0x40, 0xf4, 0x01, 0x2f,
+	0xec, 0x32, 0x05, 0x0f, 0x17, 0xeb, 0xcf, 0xa5, 0x6e, 0x0c, 0x27, 0xc7, 0xe2, 0x25, 0x78, 0x56,
+	0xac, 0x0e, 0x46, 0x46, 0x5d, 0x09, 0x9e, 0x5f, 0x4b, 0xaa, 0x52, 0xdd, 0x2e, 0xb5, 0x26, 0x96,
+	0x04, 0xf6, 0x2b, 0x55, 0xd8, 0xe5, 0x60, 0x5a, 0x6a, 0x61, 0xbc, 0x0b, 0x5d, 0x22, 0x8e, 0xcb,
+	0xf0, 0xda, 0xc8, 0xa8, 0xeb, 0x9f, 0x14, 0x07, 0xcb, 0x91, 0x18, 0x3c, 0x09, 0xa4, 0x8f, 0xa3,
+	0x60, 0xb8, 0x5c, 0x9f, 0x04, 0x96, 0x22, 0x8f, 0x23, 0x50, 0xf2, 0x2a, 0xf0, 0x39, 0xad, 0x84,
+	0x3c, 0x84, 0xde, 0x3e, 0x4b, 0xbd, 0x53, 0x5e, 0x9b, 0x9c, 0xbf, 0xaf, 0xf0, 0x12, 0x0c, 0xc6,
+	0x7a, 0xa0, 0x92, 0x3e, 0x58, 0x9e, 0xc4, 0x9f, 0x43, 0xef, 0x20, 0x26, 0x7e, 0x78, 0x31, 0x1e,
+	0xab, 0xd0, 0x4b, 0x65, 0xec, 0x51, 0x6d, 0x07, 0x05, 0xe3, 0x1f, 0x40, 0x4f, 0x04, 0x83, 0x8b,
+	0x51, 0xc6, 0xb0, 0x78, 0xa8, 0x45, 0x47, 0x49, 0xbd, 0x34, 0xc7, 0x73, 0x52, 0xb4, 0x15, 0x53,
+	0xc2, 0xe8, 0x81, 0x6a, 0x2d, 0x5c, 0x8c, 0xd9, 0x19, 0x4d, 0xb5, 0x4b, 0x75, 0x4d, 0x8a, 0xc2,
+	0xb0, 0xa3, 0x17, 0x86, 0xd8, 0x87, 0x41, 0x26, 0x2f, 0x8f, 0x4e, 0x57, 0x27, 0x2a, 0x02, 0x9b,
+	0x6b, 0x5d, 0x84, 0x44, 0xdb, 0x11, 0x63, 0x3c, 0x86, 0x65, 0xa1, 0x94, 0x23, 0x1a, 0xf3, 0x70,
+	0x7e, 0x61, 0x66, 0xb3, 0xad, 0xa9, 0x33, 0x99, 0xfd, 0xd2, 0x80, 0x15, 0xc5, 0x2d, 0xd7, 0xc7,
+	0xd5, 0xb1, 0xbc, 0x84, 0x29, 0xf0, 0x4d, 0x98, 0xe7, 0x5d, 0x90, 0x46, 0x61, 0xf0, 0x2d, 0x00,
+	0xbe, 0x70, 0x9f, 0x8a, 0xb5, 0x37, 0x00, 0xf2, 0x4f, 0xd9, 0x7d, 0x69, 0x3b, 0xda, 0x0c, 0xee,
+	0x82, 0xfd, 0x71, 0x14, 0xf2, 0x82, 0x7e, 0x69, 0x8f, 0x1c, 0xfb, 0x21, 0x61, 0xd4, 0xfb, 0x34,
+	0xa5, 0xb1, 0x68, 0xd3, 0x4c, 0x48, 0x3c, 0xce, 0x59, 0x48, 0x08, 0x5d, 0x03, 0x6b, 0x42, 0x54,
+	0x0b, 0x82, 0x0f, 0xf1, 0x2e, 0x2c, 0x67, 0x0d, 0x1a, 0x75, 0x7d, 0x27, 0x7c, 0xa7, 0x7a, 0x77,
+	0xa6, 0xdd, 0x85, 0x9f, 0xa1, 0xe0, 0x87, 0xf0, 0x7f, 0x19, 0x39, 0x3d, 0xab, 0x48, 0xd0, 0x37,
+	0xcb, 0x24, 0xdb, 0xe7, 0x22, 0x92, 0xec, 0x67, 0xb0, 0x92, 0x91, 0x2d, 0x65, 0x3d, 0x09, 0xfa,
+	0x56, 0x99, 0xee, 0x39, 0x92, 0x25, 0x49, 0xf8, 0x0e, 0xf4, 0xbf, 0x4d, 0x42, 0x2f, 0x3a, 0x3a,
+	0xda, 0xf1, 0xb4, 0x26, 0x96, 0x51, 0xea, 0xac, 0xcd, 0xd4, 0x34, 0x78, 0x1f, 0x16, 0x24, 0x12,
+	0x57, 0x81, 0x56, 0xe2, 0x18, 0x67, 0x97, 0x38, 0xe6, 0xd9, 0xe5, 0xa3, 0xa5, 0x97, 0x8f, 0xf8,
+	0x00, 0x96, 0x24, 0x51, 0xd9, 0x6a, 0xaa, 0x14, 0xe7, 0xac, 0x5e, 0x5d, 0x45, 0xb3, 0x10, 0xff,
+	0xd5, 0xcc, 0x65, 0xdd, 0x90, 0x07, 0x47, 0x9c, 0x5c, 0xa3, 0x7c, 0x72, 0x9f, 0x39, 0x01, 0x05,
+	0x6f, 0xab, 0xc4, 0xfb, 0x32, 0x01, 0xe9, 0x9d, 0x72, 0x1b, 0xf7, 0x2b, 0x55, 0xb8, 0x9a, 0x6e,
+	0x55, 0xf2, 0xf7, 0x01, 0xcc, 0x67, 0xd1, 0x2b, 0x19, 0x76, 0x47, 0x56, 0xdd, 0xa5, 0x5a, 0xd6,
+	0xa1, 0xa3, 0xd0, 0xae, 0xa6, 0xdb, 0xfb, 0x33, 0x03, 0x40, 0x32, 0xe0, 0xe7, 0xf3, 0x75, 0xad,
+	0x55, 0xf7, 0x62, 0x83, 0x40, 0x45, 0xc7, 0xf1, 0x19, 0xdb, 0xbd, 0xad, 0x45, 0x9b, 0x66, 0xb5,
+	0x88, 0xa8, 0x2d, 0x10, 0xf0, 0x48, 0x93, 0x66, 0x9a, 0x07, 0x2d, 0x43, 0x6b, 0xe1, 0xdf, 0x81,
+	0x81, 0x5c, 0xb1, 0xcf, 0x08, 0xcb, 0xda, 0x67, 0xb3, 0xf9, 0xa2, 0x40, 0x32, 0x35, 0xa4, 0x43,
+	0xe8, 0xee, 0x12, 0xf1, 0x97, 0xa3, 0x75, 0x18, 0xe1, 0x2b, 0x5d, 0x1a, 0xb2, 0x3c, 0xc9, 0x94,
+	0x10, 0x9f, 0x8f, 0x89, 0xe7, 0xa7, 0xaa, 0xce, 0x97, 0x10, 0x66, 0xd0, 0xdb, 0xa2, 0x41, 0x70,
+	0x66, 0x0e, 0xbb, 0x02, 0x9d, 0x43, 0x3f, 0x92, 0x49, 0xac, 0xed, 0x64, 0x00, 0x5a, 0x04, 0xe3,
+	0xa9, 0x24, 0x6e, 0x3c, 0xe5, 0x90, 0x4a, 0x5c, 0x8d, 0xe2, 0xcf, 0x55, 0xa7, 0x1c, 0xc3, 0x03,
+	0x3f, 0x1c, 0xab, 0x76, 0x0c, 0x1f, 0xe3, 0x4d, 0xe8, 0x39, 0x11, 0xc9, 0xfa, 0xe0, 0xd7, 0xc0,
+	0x4a, 0x62, 0x57, 0xb2, 0xe5, 0x43, 0x3e, 0xe3, 0x25, 0xaa, 0x8d, 0xca, 0x87, 0x82, 0x6e, 0x94,
+	0xb0, 0xbc, 0x03, 0x1e, 0x25, 0x0c, 0x9f, 0xc2, 0xfc, 0x2e, 0x99, 0x0a, 0x12, 0x6f, 0x41, 0x87,
+	0x1b, 0xb0, 0xb1, 0x94, 0x51, 0x3b, 0x75, 0xb2, 0xe5, 0x1c, 0x2f, 0x8e, 0x88, 0xa7, 0xca, 0x98,
+	0x4a, 0x3c, 0x25, 0xab, 0x93, 0x2d, 0xc7, 0x87, 0xaa, 0x7a, 0x48, 0x7c, 0x56, 0xc4, 0xa5, 0xfa,
+	0xe4, 0x5f, 0x39, 0x9c, 0xa5, 0x39, 0x9c, 0x50, 0xa5, 0x5d, 0x52, 0x65, 0x47, 0xaa, 0x12, 0x7f,
+	0xaa, 0x02, 0xb8, 0xce, 0xa9, 0xfd, 0x9d, 0xa0, 0x63, 0xa9, 0x18, 0xfb, 0x3b, 0x03, 0x96, 0x76,
+	0xb9, 0xe3, 0xb0, 0x4f, 0x54, 0xa3, 0xfe, 0x42, 0x49, 0x5e, 0x2c, 0x63, 0x8a, 0x0c, 0x9c, 0x39,
+	0xcc, 0x0d, 0x77, 0xe8, 0x7b, 0x62, 0x47, 0x3d, 0x87, 0x0f, 0xf9, 0xea, 0x2f, 0x53, 0x12, 0xb2,
+	0xc2, 0x29, 0x72, 0x98, 0xbb, 0xd7, 0x34, 0xf6, 0x5d, 0x2a, 0xbb, 0xcc, 0x19, 0x80, 0x3f, 0x83,
+	0x81, 0x26, 0xe3, 0x85, 0xfe, 0xa1, 0xf1, 0x3a, 0x8b, 0x23, 0xe7, 0x75, 0x16, 0x07, 0xf8, 0xff,
+	0xcc, 0x65, 0x8d, 0x72, 0x55, 0xd5, 0xf6, 0x5f, 0xdf, 0x70, 0xa4, 0xfe, 0xcc, 0x68, 0xc2, 0xb5,
+	0xff, 0x33, 0x33, 0xb3, 0x23, 0x69, 0x6a, 0x2e, 0x86, 0x9b, 0xc6, 0x31, 0x0d, 0xdd, 0x53, 0x19,
+	0x1d, 0x72, 0x18, 0xfb, 0xd0, 0x3f, 0x88, 0x29, 0x61, 0x17, 0xcc, 0x39, 0x8b, 0x74, 0xd6, 0x2a,
+	0xfd, 0xe7, 0x40, 0x60, 0x8f, 0xfd, 0xd0, 0x93, 0xf1, 0x45, 0x8c, 0xf1, 0x01, 0xf4, 0x32, 0x56,
+	0x3b, 0xde, 0x05, 0x39, 0x09, 0xec, 0x9c, 0x93, 0x80, 0xf0, 0x8f, 0x0c, 0x80, 0x8c, 0x6c, 0x95,
+	0x11, 0x85, 0x20, 0x66, 0x21, 0x88, 0xb8, 0x75, 0xe2, 0x68, 0x1a, 0x25, 0xb9, 0x57, 0xe4, 0xb0,
+	0xb6, 0x21, 0xbb, 0xb4, 0xa1, 0x55, 0xe8, 0x11, 0xd7, 0xa5, 0x53, 0x96, 0xff, 0x82, 0xce, 0x61,
+	0x7c, 0x1f, 0x96, 0x32, 0xa3, 0x09, 0x39, 0x7c, 0xd1, 0x8b, 0x68, 0xf7, 0xa3, 0xbd, 0x10, 0x5c,
+	0xda, 0x6a, 0xfd, 0xef, 0x5d, 0xb0, 0xc5, 0x7f, 0xff, 0x7d, 0xb0, 0x39, 0x51, 0xf4, 0x42, 0x15,
+	0xae, 0xcc, 0x5d, 0x57, 0xd7, 0xea, 0x16, 0xe8, 0xff, 0xb0, 0xf1, 0x1c, 0xba, 0x0f, 0xf6, 0xfe,
+	0x49, 0xf4, 0x04, 0xdd, 0xa8, 0x8b, 0x14, 0x3b, 0xde, 0xea, 0xa8, 0xee, 0x3b, 0x17, 0x17, 0xcf,
+	0xa1, 0x1d, 0xe8, 0x88, 0x12, 0x14, 0x8d, 0xaa, 0x0b, 0xf1, 0xac, 0x42, 0x5d, 0x7d, 0xbe, 0xf2,
+	0x6f, 0x39, 0x4f, 0x94, 0x05, 0x29, 0x91, 0x63, 0x56, 0x93, 0x52, 0xe5, 0x62, 0x1b, 0x52, 0xa2,
+	0x68, 0xad, 0x26, 0xa5, 0x6a, 0xda, 0x46, 0x52, 0xfb, 0x00, 0x45, 0x49, 0x86, 0xaa, 0x4b, 0x76,
+	0xbd, 0x6c, 0x6b, 0x24, 0xfa, 0x3d, 0x58, 0x9e, 0xa9, 0x4b, 0xd1, 0xab, 0xf5, 0x94, 0xf5, 0x02,
+	0xb6, 0x91, 0xfc, 0x67, 0xb0, 0xa8, 0xd7, 0x76, 0xe8, 0x66, 0x8d, 0x16, 0xf4, 0x0a, 0xb0, 0x91,
+	0x30, 0x81, 0xe7, 0x9e, 0x29, 0xe3, 0xd0, 0xad, 0x26, 0xea, 0x7a, 0xc5, 0xd7, 0xc8, 0xe2, 0xf3,
+	0xac, 0xcc, 0xda, 0x10, 0x4d, 0xb9, 0x46, 0x17, 0x3d, 0x87, 0xdb, 0xaf, 0xff, 0xd9, 0x84, 0x85,
+	0x0f, 0xe9, 0x91, 0x1f, 0xca, 0x7b, 0xf3, 0xfb, 0xd0, 0xe7, 0xab, 0x1e, 0x8a, 0x2c, 0xb6, 0xba,
+	0x13, 0x54, 0xaa, 0xde, 0x56, 0x6f, 0xd6, 0x33, 0xcc, 0x2b, 0x35, 0x3c, 0x87, 0x8e, 0x60, 0xc0,
+	0x27, 0x37, 0xf3, 0x16, 0x67, 0x5b, 0x1e, 0x5f, 0xab, 0xe7, 0x51, 0x2a, 0xdf, 0xf0, 0x1c, 0x3a,
+	0xc9, 0x02, 0xcf, 0x83, 0xa2, 0x09, 0xda, 0x96, 0xd1, 0xad, 0x7a, 0x46, 0xe5, 0x82, 0x0e, 0xcf,
+	0xad, 0xff, 0xc3, 0x86, 0xce, 0x86, 0x37, 0xf1, 0x79, 0x37, 0x7c, 0x5e, 0x3d, 0xca, 0xa9, 0x35,
+	0x68, 0xa3, 0xb9, 0x3f, 0x04, 0x5b, 0x3c, 0xfe, 0xb9, 0x1c, 0x95, 0x4f, 0xa0, 0x7f, 0x8f, 0x32,
+	0xf1, 0x60, 0x29, 0x69, 0x20, 0x55, 0xff, 0xdc, 0x49, 0xbc, 0x90, 0xca, 0xc4, 0xda, 0x27, 0x97,
+	0x16, 0x6b, 0x17, 0x7a, 0xf7, 0x28, 0x13, 0x2f, 0xa6, 0x1a, 0x28, 0x55, 0x96, 0x28, 0xf9, 0x73,
+	0x2b, 0x3c, 0x87, 0x3e, 0x82, 0xce, 0x1e, 0x7f, 0xf0, 0x74, 0x49, 0xa9, 0xb6, 0xa1, 0xeb, 0xd0,
+	0x24, 0x9d, 0x5c, 0x96, 0x8e, 0x03, 0xf3, 0xf2, 0xd5, 0x15, 0xaa, 0xee, 0x81, 0xe6, 0xcf, 0xb2,
+	0x5a, 0x6d, 0x71, 0xfd, 0xf7, 0x1d, 0xb0, 0x45, 0xa0, 0x6d, 0xbc, 0xa3, 0xb2, 0x67, 0x16, 0xab,
+	0x8d, 0xfd, 0x7d, 0xb1, 0x61, 0x7b, 0x3b, 0xa0, 0xa4, 0x91, 0x56, 0xb3, 0xe2, 0xec, 0xed, 0xc0,
+	0x9f, 0x5e, 0x9a, 0xce, 0xa7, 0x30, 0xaf, 0xfa, 0x08, 0xaf, 0xb4, 0x78, 0x5e, 0xd3, 0x26, 0x6a,
+	0x7e, 0x01, 0x8b, 0xfc, 0xcc, 0xe6, 0xaf, 0x78, 0x9a, 0x44, 0x7c, 0xb5, 0xfe, 0xe4, 0x6b, 0xdc,
+	0x79, 0x84, 0x79, 0x04, 0x4b, 0xf2, 0x25, 0x8f, 0x92, 0xfb, 0xeb, 0xad, 0xe4, 0x56, 0x8f, 0x7f,
+	0x5a, 0xdc, 0x87, 0x83, 0xec, 0xf5, 0x8e, 0xa2, 0x7f, 0xab, 0x05, 0xfd, 0xfc, 0xbd, 0x4f, 0x23,
+	0xf9, 0x03, 0x80, 0x7d, 0xaa, 0x3a, 0x05, 0xf5, 0x3a, 0x2f, 0x5e, 0xfb, 0x34, 0x51, 0x5d, 0xff,
+	0x83, 0x01, 0xf3, 0xb2, 0x82, 0x47, 0x0e, 0x74, 0x37, 0x44, 0x22, 0x58, 0x7d, 0x14, 0x8a, 0xe6,
+	0xc4, 0x6a, 0xf3, 0x9a, 0x29, 0x9e, 0x43, 0x0f, 0xa0, 0xcb, 0x77, 0xea, 0x33, 0xd4, 0xdc, 0xc0,
+	0x68, 0x14, 0xf6, 0xe7, 0x26, 0x74, 0xb3, 0xc2, 0x00, 0x7d, 0x0e, 0x9d, 0xbd, 0x80, 0xb8, 0x35,
+	0x8a, 0x28, 0x57, 0x84, 0xab, 0x2f, 0xb7, 0x58, 0xb7, 0xe3, 0x89, 0x30, 0xdc, 0xcd, 0x8c, 0x82,
+	0xda, 0xa1, 0xb4, 0x70, 0xeb, 0x2c, 0xfd, 0x6d, 0x7b, 0xa1, 0x35, 0xb8, 0xb5, 0xc6, 0x9c, 0x5f,
+	0x67, 0x7f, 0x31, 0xa1, 0xff, 0xa1, 0x3f, 0x0d, 0xa2, 0x09, 0x71, 0x4f, 0xd1, 0x9e, 0xb8, 0xd2,
+	0x78, 0xfe, 0x8f, 0x5e, 0xac, 0xcf, 0xd4, 0xb9, 0x4e, 0x46, 0xf5, 0x4b, 0x84, 0x3a, 0xee, 0xe7,
+	0x4e, 0xd1, 0xb8, 0xba, 0x55, 0x72, 0x1c, 0x53, 0x32, 0xbe, 0x02, 0x52, 0x7b, 0x52, 0xa9, 0x4d,
+	0xb9, 0xd5, 0x2b, 0xf5, 0xca, 0x54, 0x65, 0x0e, 0x9e, 0x5b, 0xff, 0x93, 0x01, 0xd6, 0x2e, 0x99,
+	0xa2, 0xfb, 0xd0, 0xd9, 0x12, 0x5d, 0x94, 0x1b, 0xd5, 0xe6, 0x17, 0x77, 0xc1, 0x0b, 0x35, 0xdf,
+	0x65, 0xd0, 0x7e, 0x24, 0x5e, 0xc2, 0xf9, 0xf4, 0xca, 0xd2, 0xa6, 0x52, 0xd3, 0x04, 0xcf, 0x1d,
+	0x76, 0xc5, 0xc7, 0x3b, 0xff, 0x1e, 0x00, 0x4d, 0x95, 0x0d, 0x64, 0x8f, 0x2d, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// CityClient is the client API for City service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type CityClient interface {
 	// Returns a list of cities owned by the given character
-	List(ctx context.Context, in *ListReq, opts ...grpc.CallOption) (*ListOfNamedItems, error)
+	List(ctx context.Context, in *ListReq, opts ...grpc.CallOption) (*ListOfNamedItems, error)
+	// Returns a view of the City
+	// Be careful, the request might fail because of a too large object.
+	Show(ctx context.Context, in *CityId, opts ...grpc.CallOption) (*CityView, error)
+	Study(ctx context.Context, in *StudyReq, opts ...grpc.CallOption) (*None, error)
+	Build(ctx context.Context, in *BuildReq, opts ...grpc.CallOption) (*None, error)
+	Train(ctx context.Context, in *TrainReq, opts ...grpc.CallOption) (*None, error)
+	// Create an army around a set of units.
+	// The set of units must not be empty and all the units must stay in the given City.
+	CreateArmy(ctx context.Context, in *CreateArmyReq, opts ...grpc.CallOption) (*None, error)
+	// Send a pile of Resources to the target City with a transport Army, that
+	// may be intercepted on its way. The Stock must hold the Resources.
+	CreateTransport(ctx context.Context, in *CreateTransportReq, opts ...grpc.CallOption) (*None, error)
+	// Transfer a Unit from the given City to the given Army.
+	// The City must control the Army and the Unit must be in the City.
+	TransferUnit(ctx context.Context, in *TransferUnitReq, opts ...grpc.CallOption) (*None, error)
+	// Transfer a pile of Resources from the given City to the given Army.
+	// The City must control the Army and the Stock must hold the amount of Resources.
+	TransferResources(ctx context.Context, in *TransferResourcesReq, opts ...grpc.CallOption) (*None, error)
+	// Return the list of armies that can be controlled by the given City
+	ListArmies(ctx context.Context, in *CityId, opts ...grpc.CallOption) (*ListOfNamedItems, error)
+}
+
+type cityClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewCityClient(cc *grpc.ClientConn) CityClient {
+	return &cityClient{cc}
+}
+
+func (c *cityClient) List(ctx context.Context, in *ListReq, opts ...grpc.CallOption) (*ListOfNamedItems, error) {
+	out := new(ListOfNamedItems)
+	err := c.cc.Invoke(ctx, "/hegemonie.region.proto.City/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cityClient) Show(ctx context.Context, in *CityId, opts ...grpc.CallOption) (*CityView, error) {
+	out := new(CityView)
+	err := c.cc.Invoke(ctx, "/hegemonie.region.proto.City/Show", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cityClient) Study(ctx context.Context, in *StudyReq, opts ...grpc.CallOption) (*None, error) {
+	out := new(None)
+	err := c.cc.Invoke(ctx, "/hegemonie.region.proto.City/Study", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cityClient) Build(ctx context.Context, in *BuildReq, opts ...grpc.CallOption) (*None, error) {
+	out := new(None)
+	err := c.cc.Invoke(ctx, "/hegemonie.region.proto.City/Build", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cityClient) Train(ctx context.Context, in *TrainReq, opts ...grpc.CallOption) (*None, error) {
+	out := new(None)
+	err := c.cc.Invoke(ctx, "/hegemonie.region.proto.City/Train", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cityClient) CreateArmy(ctx context.Context, in *CreateArmyReq, opts ...grpc.CallOption) (*None, error) {
+	out := new(None)
+	err := c.cc.Invoke(ctx, "/hegemonie.region.proto.City/CreateArmy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cityClient) CreateTransport(ctx context.Context, in *CreateTransportReq, opts ...grpc.CallOption) (*None, error) {
+	out := new(None)
+	err := c.cc.Invoke(ctx, "/hegemonie.region.proto.City/CreateTransport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cityClient) TransferUnit(ctx context.Context, in *TransferUnitReq, opts ...grpc.CallOption) (*None, error) {
+	out := new(None)
+	err := c.cc.Invoke(ctx, "/hegemonie.region.proto.City/TransferUnit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cityClient) TransferResources(ctx context.Context, in *TransferResourcesReq, opts ...grpc.CallOption) (*None, error) {
+	out := new(None)
+	err := c.cc.Invoke(ctx, "/hegemonie.region.proto.City/TransferResources", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cityClient) ListArmies(ctx context.Context, in *CityId, opts ...grpc.CallOption) (*ListOfNamedItems, error) {
+	out := new(ListOfNamedItems)
+	err := c.cc.Invoke(ctx, "/hegemonie.region.proto.City/ListArmies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CityServer is the server API for City service.
+type CityServer interface {
+	// Returns a list of cities owned by the given character
+	List(context.Context, *ListReq) (*ListOfNamedItems, error)
 	// Returns a view of the City
 	// Be careful, the request might fail because of a too large object.
-	Show(ctx context.Context, in *CityId, opts ...grpc.CallOption) (*CityView, error)
-	Study(ctx context.Context, in *StudyReq, opts ...grpc.CallOption) (*None, error)
-	Build(ctx context.Context, in *BuildReq, opts ...grpc.CallOption) (*None, error)
-	Train(ctx context.Context, in *TrainReq, opts ...grpc.CallOption) (*None, error)
+	Show(context.Context, *CityId) (*CityView, error)
+	Study(context.Context, *StudyReq) (*None, error)
+	Build(context.Context, *BuildReq) (*None, error)
+	Train(context.Context, *TrainReq) (*None, error)
 	// Create an army around a set of units.
 	// The set of units must not be empty and all the units must stay in the given City.
-	CreateArmy(ctx context.Context, in *CreateArmyReq, opts ...grpc.CallOption) (*None, error)
-	CreateTransport(ctx context.Context, in *CreateTransportReq, opts ...grpc.CallOption) (*None, error)
+	CreateArmy(context.Context, *CreateArmyReq) (*None, error)
+	// Send a pile of Resources to the target City with a transport Army, that
+	// may be intercepted on its way. The Stock must hold the Resources.
+	CreateTransport(context.Context, *CreateTransportReq) (*None, error)
 	// Transfer a Unit from the given City to the given Army.
 	// The City must control the Army and the Unit must be in the City.
-	TransferUnit(ctx context.Context, in *TransferUnitReq, opts ...grpc.CallOption) (*None, error)
+	TransferUnit(context.Context, *TransferUnitReq) (*None, error)
 	// Transfer a pile of Resources from the given City to the given Army.
 	// The City must control the Army and the Stock must hold the amount of Resources.
-	TransferResources(ctx context.Context, in *TransferResourcesReq, opts ...grpc.CallOption) (*None, error)
+	TransferResources(context.Context, *TransferResourcesReq) (*None, error)
 	// Return the list of armies that can be controlled by the given City
-	ListArmies(ctx context.Context, in *CityId, opts ...grpc.CallOption) (*ListOfNamedItems, error)
+	ListArmies(context.Context, *CityId) (*ListOfNamedItems, error)
+}
+
+// UnimplementedCityServer can be embedded to have forward compatible implementations.
+type UnimplementedCityServer struct {
+}
+
+func (*UnimplementedCityServer) List(ctx context.Context, req *ListReq) (*ListOfNamedItems, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (*UnimplementedCityServer) Show(ctx context.Context, req *CityId) (*CityView, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Show not implemented")
+}
+func (*UnimplementedCityServer) Study(ctx context.Context, req *StudyReq) (*None, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Study not implemented")
+}
+func (*UnimplementedCityServer) Build(ctx context.Context, req *BuildReq) (*None, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Build not implemented")
+}
+func (*UnimplementedCityServer) Train(ctx context.Context, req *TrainReq) (*None, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Train not implemented")
+}
+func (*UnimplementedCityServer) CreateArmy(ctx context.Context, req *CreateArmyReq) (*None, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateArmy not implemented")
+}
+func (*UnimplementedCityServer) CreateTransport(ctx context.Context, req *CreateTransportReq) (*None, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTransport not implemented")
+}
+func (*UnimplementedCityServer) TransferUnit(ctx context.Context, req *TransferUnitReq) (*None, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferUnit not implemented")
+}
+func (*UnimplementedCityServer) TransferResources(ctx context.Context, req *TransferResourcesReq) (*None, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferResources not implemented")
+}
+func (*UnimplementedCityServer) ListArmies(ctx context.Context, req *CityId) (*ListOfNamedItems, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListArmies not implemented")
+}
+
+func RegisterCityServer(s *grpc.Server, srv CityServer) {
+	s.RegisterService(&_City_serviceDesc, srv)
+}
+
+func _City_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CityServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hegemonie.region.proto.City/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CityServer).List(ctx, req.(*ListReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _City_Show_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CityId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CityServer).Show(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hegemonie.region.proto.City/Show",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CityServer).Show(ctx, req.(*CityId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _City_Study_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StudyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CityServer).Study(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hegemonie.region.proto.City/Study",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CityServer).Study(ctx, req.(*StudyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _City_Build_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BuildReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CityServer).Build(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hegemonie.region.proto.City/Build",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CityServer).Build(ctx, req.(*BuildReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _City_Train_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TrainReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CityServer).Train(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hegemonie.region.proto.City/Train",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CityServer).Train(ctx, req.(*TrainReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _City_CreateArmy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateArmyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CityServer).CreateArmy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hegemonie.region.proto.City/CreateArmy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CityServer).CreateArmy(ctx, req.(*CreateArmyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _City_CreateTransport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTransportReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CityServer).CreateTransport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hegemonie.region.proto.City/CreateTransport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CityServer).CreateTransport(ctx, req.(*CreateTransportReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _City_TransferUnit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferUnitReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CityServer).TransferUnit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hegemonie.region.proto.City/TransferUnit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CityServer).TransferUnit(ctx, req.(*TransferUnitReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _City_TransferResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferResourcesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CityServer).TransferResources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hegemonie.region.proto.City/TransferResources",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CityServer).TransferResources(ctx, req.(*TransferResourcesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _City_ListArmies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CityId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CityServer).ListArmies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hegemonie.region.proto.City/ListArmies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CityServer).ListArmies(ctx, req.(*CityId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _City_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "hegemonie.region.proto.City",
+	HandlerType: (*CityServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "List",
+			Handler:    _City_List_Handler,
+		},
+		{
+			MethodName: "Show",
+			Handler:    _City_Show_Handler,
+		},
+		{
+			MethodName: "Study",
+			Handler:    _City_Study_Handler,
+		},
+		{
+			MethodName: "Build",
+			Handler:    _City_Build_Handler,
+		},
+		{
+			MethodName: "Train",
+			Handler:    _City_Train_Handler,
+		},
+		{
+			MethodName: "CreateArmy",
+			Handler:    _City_CreateArmy_Handler,
+		},
+		{
+			MethodName: "CreateTransport",
+			Handler:    _City_CreateTransport_Handler,
+		},
+		{
+			MethodName: "TransferUnit",
+			Handler:    _City_TransferUnit_Handler,
+		},
+		{
+			MethodName: "TransferResources",
+			Handler:    _City_TransferResources_Handler,
+		},
+		{
+			MethodName: "ListArmies",
+			Handler:    _City_ListArmies_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "region.proto",
+}
+
+// DefinitionsClient is the client API for Definitions service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type DefinitionsClient interface {
+	ListUnits(ctx context.Context, in *PaginatedQuery, opts ...grpc.CallOption) (*ListOfUnitTypes, error)
+	ListBuildings(ctx context.Context, in *PaginatedQuery, opts ...grpc.CallOption) (*ListOfBuildingTypes, error)
+	ListKnowledges(ctx context.Context, in *PaginatedQuery, opts ...grpc.CallOption) (*ListOfKnowledgeTypes, error)
+}
+
+type definitionsClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewDefinitionsClient(cc *grpc.ClientConn) DefinitionsClient {
+	return &definitionsClient{cc}
+}
+
+func (c *definitionsClient) ListUnits(ctx context.Context, in *PaginatedQuery, opts ...grpc.CallOption) (*ListOfUnitTypes, error) {
+	out := new(ListOfUnitTypes)
+	err := c.cc.Invoke(ctx, "/hegemonie.region.proto.Definitions/ListUnits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *definitionsClient) ListBuildings(ctx context.Context, in *PaginatedQuery, opts ...grpc.CallOption) (*ListOfBuildingTypes, error) {
+	out := new(ListOfBuildingTypes)
+	err := c.cc.Invoke(ctx, "/hegemonie.region.proto.Definitions/ListBuildings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *definitionsClient) ListKnowledges(ctx context.Context, in *PaginatedQuery, opts ...grpc.CallOption) (*ListOfKnowledgeTypes, error) {
+	out := new(ListOfKnowledgeTypes)
+	err := c.cc.Invoke(ctx, "/hegemonie.region.proto.Definitions/ListKnowledges", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DefinitionsServer is the server API for Definitions service.
+type DefinitionsServer interface {
+	ListUnits(context.Context, *PaginatedQuery) (*ListOfUnitTypes, error)
+	ListBuildings(context.Context, *PaginatedQuery) (*ListOfBuildingTypes, error)
+	ListKnowledges(context.Context, *PaginatedQuery) (*ListOfKnowledgeTypes, error)
+}
+
+// UnimplementedDefinitionsServer can be embedded to have forward compatible implementations.
+type UnimplementedDefinitionsServer struct {
+}
+
+func (*UnimplementedDefinitionsServer) ListUnits(ctx context.Context, req *PaginatedQuery) (*ListOfUnitTypes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUnits not implemented")
+}
+func (*UnimplementedDefinitionsServer) ListBuildings(ctx context.Context, req *PaginatedQuery) (*ListOfBuildingTypes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBuildings not implemented")
+}
+func (*UnimplementedDefinitionsServer) ListKnowledges(ctx context.Context, req *PaginatedQuery) (*ListOfKnowledgeTypes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListKnowledges not implemented")
+}
+
+func RegisterDefinitionsServer(s *grpc.Server, srv DefinitionsServer) {
+	s.RegisterService(&_Definitions_serviceDesc, srv)
+}
+
+func _Definitions_ListUnits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PaginatedQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DefinitionsServer).ListUnits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hegemonie.region.proto.Definitions/ListUnits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DefinitionsServer).ListUnits(ctx, req.(*PaginatedQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Definitions_ListBuildings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PaginatedQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DefinitionsServer).ListBuildings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hegemonie.region.proto.Definitions/ListBuildings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DefinitionsServer).ListBuildings(ctx, req.(*PaginatedQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Definitions_ListKnowledges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PaginatedQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DefinitionsServer).ListKnowledges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hegemonie.region.proto.Definitions/ListKnowledges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DefinitionsServer).ListKnowledges(ctx, req.(*PaginatedQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Definitions_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "hegemonie.region.proto.Definitions",
+	HandlerType: (*DefinitionsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListUnits",
+			Handler:    _Definitions_ListUnits_Handler,
+		},
+		{
+			MethodName: "ListBuildings",
+			Handler:    _Definitions_ListBuildings_Handler,
+		},
+		{
+			MethodName: "ListKnowledges",
+			Handler:    _Definitions_ListKnowledges_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "region.proto",
+}
+
+// AdminClient is the client API for Admin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type AdminClient interface {
+	// Have all the Cities on the Region to produce their resources
+	Produce(ctx context.Context, in *None, opts ...grpc.CallOption) (*None, error)
+	// Make all the armies on the Region to move on step
+	Move(ctx context.Context, in *None, opts ...grpc.CallOption) (*None, error)
+	// Return the score of all the Cities of the Region, from the best to the worst
+	GetScores(ctx context.Context, in *None, opts ...grpc.CallOption) (*ScoreBoard, error)
+	// Dump the current state of the Region in its live directory
+	Save(ctx context.Context, in *None, opts ...grpc.CallOption) (*None, error)
+	// Return the state of the clock of the Region
+	GetClock(ctx context.Context, in *None, opts ...grpc.CallOption) (*ClockView, error)
+	// Suspend the scheduled ticks. The manual Produce and Move still work.
+	Pause(ctx context.Context, in *None, opts ...grpc.CallOption) (*None, error)
+	// Resume the scheduled ticks, from now on.
+	Resume(ctx context.Context, in *None, opts ...grpc.CallOption) (*None, error)
+	// Play immediately the given number of movement ticks, with the
+	// production rounds scheduled in between.
+	CatchUp(ctx context.Context, in *CatchUpReq, opts ...grpc.CallOption) (*ClockView, error)
+}
+
+type adminClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewAdminClient(cc *grpc.ClientConn) AdminClient {
+	return &adminClient{cc}
+}
+
+func (c *adminClient) Produce(ctx context.Context, in *None, opts ...grpc.CallOption) (*None, error) {
+	out := new(None)
+	err := c.cc.Invoke(ctx, "/hegemonie.region.proto.Admin/Produce", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) Move(ctx context.Context, in *None, opts ...grpc.CallOption) (*None, error) {
+	out := new(None)
+	err := c.cc.Invoke(ctx, "/hegemonie.region.proto.Admin/Move", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-type cityClient struct {
-	cc *grpc.ClientConn
+func (c *adminClient) GetScores(ctx context.Context, in *None, opts ...grpc.CallOption) (*ScoreBoard, error) {
+	out := new(ScoreBoard)
+	err := c.cc.Invoke(ctx, "/hegemonie.region.proto.Admin/GetScores", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func NewCityClient(cc *grpc.ClientConn) CityClient {
-	return &cityClient{cc}
+func (c *adminClient) Save(ctx context.Context, in *None, opts ...grpc.CallOption) (*None, error) {
+	out := new(None)
+	err := c.cc.Invoke(ctx, "/hegemonie.region.proto.Admin/Save", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (c *cityClient) List(ctx context.Context, in *ListReq, opts ...grpc.CallOption) (*ListOfNamedItems, error) {
-	out := new(ListOfNamedItems)
-	err := c.cc.Invoke(ctx, "/hegemonie.region.proto.City/List", in, out, opts...)
+func (c *adminClient) GetClock(ctx context.Context, in *None, opts ...grpc.CallOption) (*ClockView, error) {
+	out := new(ClockView)
+	err := c.cc.Invoke(ctx, "/hegemonie.region.proto.Admin/GetClock", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *cityClient) Show(ctx context.Context, in *CityId, opts ...grpc.CallOption) (*CityView, error) {
-	out := new(CityView)
-	err := c.cc.Invoke(ctx, "/hegemonie.region.proto.City/Show", in, out, opts...)
+func (c *adminClient) Pause(ctx context.Context, in *None, opts ...grpc.CallOption) (*None, error) {
+	out := new(None)
+	err := c.cc.Invoke(ctx, "/hegemonie.region.proto.Admin/Pause", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *cityClient) Study(ctx context.Context, in *StudyReq, opts ...grpc.CallOption) (*None, error) {
+func (c *adminClient) Resume(ctx context.Context, in *None, opts ...grpc.CallOption) (*None, error) {
 	out := new(None)
-	err := c.cc.Invoke(ctx, "/hegemonie.region.proto.City/Study", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/hegemonie.region.proto.Admin/Resume", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *cityClient) Build(ctx context.Context, in *BuildReq, opts ...grpc.CallOption) (*None, error) {
+func (c *adminClient) CatchUp(ctx context.Context, in *CatchUpReq, opts ...grpc.CallOption) (*ClockView, error) {
+	out := new(ClockView)
+	err := c.cc.Invoke(ctx, "/hegemonie.region.proto.Admin/CatchUp", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AdminServer is the server API for Admin service.
+type AdminServer interface {
+	// Have all the Cities on the Region to produce their resources
+	Produce(context.Context, *None) (*None, error)
+	// Make all the armies on the Region to move on step
+	Move(context.Context, *None) (*None, error)
+	// Return the score of all the Cities of the Region, from the best to the worst
+	GetScores(context.Context, *None) (*ScoreBoard, error)
+	// Dump the current state of the Region in its live directory
+	Save(context.Context, *None) (*None, error)
+	// Return the state of the clock of the Region
+	GetClock(context.Context, *None) (*ClockView, error)
+	// Suspend the scheduled ticks. The manual Produce and Move still work.
+	Pause(context.Context, *None) (*None, error)
+	// Resume the scheduled ticks, from now on.
+	Resume(context.Context, *None) (*None, error)
+	// Play immediately the given number of movement ticks, with the
+	// production rounds scheduled in between.
+	CatchUp(context.Context, *CatchUpReq) (*ClockView, error)
+}
+
+// UnimplementedAdminServer can be embedded to have forward compatible implementations.
+type UnimplementedAdminServer struct {
+}
+
+func (*UnimplementedAdminServer) Produce(ctx context.Context, req *None) (*None, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Produce not implemented")
+}
+func (*UnimplementedAdminServer) Move(ctx context.Context, req *None) (*None, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Move not implemented")
+}
+func (*UnimplementedAdminServer) GetScores(ctx context.Context, req *None) (*ScoreBoard, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetScores not implemented")
+}
+func (*UnimplementedAdminServer) Save(ctx context.Context, req *None) (*None, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Save not implemented")
+}
+func (*UnimplementedAdminServer) GetClock(ctx context.Context, req *None) (*ClockView, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClock not implemented")
+}
+func (*UnimplementedAdminServer) Pause(ctx context.Context, req *None) (*None, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pause not implemented")
+}
+func (*UnimplementedAdminServer) Resume(ctx context.Context, req *None) (*None, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Resume not implemented")
+}
+func (*UnimplementedAdminServer) CatchUp(ctx context.Context, req *CatchUpReq) (*ClockView, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CatchUp not implemented")
+}
+
+func RegisterAdminServer(s *grpc.Server, srv AdminServer) {
+	s.RegisterService(&_Admin_serviceDesc, srv)
+}
+
+func _Admin_Produce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(None)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).Produce(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hegemonie.region.proto.Admin/Produce",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).Produce(ctx, req.(*None))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_Move_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(None)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).Move(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hegemonie.region.proto.Admin/Move",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).Move(ctx, req.(*None))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_GetScores_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(None)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).GetScores(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hegemonie.region.proto.Admin/GetScores",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).GetScores(ctx, req.(*None))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_Save_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(None)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).Save(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hegemonie.region.proto.Admin/Save",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).Save(ctx, req.(*None))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_GetClock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(None)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).GetClock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hegemonie.region.proto.Admin/GetClock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).GetClock(ctx, req.(*None))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_Pause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(None)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).Pause(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hegemonie.region.proto.Admin/Pause",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).Pause(ctx, req.(*None))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_Resume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(None)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).Resume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hegemonie.region.proto.Admin/Resume",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).Resume(ctx, req.(*None))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_CatchUp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CatchUpReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).CatchUp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hegemonie.region.proto.Admin/CatchUp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).CatchUp(ctx, req.(*CatchUpReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Admin_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "hegemonie.region.proto.Admin",
+	HandlerType: (*AdminServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Produce",
+			Handler:    _Admin_Produce_Handler,
+		},
+		{
+			MethodName: "Move",
+			Handler:    _Admin_Move_Handler,
+		},
+		{
+			MethodName: "GetScores",
+			Handler:    _Admin_GetScores_Handler,
+		},
+		{
+			MethodName: "Save",
+			Handler:    _Admin_Save_Handler,
+		},
+		{
+			MethodName: "GetClock",
+			Handler:    _Admin_GetClock_Handler,
+		},
+		{
+			MethodName: "Pause",
+			Handler:    _Admin_Pause_Handler,
+		},
+		{
+			MethodName: "Resume",
+			Handler:    _Admin_Resume_Handler,
+		},
+		{
+			MethodName: "CatchUp",
+			Handler:    _Admin_CatchUp_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "region.proto",
+}
+
+// ArmyClient is the client API for Army service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ArmyClient interface {
+	// Return a detailed view of the given Army
+	Show(ctx context.Context, in *ArmyId, opts ...grpc.CallOption) (*ArmyView, error)
+	// Make the Army flea the fight it is involved in.
+	Flea(ctx context.Context, in *ArmyId, opts ...grpc.CallOption) (*None, error)
+	// Make the Army flip in the fight it is involved in.
+	Flip(ctx context.Context, in *ArmyId, opts ...grpc.CallOption) (*None, error)
+	// Append the specified command on the list of the Army.
+	Command(ctx context.Context, in *ArmyCommandReq, opts ...grpc.CallOption) (*None, error)
+	// Return the commands queued for the Army, in their order of execution.
+	ListCommands(ctx context.Context, in *ArmyId, opts ...grpc.CallOption) (*ListOfArmyCommands, error)
+	// Move a command at another position of the queue of the Army.
+	ReorderCommand(ctx context.Context, in *ArmyCommandReorderReq, opts ...grpc.CallOption) (*None, error)
+	// Remove a command from the queue of the Army.
+	CancelCommand(ctx context.Context, in *ArmyCommandCancelReq, opts ...grpc.CallOption) (*None, error)
+	// Set the posture of the Army against a City, or its default posture.
+	SetPosture(ctx context.Context, in *ArmyPostureReq, opts ...grpc.CallOption) (*None, error)
+}
+
+type armyClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewArmyClient(cc *grpc.ClientConn) ArmyClient {
+	return &armyClient{cc}
+}
+
+func (c *armyClient) Show(ctx context.Context, in *ArmyId, opts ...grpc.CallOption) (*ArmyView, error) {
+	out := new(ArmyView)
+	err := c.cc.Invoke(ctx, "/hegemonie.region.proto.Army/Show", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *armyClient) Flea(ctx context.Context, in *ArmyId, opts ...grpc.CallOption) (*None, error) {
 	out := new(None)
-	err := c.cc.Invoke(ctx, "/hegemonie.region.proto.City/Build", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/hegemonie.region.proto.Army/Flea", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *cityClient) Train(ctx context.Context, in *TrainReq, opts ...grpc.CallOption) (*None, error) {
+func (c *armyClient) Flip(ctx context.Context, in *ArmyId, opts ...grpc.CallOption) (*None, error) {
 	out := new(None)
-	err := c.cc.Invoke(ctx, "/hegemonie.region.proto.City/Train", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/hegemonie.region.proto.Army/Flip", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *cityClient) CreateArmy(ctx context.Context, in *CreateArmyReq, opts ...grpc.CallOption) (*None, error) {
+func (c *armyClient) Command(ctx context.Context, in *ArmyCommandReq, opts ...grpc.CallOption) (*None, error) {
 	out := new(None)
-	err := c.cc.Invoke(ctx, "/hegemonie.region.proto.City/CreateArmy", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/hegemonie.region.proto.Army/Command", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *cityClient) CreateTransport(ctx context.Context, in *CreateTransportReq, opts ...grpc.CallOption) (*None, error) {
-	out := new(None)
-	err := c.cc.Invoke(ctx, "/hegemonie.region.proto.City/CreateTransport", in, out, opts...)
+func (c *armyClient) ListCommands(ctx context.Context, in *ArmyId, opts ...grpc.CallOption) (*ListOfArmyCommands, error) {
+	out := new(ListOfArmyCommands)
+	err := c.cc.Invoke(ctx, "/hegemonie.region.proto.Army/ListCommands", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *cityClient) TransferUnit(ctx context.Context, in *TransferUnitReq, opts ...grpc.CallOption) (*None, error) {
+func (c *armyClient) ReorderCommand(ctx context.Context, in *ArmyCommandReorderReq, opts ...grpc.CallOption) (*None, error) {
 	out := new(None)
-	err := c.cc.Invoke(ctx, "/hegemonie.region.proto.City/TransferUnit", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/hegemonie.region.proto.Army/ReorderCommand", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *cityClient) TransferResources(ctx context.Context, in *TransferResourcesReq, opts ...grpc.CallOption) (*None, error) {
+func (c *armyClient) CancelCommand(ctx context.Context, in *ArmyCommandCancelReq, opts ...grpc.CallOption) (*None, error) {
 	out := new(None)
-	err := c.cc.Invoke(ctx, "/hegemonie.region.proto.City/TransferResources", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/hegemonie.region.proto.Army/CancelCommand", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *cityClient) ListArmies(ctx context.Context, in *CityId, opts ...grpc.CallOption) (*ListOfNamedItems, error) {
-	out := new(ListOfNamedItems)
-	err := c.cc.Invoke(ctx, "/hegemonie.region.proto.City/ListArmies", in, out, opts...)
+func (c *armyClient) SetPosture(ctx context.Context, in *ArmyPostureReq, opts ...grpc.CallOption) (*None, error) {
+	out := new(None)
+	err := c.cc.Invoke(ctx, "/hegemonie.region.proto.Army/SetPosture", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// CityServer is the server API for City service.
-type CityServer interface {
-	// Returns a list of cities owned by the given character
-	List(context.Context, *ListReq) (*ListOfNamedItems, error)
-	// Returns a view of the City
-	// Be careful, the request might fail because of a too large object.
-	Show(context.Context, *CityId) (*CityView, error)
-	Study(context.Context, *StudyReq) (*None, error)
-	Build(context.Context, *BuildReq) (*None, error)
-	Train(context.Context, *TrainReq) (*None, error)
-	// Create an army around a set of units.
-	// The set of units must not be empty and all the units must stay in the given City.
-	CreateArmy(context.Context, *CreateArmyReq) (*None, error)
-	CreateTransport(context.Context, *CreateTransportReq) (*None, error)
-	// Transfer a Unit from the given City to the given Army.
-	// The City must control the Army and the Unit must be in the City.
-	TransferUnit(context.Context, *TransferUnitReq) (*None, error)
-	// Transfer a pile of Resources from the given City to the given Army.
-	// The City must control the Army and the Stock must hold the amount of Resources.
-	TransferResources(context.Context, *TransferResourcesReq) (*None, error)
-	// Return the list of armies that can be controlled by the given City
-	ListArmies(context.Context, *CityId) (*ListOfNamedItems, error)
+// ArmyServer is the server API for Army service.
+type ArmyServer interface {
+	// Return a detailed view of the given Army
+	Show(context.Context, *ArmyId) (*ArmyView, error)
+	// Make the Army flea the fight it is involved in.
+	Flea(context.Context, *ArmyId) (*None, error)
+	// Make the Army flip in the fight it is involved in.
+	Flip(context.Context, *ArmyId) (*None, error)
+	// Append the specified command on the list of the Army.
+	Command(context.Context, *ArmyCommandReq) (*None, error)
+	// Return the commands queued for the Army, in their order of execution.
+	ListCommands(context.Context, *ArmyId) (*ListOfArmyCommands, error)
+	// Move a command at another position of the queue of the Army.
+	ReorderCommand(context.Context, *ArmyCommandReorderReq) (*None, error)
+	// Remove a command from the queue of the Army.
+	CancelCommand(context.Context, *ArmyCommandCancelReq) (*None, error)
+	// Set the posture of the Army against a City, or its default posture.
+	SetPosture(context.Context, *ArmyPostureReq) (*None, error)
 }
 
-// UnimplementedCityServer can be embedded to have forward compatible implementations.
-type UnimplementedCityServer struct {
+// UnimplementedArmyServer can be embedded to have forward compatible implementations.
+type UnimplementedArmyServer struct {
 }
 
-func (*UnimplementedCityServer) List(ctx context.Context, req *ListReq) (*ListOfNamedItems, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
-}
-func (*UnimplementedCityServer) Show(ctx context.Context, req *CityId) (*CityView, error) {
+func (*UnimplementedArmyServer) Show(ctx context.Context, req *ArmyId) (*ArmyView, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Show not implemented")
 }
-func (*UnimplementedCityServer) Study(ctx context.Context, req *StudyReq) (*None, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Study not implemented")
-}
-func (*UnimplementedCityServer) Build(ctx context.Context, req *BuildReq) (*None, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Build not implemented")
-}
-func (*UnimplementedCityServer) Train(ctx context.Context, req *TrainReq) (*None, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Train not implemented")
-}
-func (*UnimplementedCityServer) CreateArmy(ctx context.Context, req *CreateArmyReq) (*None, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateArmy not implemented")
+func (*UnimplementedArmyServer) Flea(ctx context.Context, req *ArmyId) (*None, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Flea not implemented")
 }
-func (*UnimplementedCityServer) CreateTransport(ctx context.Context, req *CreateTransportReq) (*None, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateTransport not implemented")
+func (*UnimplementedArmyServer) Flip(ctx context.Context, req *ArmyId) (*None, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Flip not implemented")
 }
-func (*UnimplementedCityServer) TransferUnit(ctx context.Context, req *TransferUnitReq) (*None, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TransferUnit not implemented")
+func (*UnimplementedArmyServer) Command(ctx context.Context, req *ArmyCommandReq) (*None, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Command not implemented")
 }
-func (*UnimplementedCityServer) TransferResources(ctx context.Context, req *TransferResourcesReq) (*None, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TransferResources not implemented")
+func (*UnimplementedArmyServer) ListCommands(ctx context.Context, req *ArmyId) (*ListOfArmyCommands, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCommands not implemented")
 }
-func (*UnimplementedCityServer) ListArmies(ctx context.Context, req *CityId) (*ListOfNamedItems, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListArmies not implemented")
+func (*UnimplementedArmyServer) ReorderCommand(ctx context.Context, req *ArmyCommandReorderReq) (*None, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderCommand not implemented")
 }
-
-func RegisterCityServer(s *grpc.Server, srv CityServer) {
-	s.RegisterService(&_City_serviceDesc, srv)
+func (*UnimplementedArmyServer) CancelCommand(ctx context.Context, req *ArmyCommandCancelReq) (*None, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelCommand not implemented")
 }
-
-func _City_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListReq)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(CityServer).List(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/hegemonie.region.proto.City/List",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CityServer).List(ctx, req.(*ListReq))
-	}
-	return interceptor(ctx, in, info, handler)
+func (*UnimplementedArmyServer) SetPosture(ctx context.Context, req *ArmyPostureReq) (*None, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPosture not implemented")
 }
 
-func _City_Show_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CityId)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(CityServer).Show(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/hegemonie.region.proto.City/Show",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CityServer).Show(ctx, req.(*CityId))
-	}
-	return interceptor(ctx, in, info, handler)
+func RegisterArmyServer(s *grpc.Server, srv ArmyServer) {
+	s.RegisterService(&_Army_serviceDesc, srv)
 }
 
-func _City_Study_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StudyReq)
+func _Army_Show_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArmyId)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CityServer).Study(ctx, in)
+		return srv.(ArmyServer).Show(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/hegemonie.region.proto.City/Study",
+		FullMethod: "/hegemonie.region.proto.Army/Show",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CityServer).Study(ctx, req.(*StudyReq))
+		return srv.(ArmyServer).Show(ctx, req.(*ArmyId))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _City_Build_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BuildReq)
+func _Army_Flea_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArmyId)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CityServer).Build(ctx, in)
+		return srv.(ArmyServer).Flea(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/hegemonie.region.proto.City/Build",
+		FullMethod: "/hegemonie.region.proto.Army/Flea",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CityServer).Build(ctx, req.(*BuildReq))
+		return srv.(ArmyServer).Flea(ctx, req.(*ArmyId))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _City_Train_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TrainReq)
+func _Army_Flip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArmyId)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CityServer).Train(ctx, in)
+		return srv.(ArmyServer).Flip(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/hegemonie.region.proto.City/Train",
+		FullMethod: "/hegemonie.region.proto.Army/Flip",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CityServer).Train(ctx, req.(*TrainReq))
+		return srv.(ArmyServer).Flip(ctx, req.(*ArmyId))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _City_CreateArmy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateArmyReq)
+func _Army_Command_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArmyCommandReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CityServer).CreateArmy(ctx, in)
+		return srv.(ArmyServer).Command(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/hegemonie.region.proto.City/CreateArmy",
+		FullMethod: "/hegemonie.region.proto.Army/Command",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CityServer).CreateArmy(ctx, req.(*CreateArmyReq))
+		return srv.(ArmyServer).Command(ctx, req.(*ArmyCommandReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _City_CreateTransport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateTransportReq)
+func _Army_ListCommands_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArmyId)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CityServer).CreateTransport(ctx, in)
+		return srv.(ArmyServer).ListCommands(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/hegemonie.region.proto.City/CreateTransport",
+		FullMethod: "/hegemonie.region.proto.Army/ListCommands",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CityServer).CreateTransport(ctx, req.(*CreateTransportReq))
+		return srv.(ArmyServer).ListCommands(ctx, req.(*ArmyId))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _City_TransferUnit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TransferUnitReq)
+func _Army_ReorderCommand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArmyCommandReorderReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CityServer).TransferUnit(ctx, in)
+		return srv.(ArmyServer).ReorderCommand(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/hegemonie.region.proto.City/TransferUnit",
+		FullMethod: "/hegemonie.region.proto.Army/ReorderCommand",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CityServer).TransferUnit(ctx, req.(*TransferUnitReq))
+		return srv.(ArmyServer).ReorderCommand(ctx, req.(*ArmyCommandReorderReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _City_TransferResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TransferResourcesReq)
+func _Army_CancelCommand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArmyCommandCancelReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CityServer).TransferResources(ctx, in)
+		return srv.(ArmyServer).CancelCommand(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/hegemonie.region.proto.City/TransferResources",
+		FullMethod: "/hegemonie.region.proto.Army/CancelCommand",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CityServer).TransferResources(ctx, req.(*TransferResourcesReq))
+		return srv.(ArmyServer).CancelCommand(ctx, req.(*ArmyCommandCancelReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _City_ListArmies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CityId)
+func _Army_SetPosture_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArmyPostureReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CityServer).ListArmies(ctx, in)
+		return srv.(ArmyServer).SetPosture(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/hegemonie.region.proto.City/ListArmies",
+		FullMethod: "/hegemonie.region.proto.Army/SetPosture",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CityServer).ListArmies(ctx, req.(*CityId))
+		return srv.(ArmyServer).SetPosture(ctx, req.(*ArmyPostureReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var _City_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "hegemonie.region.proto.City",
-	HandlerType: (*CityServer)(nil),
+var _Army_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "hegemonie.region.proto.Army",
+	HandlerType: (*ArmyServer)(nil),
 	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "List",
-			Handler:    _City_List_Handler,
-		},
 		{
 			MethodName: "Show",
-			Handler:    _City_Show_Handler,
-		},
-		{
-			MethodName: "Study",
-			Handler:    _City_Study_Handler,
+			Handler:    _Army_Show_Handler,
 		},
 		{
-			MethodName: "Build",
-			Handler:    _City_Build_Handler,
+			MethodName: "Flea",
+			Handler:    _Army_Flea_Handler,
 		},
 		{
-			MethodName: "Train",
-			Handler:    _City_Train_Handler,
+			MethodName: "Flip",
+			Handler:    _Army_Flip_Handler,
 		},
 		{
-			MethodName: "CreateArmy",
-			Handler:    _City_CreateArmy_Handler,
+			MethodName: "Command",
+			Handler:    _Army_Command_Handler,
 		},
 		{
-			MethodName: "CreateTransport",
-			Handler:    _City_CreateTransport_Handler,
+			MethodName: "ListCommands",
+			Handler:    _Army_ListCommands_Handler,
 		},
 		{
-			MethodName: "TransferUnit",
-			Handler:    _City_TransferUnit_Handler,
+			MethodName: "ReorderCommand",
+			Handler:    _Army_ReorderCommand_Handler,
 		},
 		{
-			MethodName: "TransferResources",
-			Handler:    _City_TransferResources_Handler,
+			MethodName: "CancelCommand",
+			Handler:    _Army_CancelCommand_Handler,
 		},
 		{
-			MethodName: "ListArmies",
-			Handler:    _City_ListArmies_Handler,
+			MethodName: "SetPosture",
+			Handler:    _Army_SetPosture_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "region.proto",
 }
 
-// DefinitionsClient is the client API for Definitions service.
+// HandoffClient is the client API for Handoff service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type DefinitionsClient interface {
-	ListUnits(ctx context.Context, in *PaginatedQuery, opts ...grpc.CallOption) (*ListOfUnitTypes, error)
-	ListBuildings(ctx context.Context, in *PaginatedQuery, opts ...grpc.CallOption) (*ListOfBuildingTypes, error)
-	ListKnowledges(ctx context.Context, in *PaginatedQuery, opts ...grpc.CallOption) (*ListOfKnowledgeTypes, error)
+type HandoffClient interface {
+	// Accept the Army, idempotent for the same transfer. Return the ID of the
+	// Army in the Region it enters.
+	Accept(ctx context.Context, in *HandoffReq, opts ...grpc.CallOption) (*HandoffRep, error)
+	// Let the accepted Army enter the map, idempotent as well
+	Commit(ctx context.Context, in *HandoffId, opts ...grpc.CallOption) (*None, error)
 }
 
-type definitionsClient struct {
+type handoffClient struct {
 	cc *grpc.ClientConn
 }
 
-func NewDefinitionsClient(cc *grpc.ClientConn) DefinitionsClient {
-	return &definitionsClient{cc}
-}
-
-func (c *definitionsClient) ListUnits(ctx context.Context, in *PaginatedQuery, opts ...grpc.CallOption) (*ListOfUnitTypes, error) {
-	out := new(ListOfUnitTypes)
-	err := c.cc.Invoke(ctx, "/hegemonie.region.proto.Definitions/ListUnits", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func NewHandoffClient(cc *grpc.ClientConn) HandoffClient {
+	return &handoffClient{cc}
 }
 
-func (c *definitionsClient) ListBuildings(ctx context.Context, in *PaginatedQuery, opts ...grpc.CallOption) (*ListOfBuildingTypes, error) {
-	out := new(ListOfBuildingTypes)
-	err := c.cc.Invoke(ctx, "/hegemonie.region.proto.Definitions/ListBuildings", in, out, opts...)
+func (c *handoffClient) Accept(ctx context.Context, in *HandoffReq, opts ...grpc.CallOption) (*HandoffRep, error) {
+	out := new(HandoffRep)
+	err := c.cc.Invoke(ctx, "/hegemonie.region.proto.Handoff/Accept", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *definitionsClient) ListKnowledges(ctx context.Context, in *PaginatedQuery, opts ...grpc.CallOption) (*ListOfKnowledgeTypes, error) {
-	out := new(ListOfKnowledgeTypes)
-	err := c.cc.Invoke(ctx, "/hegemonie.region.proto.Definitions/ListKnowledges", in, out, opts...)
+func (c *handoffClient) Commit(ctx context.Context, in *HandoffId, opts ...grpc.CallOption) (*None, error) {
+	out := new(None)
+	err := c.cc.Invoke(ctx, "/hegemonie.region.proto.Handoff/Commit", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
-
-// DefinitionsServer is the server API for Definitions service.
-type DefinitionsServer interface {
-	ListUnits(context.Context, *PaginatedQuery) (*ListOfUnitTypes, error)
-	ListBuildings(context.Context, *PaginatedQuery) (*ListOfBuildingTypes, error)
-	ListKnowledges(context.Context, *PaginatedQuery) (*ListOfKnowledgeTypes, error)
-}
-
-// UnimplementedDefinitionsServer can be embedded to have forward compatible implementations.
-type UnimplementedDefinitionsServer struct {
-}
-
-func (*UnimplementedDefinitionsServer) ListUnits(ctx context.Context, req *PaginatedQuery) (*ListOfUnitTypes, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListUnits not implemented")
-}
-func (*UnimplementedDefinitionsServer) ListBuildings(ctx context.Context, req *PaginatedQuery) (*ListOfBuildingTypes, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListBuildings not implemented")
+
+// HandoffServer is the server API for Handoff service.
+type HandoffServer interface {
+	// Accept the Army, idempotent for the same transfer. Return the ID of the
+	// Army in the Region it enters.
+	Accept(context.Context, *HandoffReq) (*HandoffRep, error)
+	// Let the accepted Army enter the map, idempotent as well
+	Commit(context.Context, *HandoffId) (*None, error)
 }
-func (*UnimplementedDefinitionsServer) ListKnowledges(ctx context.Context, req *PaginatedQuery) (*ListOfKnowledgeTypes, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListKnowledges not implemented")
+
+// UnimplementedHandoffServer can be embedded to have forward compatible implementations.
+type UnimplementedHandoffServer struct {
 }
 
-func RegisterDefinitionsServer(s *grpc.Server, srv DefinitionsServer) {
-	s.RegisterService(&_Definitions_serviceDesc, srv)
+func (*UnimplementedHandoffServer) Accept(ctx context.Context, req *HandoffReq) (*HandoffRep, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Accept not implemented")
+}
+func (*UnimplementedHandoffServer) Commit(ctx context.Context, req *HandoffId) (*None, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Commit not implemented")
 }
 
-func _Definitions_ListUnits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PaginatedQuery)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(DefinitionsServer).ListUnits(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/hegemonie.region.proto.Definitions/ListUnits",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DefinitionsServer).ListUnits(ctx, req.(*PaginatedQuery))
-	}
-	return interceptor(ctx, in, info, handler)
+func RegisterHandoffServer(s *grpc.Server, srv HandoffServer) {
+	s.RegisterService(&_Handoff_serviceDesc, srv)
 }
 
-func _Definitions_ListBuildings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PaginatedQuery)
+func _Handoff_Accept_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HandoffReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DefinitionsServer).ListBuildings(ctx, in)
+		return srv.(HandoffServer).Accept(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/hegemonie.region.proto.Definitions/ListBuildings",
+		FullMethod: "/hegemonie.region.proto.Handoff/Accept",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DefinitionsServer).ListBuildings(ctx, req.(*PaginatedQuery))
+		return srv.(HandoffServer).Accept(ctx, req.(*HandoffReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Definitions_ListKnowledges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PaginatedQuery)
+func _Handoff_Commit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HandoffId)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DefinitionsServer).ListKnowledges(ctx, in)
+		return srv.(HandoffServer).Commit(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/hegemonie.region.proto.Definitions/ListKnowledges",
+		FullMethod: "/hegemonie.region.proto.Handoff/Commit",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DefinitionsServer).ListKnowledges(ctx, req.(*PaginatedQuery))
+		return srv.(HandoffServer).Commit(ctx, req.(*HandoffId))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Definitions_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "hegemonie.region.proto.Definitions",
-	HandlerType: (*DefinitionsServer)(nil),
+var _Handoff_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "hegemonie.region.proto.Handoff",
+	HandlerType: (*HandoffServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "ListUnits",
-			Handler:    _Definitions_ListUnits_Handler,
-		},
-		{
-			MethodName: "ListBuildings",
-			Handler:    _Definitions_ListBuildings_Handler,
+			MethodName: "Accept",
+			Handler:    _Handoff_Accept_Handler,
 		},
 		{
-			MethodName: "ListKnowledges",
-			Handler:    _Definitions_ListKnowledges_Handler,
+			MethodName: "Commit",
+			Handler:    _Handoff_Commit_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "region.proto",
 }
 
-// AdminClient is the client API for Admin service.
+// MarketClient is the client API for Market service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type AdminClient interface {
-	// Have all the Cities on the Region to produce their resources
-	Produce(ctx context.Context, in *None, opts ...grpc.CallOption) (*None, error)
-	// Make all the armies on the Region to move on step
-	Move(ctx context.Context, in *None, opts ...grpc.CallOption) (*None, error)
-	GetScores(ctx context.Context, in *None, opts ...grpc.CallOption) (*ScoreBoard, error)
+type MarketClient interface {
+	// Place an Order, escrowed from the Stock of the City. Return its ID.
+	Place(ctx context.Context, in *MarketOrderReq, opts ...grpc.CallOption) (*MarketOrderId, error)
+	// Withdraw an Order of the City, its escrow returns to the Stock
+	Cancel(ctx context.Context, in *MarketOrderId, opts ...grpc.CallOption) (*None, error)
+	// Return a page of the Orders of the Region, ordered by ID
+	List(ctx context.Context, in *PaginatedQuery, opts ...grpc.CallOption) (*ListOfMarketOrders, error)
 }
 
-type adminClient struct {
+type marketClient struct {
 	cc *grpc.ClientConn
 }
 
-func NewAdminClient(cc *grpc.ClientConn) AdminClient {
-	return &adminClient{cc}
+func NewMarketClient(cc *grpc.ClientConn) MarketClient {
+	return &marketClient{cc}
 }
 
-func (c *adminClient) Produce(ctx context.Context, in *None, opts ...grpc.CallOption) (*None, error) {
-	out := new(None)
-	err := c.cc.Invoke(ctx, "/hegemonie.region.proto.Admin/Produce", in, out, opts...)
+func (c *marketClient) Place(ctx context.Context, in *MarketOrderReq, opts ...grpc.CallOption) (*MarketOrderId, error) {
+	out := new(MarketOrderId)
+	err := c.cc.Invoke(ctx, "/hegemonie.region.proto.Market/Place", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *adminClient) Move(ctx context.Context, in *None, opts ...grpc.CallOption) (*None, error) {
+func (c *marketClient) Cancel(ctx context.Context, in *MarketOrderId, opts ...grpc.CallOption) (*None, error) {
 	out := new(None)
-	err := c.cc.Invoke(ctx, "/hegemonie.region.proto.Admin/Move", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/hegemonie.region.proto.Market/Cancel", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *adminClient) GetScores(ctx context.Context, in *None, opts ...grpc.CallOption) (*ScoreBoard, error) {
-	out := new(ScoreBoard)
-	err := c.cc.Invoke(ctx, "/hegemonie.region.proto.Admin/GetScores", in, out, opts...)
+func (c *marketClient) List(ctx context.Context, in *PaginatedQuery, opts ...grpc.CallOption) (*ListOfMarketOrders, error) {
+	out := new(ListOfMarketOrders)
+	err := c.cc.Invoke(ctx, "/hegemonie.region.proto.Market/List", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// AdminServer is the server API for Admin service.
-type AdminServer interface {
-	// Have all the Cities on the Region to produce their resources
-	Produce(context.Context, *None) (*None, error)
-	// Make all the armies on the Region to move on step
-	Move(context.Context, *None) (*None, error)
-	GetScores(context.Context, *None) (*ScoreBoard, error)
+// MarketServer is the server API for Market service.
+type MarketServer interface {
+	// Place an Order, escrowed from the Stock of the City. Return its ID.
+	Place(context.Context, *MarketOrderReq) (*MarketOrderId, error)
+	// Withdraw an Order of the City, its escrow returns to the Stock
+	Cancel(context.Context, *MarketOrderId) (*None, error)
+	// Return a page of the Orders of the Region, ordered by ID
+	List(context.Context, *PaginatedQuery) (*ListOfMarketOrders, error)
 }
 
-// UnimplementedAdminServer can be embedded to have forward compatible implementations.
-type UnimplementedAdminServer struct {
+// UnimplementedMarketServer can be embedded to have forward compatible implementations.
+type UnimplementedMarketServer struct {
 }
 
-func (*UnimplementedAdminServer) Produce(ctx context.Context, req *None) (*None, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Produce not implemented")
+func (*UnimplementedMarketServer) Place(ctx context.Context, req *MarketOrderReq) (*MarketOrderId, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Place not implemented")
 }
-func (*UnimplementedAdminServer) Move(ctx context.Context, req *None) (*None, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Move not implemented")
+func (*UnimplementedMarketServer) Cancel(ctx context.Context, req *MarketOrderId) (*None, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Cancel not implemented")
 }
-func (*UnimplementedAdminServer) GetScores(ctx context.Context, req *None) (*ScoreBoard, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetScores not implemented")
+func (*UnimplementedMarketServer) List(ctx context.Context, req *PaginatedQuery) (*ListOfMarketOrders, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
 }
 
-func RegisterAdminServer(s *grpc.Server, srv AdminServer) {
-	s.RegisterService(&_Admin_serviceDesc, srv)
+func RegisterMarketServer(s *grpc.Server, srv MarketServer) {
+	s.RegisterService(&_Market_serviceDesc, srv)
 }
 
-func _Admin_Produce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(None)
+func _Market_Place_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarketOrderReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServer).Produce(ctx, in)
+		return srv.(MarketServer).Place(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/hegemonie.region.proto.Admin/Produce",
+		FullMethod: "/hegemonie.region.proto.Market/Place",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServer).Produce(ctx, req.(*None))
+		return srv.(MarketServer).Place(ctx, req.(*MarketOrderReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Admin_Move_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(None)
+func _Market_Cancel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarketOrderId)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServer).Move(ctx, in)
+		return srv.(MarketServer).Cancel(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/hegemonie.region.proto.Admin/Move",
+		FullMethod: "/hegemonie.region.proto.Market/Cancel",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServer).Move(ctx, req.(*None))
+		return srv.(MarketServer).Cancel(ctx, req.(*MarketOrderId))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Admin_GetScores_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(None)
+func _Market_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PaginatedQuery)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServer).GetScores(ctx, in)
+		return srv.(MarketServer).List(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/hegemonie.region.proto.Admin/GetScores",
+		FullMethod: "/hegemonie.region.proto.Market/List",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServer).GetScores(ctx, req.(*None))
+		return srv.(MarketServer).List(ctx, req.(*PaginatedQuery))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Admin_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "hegemonie.region.proto.Admin",
-	HandlerType: (*AdminServer)(nil),
+var _Market_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "hegemonie.region.proto.Market",
+	HandlerType: (*MarketServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "Produce",
-			Handler:    _Admin_Produce_Handler,
+			MethodName: "Place",
+			Handler:    _Market_Place_Handler,
 		},
 		{
-			MethodName: "Move",
-			Handler:    _Admin_Move_Handler,
+			MethodName: "Cancel",
+			Handler:    _Market_Cancel_Handler,
 		},
 		{
-			MethodName: "GetScores",
-			Handler:    _Admin_GetScores_Handler,
+			MethodName: "List",
+			Handler:    _Market_List_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "region.proto",
 }
 
-// ArmyClient is the client API for Army service.
+// DiplomacyClient is the client API for Diplomacy service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type ArmyClient interface {
-	// Return a detailed view of the given Army
-	Show(ctx context.Context, in *ArmyId, opts ...grpc.CallOption) (*ArmyView, error)
-	// Make the Army flea the fight it is involved in.
-	Flea(ctx context.Context, in *ArmyId, opts ...grpc.CallOption) (*None, error)
-	// Make the Army flip in the fight it is involved in.
-	Flip(ctx context.Context, in *ArmyId, opts ...grpc.CallOption) (*None, error)
-	// Append the specified command on the list of the Army.
-	Command(ctx context.Context, in *ArmyCommandReq, opts ...grpc.CallOption) (*None, error)
-}
-
-type armyClient struct {
+type DiplomacyClient interface {
+	// Propose a Treaty to another City. A war is in force at once.
+	Propose(ctx context.Context, in *TreatyReq, opts ...grpc.CallOption) (*TreatyId, error)
+	// Accept a Treaty proposed to the City
+	Accept(ctx context.Context, in *TreatyId, opts ...grpc.CallOption) (*None, error)
+	// Break a Treaty in force, or withdraw or refuse a proposal
+	Break(ctx context.Context, in *TreatyId, opts ...grpc.CallOption) (*None, error)
+	// Return the Treaties of the City, proposed or in force
+	List(ctx context.Context, in *CityId, opts ...grpc.CallOption) (*ListOfTreaties, error)
+}
+
+type diplomacyClient struct {
 	cc *grpc.ClientConn
 }
 
-func NewArmyClient(cc *grpc.ClientConn) ArmyClient {
-	return &armyClient{cc}
+func NewDiplomacyClient(cc *grpc.ClientConn) DiplomacyClient {
+	return &diplomacyClient{cc}
 }
 
-func (c *armyClient) Show(ctx context.Context, in *ArmyId, opts ...grpc.CallOption) (*ArmyView, error) {
-	out := new(ArmyView)
-	err := c.cc.Invoke(ctx, "/hegemonie.region.proto.Army/Show", in, out, opts...)
+func (c *diplomacyClient) Propose(ctx context.Context, in *TreatyReq, opts ...grpc.CallOption) (*TreatyId, error) {
+	out := new(TreatyId)
+	err := c.cc.Invoke(ctx, "/hegemonie.region.proto.Diplomacy/Propose", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *armyClient) Flea(ctx context.Context, in *ArmyId, opts ...grpc.CallOption) (*None, error) {
+func (c *diplomacyClient) Accept(ctx context.Context, in *TreatyId, opts ...grpc.CallOption) (*None, error) {
 	out := new(None)
-	err := c.cc.Invoke(ctx, "/hegemonie.region.proto.Army/Flea", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/hegemonie.region.proto.Diplomacy/Accept", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *armyClient) Flip(ctx context.Context, in *ArmyId, opts ...grpc.CallOption) (*None, error) {
+func (c *diplomacyClient) Break(ctx context.Context, in *TreatyId, opts ...grpc.CallOption) (*None, error) {
 	out := new(None)
-	err := c.cc.Invoke(ctx, "/hegemonie.region.proto.Army/Flip", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/hegemonie.region.proto.Diplomacy/Break", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *armyClient) Command(ctx context.Context, in *ArmyCommandReq, opts ...grpc.CallOption) (*None, error) {
-	out := new(None)
-	err := c.cc.Invoke(ctx, "/hegemonie.region.proto.Army/Command", in, out, opts...)
+func (c *diplomacyClient) List(ctx context.Context, in *CityId, opts ...grpc.CallOption) (*ListOfTreaties, error) {
+	out := new(ListOfTreaties)
+	err := c.cc.Invoke(ctx, "/hegemonie.region.proto.Diplomacy/List", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// ArmyServer is the server API for Army service.
-type ArmyServer interface {
-	// Return a detailed view of the given Army
-	Show(context.Context, *ArmyId) (*ArmyView, error)
-	// Make the Army flea the fight it is involved in.
-	Flea(context.Context, *ArmyId) (*None, error)
-	// Make the Army flip in the fight it is involved in.
-	Flip(context.Context, *ArmyId) (*None, error)
-	// Append the specified command on the list of the Army.
-	Command(context.Context, *ArmyCommandReq) (*None, error)
+// DiplomacyServer is the server API for Diplomacy service.
+type DiplomacyServer interface {
+	// Propose a Treaty to another City. A war is in force at once.
+	Propose(context.Context, *TreatyReq) (*TreatyId, error)
+	// Accept a Treaty proposed to the City
+	Accept(context.Context, *TreatyId) (*None, error)
+	// Break a Treaty in force, or withdraw or refuse a proposal
+	Break(context.Context, *TreatyId) (*None, error)
+	// Return the Treaties of the City, proposed or in force
+	List(context.Context, *CityId) (*ListOfTreaties, error)
 }
 
-// UnimplementedArmyServer can be embedded to have forward compatible implementations.
-type UnimplementedArmyServer struct {
+// UnimplementedDiplomacyServer can be embedded to have forward compatible implementations.
+type UnimplementedDiplomacyServer struct {
 }
 
-func (*UnimplementedArmyServer) Show(ctx context.Context, req *ArmyId) (*ArmyView, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Show not implemented")
+func (*UnimplementedDiplomacyServer) Propose(ctx context.Context, req *TreatyReq) (*TreatyId, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Propose not implemented")
 }
-func (*UnimplementedArmyServer) Flea(ctx context.Context, req *ArmyId) (*None, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Flea not implemented")
+func (*UnimplementedDiplomacyServer) Accept(ctx context.Context, req *TreatyId) (*None, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Accept not implemented")
 }
-func (*UnimplementedArmyServer) Flip(ctx context.Context, req *ArmyId) (*None, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Flip not implemented")
+func (*UnimplementedDiplomacyServer) Break(ctx context.Context, req *TreatyId) (*None, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Break not implemented")
 }
-func (*UnimplementedArmyServer) Command(ctx context.Context, req *ArmyCommandReq) (*None, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Command not implemented")
+func (*UnimplementedDiplomacyServer) List(ctx context.Context, req *CityId) (*ListOfTreaties, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
 }
 
-func RegisterArmyServer(s *grpc.Server, srv ArmyServer) {
-	s.RegisterService(&_Army_serviceDesc, srv)
+func RegisterDiplomacyServer(s *grpc.Server, srv DiplomacyServer) {
+	s.RegisterService(&_Diplomacy_serviceDesc, srv)
 }
 
-func _Army_Show_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ArmyId)
+func _Diplomacy_Propose_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TreatyReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ArmyServer).Show(ctx, in)
+		return srv.(DiplomacyServer).Propose(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/hegemonie.region.proto.Army/Show",
+		FullMethod: "/hegemonie.region.proto.Diplomacy/Propose",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ArmyServer).Show(ctx, req.(*ArmyId))
+		return srv.(DiplomacyServer).Propose(ctx, req.(*TreatyReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Army_Flea_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ArmyId)
+func _Diplomacy_Accept_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TreatyId)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ArmyServer).Flea(ctx, in)
+		return srv.(DiplomacyServer).Accept(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/hegemonie.region.proto.Army/Flea",
+		FullMethod: "/hegemonie.region.proto.Diplomacy/Accept",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ArmyServer).Flea(ctx, req.(*ArmyId))
+		return srv.(DiplomacyServer).Accept(ctx, req.(*TreatyId))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Army_Flip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ArmyId)
+func _Diplomacy_Break_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TreatyId)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ArmyServer).Flip(ctx, in)
+		return srv.(DiplomacyServer).Break(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/hegemonie.region.proto.Army/Flip",
+		FullMethod: "/hegemonie.region.proto.Diplomacy/Break",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ArmyServer).Flip(ctx, req.(*ArmyId))
+		return srv.(DiplomacyServer).Break(ctx, req.(*TreatyId))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Army_Command_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ArmyCommandReq)
+func _Diplomacy_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CityId)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ArmyServer).Command(ctx, in)
+		return srv.(DiplomacyServer).List(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/hegemonie.region.proto.Army/Command",
+		FullMethod: "/hegemonie.region.proto.Diplomacy/List",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ArmyServer).Command(ctx, req.(*ArmyCommandReq))
+		return srv.(DiplomacyServer).List(ctx, req.(*CityId))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Army_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "hegemonie.region.proto.Army",
-	HandlerType: (*ArmyServer)(nil),
+var _Diplomacy_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "hegemonie.region.proto.Diplomacy",
+	HandlerType: (*DiplomacyServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "Show",
-			Handler:    _Army_Show_Handler,
+			MethodName: "Propose",
+			Handler:    _Diplomacy_Propose_Handler,
 		},
 		{
-			MethodName: "Flea",
-			Handler:    _Army_Flea_Handler,
+			MethodName: "Accept",
+			Handler:    _Diplomacy_Accept_Handler,
 		},
 		{
-			MethodName: "Flip",
-			Handler:    _Army_Flip_Handler,
+			MethodName: "Break",
+			Handler:    _Diplomacy_Break_Handler,
 		},
 		{
-			MethodName: "Command",
-			Handler:    _Army_Command_Handler,
+			MethodName: "List",
+			Handler:    _Diplomacy_List_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "region.proto",
+}
+
+// MapClient is the client API for Map service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MapClient interface {
+	// Return a page of the Cells, ordered by ID, with the Roads leaving them.
+	// With a center, only the Cells within the radius (in steps) are listed.
+	Cells(ctx context.Context, in *MapReq, opts ...grpc.CallOption) (*MapView, error)
+	// Return a page of the Cities, ordered by ID, with their position
+	Cities(ctx context.Context, in *PaginatedQuery, opts ...grpc.CallOption) (*ListOfCityPositions, error)
+}
+
+type mapClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewMapClient(cc *grpc.ClientConn) MapClient {
+	return &mapClient{cc}
+}
+
+func (c *mapClient) Cells(ctx context.Context, in *MapReq, opts ...grpc.CallOption) (*MapView, error) {
+	out := new(MapView)
+	err := c.cc.Invoke(ctx, "/hegemonie.region.proto.Map/Cells", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mapClient) Cities(ctx context.Context, in *PaginatedQuery, opts ...grpc.CallOption) (*ListOfCityPositions, error) {
+	out := new(ListOfCityPositions)
+	err := c.cc.Invoke(ctx, "/hegemonie.region.proto.Map/Cities", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MapServer is the server API for Map service.
+type MapServer interface {
+	// Return a page of the Cells, ordered by ID, with the Roads leaving them.
+	// With a center, only the Cells within the radius (in steps) are listed.
+	Cells(context.Context, *MapReq) (*MapView, error)
+	// Return a page of the Cities, ordered by ID, with their position
+	Cities(context.Context, *PaginatedQuery) (*ListOfCityPositions, error)
+}
+
+// UnimplementedMapServer can be embedded to have forward compatible implementations.
+type UnimplementedMapServer struct {
+}
+
+func (*UnimplementedMapServer) Cells(ctx context.Context, req *MapReq) (*MapView, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Cells not implemented")
+}
+func (*UnimplementedMapServer) Cities(ctx context.Context, req *PaginatedQuery) (*ListOfCityPositions, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Cities not implemented")
+}
+
+func RegisterMapServer(s *grpc.Server, srv MapServer) {
+	s.RegisterService(&_Map_serviceDesc, srv)
+}
+
+func _Map_Cells_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MapReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MapServer).Cells(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hegemonie.region.proto.Map/Cells",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MapServer).Cells(ctx, req.(*MapReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Map_Cities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PaginatedQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MapServer).Cities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hegemonie.region.proto.Map/Cities",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MapServer).Cities(ctx, req.(*PaginatedQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Map_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "hegemonie.region.proto.Map",
+	HandlerType: (*MapServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Cells",
+			Handler:    _Map_Cells_Handler,
+		},
+		{
+			MethodName: "Cities",
+			Handler:    _Map_Cities_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -11,13 +11,16 @@ import (
 	"github.com/go-macaron/binding"
 	"github.com/go-macaron/session"
 	auth "github.com/jfsmig/hegemonie/pkg/auth/proto"
+	events "github.com/jfsmig/hegemonie/pkg/events/proto"
 	region "github.com/jfsmig/hegemonie/pkg/region/proto"
 	"gopkg.in/macaron.v1"
+	"log"
+	"net/url"
 )
 
-func (f *FrontService) authenticateUserFromSession(sess session.Store) (*auth.UserView, error) {
+func (f *FrontService) authenticateUserFromSession(sess *Session) (*auth.UserView, error) {
 	// Validate the session data
-	userid := ptou(sess.Get("userid"))
+	userid := sess.UserId
 	if userid == 0 {
 		return nil, errors.New("Not authenticated")
 	}
@@ -28,7 +31,7 @@ func (f *FrontService) authenticateUserFromSession(sess session.Store) (*auth.Us
 		&auth.UserShowReq{Id: userid})
 }
 
-func (f *FrontService) authenticateAdminFromSession(sess session.Store) (*auth.UserView, error) {
+func (f *FrontService) authenticateAdminFromSession(sess *Session) (*auth.UserView, error) {
 	uView, err := f.authenticateUserFromSession(sess)
 	if err != nil {
 		return nil, err
@@ -39,9 +42,9 @@ func (f *FrontService) authenticateAdminFromSession(sess session.Store) (*auth.U
 	return uView, nil
 }
 
-func (f *FrontService) authenticateCharacterFromSession(sess session.Store, idChar uint64) (*auth.UserView, *auth.CharacterView, error) {
+func (f *FrontService) authenticateCharacterFromSession(sess *Session, idChar uint64) (*auth.UserView, *auth.CharacterView, error) {
 	// Validate the session data
-	userid := ptou(sess.Get("userid"))
+	userid := sess.UserId
 	if userid == 0 || idChar == 0 {
 		return nil, nil, errors.New("Not authenticated")
 	}
@@ -58,33 +61,34 @@ func (f *FrontService) authenticateCharacterFromSession(sess session.Store, idCh
 }
 
 func (f *FrontService) routeForms(m *macaron.Macaron) {
-	doLogIn := func(ctx *macaron.Context, flash *session.Flash, sess session.Store, info FormLogin) {
-		// Cleanup a previous session
-		sess.Flush()
-
-		// Authorize the character with the user
+	doLogIn := func(ctx *macaron.Context, flash *session.Flash, info FormLogin) {
 		cliAuth := auth.NewAuthClient(f.cnxAuth)
-		uView, err := cliAuth.UserAuth(context.Background(),
+		rep, err := cliAuth.SessionIssue(context.Background(),
 			&auth.UserAuthReq{Mail: info.UserMail, Pass: info.UserPass})
 
 		if err != nil {
+			clearSessionCookie(ctx)
 			flash.Warning(err.Error())
 			ctx.Redirect("/")
 		} else {
-			strId := utoa(uView.Id)
-			ctx.SetSecureCookie("session", strId)
-			sess.Set("userid", strId)
+			setSessionCookie(ctx, rep)
 			ctx.Redirect("/game/user")
 		}
 	}
 
-	doLogOut := func(ctx *macaron.Context, s session.Store) {
-		ctx.SetSecureCookie("session", "")
-		s.Flush()
+	doLogOut := func(ctx *macaron.Context, sess *Session) {
+		if sess.Token != "" {
+			cliAuth := auth.NewAuthClient(f.cnxAuth)
+			_, err := cliAuth.SessionRevoke(context.Background(), &auth.SessionReq{Token: sess.Token})
+			if err != nil {
+				log.Println("Session revocation error:", err.Error())
+			}
+		}
+		clearSessionCookie(ctx)
 		ctx.Redirect("/")
 	}
 
-	doMove := func(ctx *macaron.Context, sess session.Store, flash *session.Flash) {
+	doMove := func(ctx *macaron.Context, sess *Session, flash *session.Flash) {
 		_, err := f.authenticateAdminFromSession(sess)
 		if err != nil {
 			flash.Warning(err.Error())
@@ -92,15 +96,18 @@ func (f *FrontService) routeForms(m *macaron.Macaron) {
 			return
 		}
 
-		cliReg := region.NewAdminClient(f.cnxRegion)
-		_, err = cliReg.Move(context.Background(), &region.None{})
+		name := ctx.Query("region")
+		cnx, err := f.cnxRegionOf(context.Background(), name)
+		if err == nil {
+			_, err = region.NewAdminClient(cnx).Move(context.Background(), &region.None{})
+		}
 		if err != nil {
 			flash.Warning(err.Error())
 		}
-		ctx.Redirect("/admin")
+		ctx.Redirect("/game/admin?region=" + url.QueryEscape(name))
 	}
 
-	doProduce := func(ctx *macaron.Context, sess session.Store, flash *session.Flash) {
+	doProduce := func(ctx *macaron.Context, sess *Session, flash *session.Flash) {
 		_, err := f.authenticateAdminFromSession(sess)
 		if err != nil {
 			flash.Warning(err.Error())
@@ -108,23 +115,32 @@ func (f *FrontService) routeForms(m *macaron.Macaron) {
 			return
 		}
 
-		cliReg := region.NewAdminClient(f.cnxRegion)
-		_, err = cliReg.Produce(context.Background(), &region.None{})
+		name := ctx.Query("region")
+		cnx, err := f.cnxRegionOf(context.Background(), name)
+		if err == nil {
+			_, err = region.NewAdminClient(cnx).Produce(context.Background(), &region.None{})
+		}
 		if err != nil {
 			flash.Warning(err.Error())
 		}
-		ctx.Redirect("/admin")
+		ctx.Redirect("/game/admin?region=" + url.QueryEscape(name))
 	}
 
-	doCityStudy := func(ctx *macaron.Context, flash *session.Flash, sess session.Store, info FormCityStudy) {
-		_, _, err := f.authenticateCharacterFromSession(sess, info.CharacterId)
+	doCityStudy := func(ctx *macaron.Context, flash *session.Flash, sess *Session, info FormCityStudy) {
+		_, cView, err := f.authenticateCharacterFromSession(sess, info.CharacterId)
 		if err != nil {
 			flash.Warning(err.Error())
 			ctx.Redirect("/game/user")
 			return
 		}
+		cnx, err := f.cnxRegionOf(context.Background(), cView.Region)
+		if err != nil {
+			flash.Warning("Region error: " + err.Error())
+			ctx.Redirect("/game/user")
+			return
+		}
 
-		cliReg := region.NewCityClient(f.cnxRegion)
+		cliReg := region.NewCityClient(cnx)
 		_, err = cliReg.Study(context.Background(),
 			&region.StudyReq{City: info.CityId, Character: info.CharacterId, KnowledgeType: info.KnowledgeId})
 		if err != nil {
@@ -134,15 +150,21 @@ func (f *FrontService) routeForms(m *macaron.Macaron) {
 		ctx.Redirect("/game/land/knowledges?cid=" + utoa(info.CharacterId) + "&lid=" + utoa(info.CityId))
 	}
 
-	doCityBuild := func(ctx *macaron.Context, flash *session.Flash, sess session.Store, info FormCityBuild) {
-		_, _, err := f.authenticateCharacterFromSession(sess, info.CharacterId)
+	doCityBuild := func(ctx *macaron.Context, flash *session.Flash, sess *Session, info FormCityBuild) {
+		_, cView, err := f.authenticateCharacterFromSession(sess, info.CharacterId)
 		if err != nil {
 			flash.Warning(err.Error())
 			ctx.Redirect("/game/user")
 			return
 		}
+		cnx, err := f.cnxRegionOf(context.Background(), cView.Region)
+		if err != nil {
+			flash.Warning("Region error: " + err.Error())
+			ctx.Redirect("/game/user")
+			return
+		}
 
-		cliReg := region.NewCityClient(f.cnxRegion)
+		cliReg := region.NewCityClient(cnx)
 		_, err = cliReg.Build(context.Background(),
 			&region.BuildReq{City: info.CityId, Character: info.CharacterId, BuildingType: info.BuildingId})
 		if err != nil {
@@ -152,15 +174,21 @@ func (f *FrontService) routeForms(m *macaron.Macaron) {
 		ctx.Redirect("/game/land/buildings?cid=" + utoa(info.CharacterId) + "&lid=" + utoa(info.CityId))
 	}
 
-	doCityTrain := func(ctx *macaron.Context, flash *session.Flash, sess session.Store, info FormCityTrain) {
-		_, _, err := f.authenticateCharacterFromSession(sess, info.CharacterId)
+	doCityTrain := func(ctx *macaron.Context, flash *session.Flash, sess *Session, info FormCityTrain) {
+		_, cView, err := f.authenticateCharacterFromSession(sess, info.CharacterId)
 		if err != nil {
 			flash.Warning(err.Error())
 			ctx.Redirect("/game/user")
 			return
 		}
+		cnx, err := f.cnxRegionOf(context.Background(), cView.Region)
+		if err != nil {
+			flash.Warning("Region error: " + err.Error())
+			ctx.Redirect("/game/user")
+			return
+		}
 
-		cliReg := region.NewCityClient(f.cnxRegion)
+		cliReg := region.NewCityClient(cnx)
 		_, err = cliReg.Train(context.Background(),
 			&region.TrainReq{City: info.CityId, Character: info.CharacterId, UnitType: info.UnitId})
 		if err != nil {
@@ -170,11 +198,11 @@ func (f *FrontService) routeForms(m *macaron.Macaron) {
 		ctx.Redirect("/game/land/units?cid=" + utoa(info.CharacterId) + "&lid=" + utoa(info.CityId))
 	}
 
-	doCityCreateArmy := func(ctx *macaron.Context, flash *session.Flash, sess session.Store, info FormCityArmyCreate) {
+	doCityCreateArmy := func(ctx *macaron.Context, flash *session.Flash, sess *Session, info FormCityArmyCreate) {
 		/*
 			reply := hclient.CityCreateArmyReply{}
 			args := hclient.CityCreateArmyArgs{
-				UserId:      ptou(sess.Get("userid")),
+				UserId:      sess.UserId,
 				CharacterId: info.CharacterId,
 				CityId:      info.CityId,
 				Name:        info.Name,
@@ -187,11 +215,11 @@ func (f *FrontService) routeForms(m *macaron.Macaron) {
 		ctx.Redirect("/game/land/overview?cid=" + utoa(info.CharacterId) + "&lid=" + utoa(info.CityId))
 	}
 
-	doCityTransferUnit := func(ctx *macaron.Context, flash *session.Flash, sess session.Store, info FormCityUnitTransfer) {
+	doCityTransferUnit := func(ctx *macaron.Context, flash *session.Flash, sess *Session, info FormCityUnitTransfer) {
 		/*
 			reply := hclient.CityTransferUnitReply{}
 			args := hclient.CityTransferUnitArgs{
-				UserId:      ptou(sess.Get("userid")),
+				UserId:      sess.UserId,
 				CharacterId: info.CharacterId,
 				CityId:      info.CityId,
 				UnitId:      info.UnitId,
@@ -205,11 +233,11 @@ func (f *FrontService) routeForms(m *macaron.Macaron) {
 		ctx.Redirect("/game/land/overview?cid=" + utoa(info.CharacterId) + "&lid=" + utoa(info.CityId))
 	}
 
-	doCityCommandArmy := func(ctx *macaron.Context, flash *session.Flash, sess session.Store, info FormCityArmyCommand) {
+	doCityCommandArmy := func(ctx *macaron.Context, flash *session.Flash, sess *Session, info FormCityArmyCommand) {
 		/*
 			reply := hclient.CityCommandArmyReply{}
 			args := hclient.CityCommandArmyArgs{
-				UserId:      ptou(sess.Get("userid")),
+				UserId:      sess.UserId,
 				CharacterId: info.CharacterId,
 				CityId:      info.CityId,
 				ArmyId:      info.ArmyId,
@@ -224,11 +252,11 @@ func (f *FrontService) routeForms(m *macaron.Macaron) {
 		ctx.Redirect("/game/land/overview?cid=" + utoa(info.CharacterId) + "&lid=" + utoa(info.CityId))
 	}
 
-	doCityDisbandArmy := func(ctx *macaron.Context, flash *session.Flash, sess session.Store, info FormCityArmyDisband) {
+	doCityDisbandArmy := func(ctx *macaron.Context, flash *session.Flash, sess *Session, info FormCityArmyDisband) {
 		/*
 			reply := hclient.CityCommandArmyReply{}
 			args := hclient.CityCommandArmyArgs{
-				UserId:      ptou(sess.Get("userid")),
+				UserId:      sess.UserId,
 				CharacterId: info.CharacterId,
 				CityId:      info.CityId,
 				ArmyId:      info.ArmyId,
@@ -243,11 +271,11 @@ func (f *FrontService) routeForms(m *macaron.Macaron) {
 		ctx.Redirect("/game/land/overview?cid=" + utoa(info.CharacterId) + "&lid=" + utoa(info.CityId))
 	}
 
-	doCityCancelArmy := func(ctx *macaron.Context, flash *session.Flash, sess session.Store, info FormCityArmyCancel) {
+	doCityCancelArmy := func(ctx *macaron.Context, flash *session.Flash, sess *Session, info FormCityArmyCancel) {
 		/*
 			reply := hclient.CityCommandArmyReply{}
 			args := hclient.CityCommandArmyArgs{
-				UserId:      ptou(sess.Get("userid")),
+				UserId:      sess.UserId,
 				CharacterId: info.CharacterId,
 				CityId:      info.CityId,
 				ArmyId:      info.ArmyId,
@@ -262,6 +290,163 @@ func (f *FrontService) routeForms(m *macaron.Macaron) {
 		ctx.Redirect("/game/land/overview?cid=" + utoa(info.CharacterId) + "&lid=" + utoa(info.CityId))
 	}
 
+	doReportsRead := func(ctx *macaron.Context, flash *session.Flash, sess *Session, info FormReportsRead) {
+		_, _, err := f.authenticateCharacterFromSession(sess, info.CharacterId)
+		if err != nil {
+			flash.Warning(err.Error())
+			ctx.Redirect("/game/user")
+			return
+		}
+
+		cliEvt := events.NewReportsClient(f.cnxEvents)
+		req := &events.MarkReadReq{Character: info.CharacterId, All: info.ReportId == 0}
+		if info.ReportId != 0 {
+			req.Reports = []uint64{info.ReportId}
+		}
+		_, err = cliEvt.MarkRead(context.Background(), req)
+		if err != nil {
+			flash.Warning(err.Error())
+		}
+
+		next := "/game/reports?cid=" + utoa(info.CharacterId)
+		if info.CityId != 0 {
+			next += "&lid=" + utoa(info.CityId)
+		}
+		ctx.Redirect(next)
+	}
+
+	doMarketPlace := func(ctx *macaron.Context, flash *session.Flash, sess *Session, info FormMarketPlace) {
+		_, cView, err := f.authenticateCharacterFromSession(sess, info.CharacterId)
+		if err != nil {
+			flash.Warning(err.Error())
+			ctx.Redirect("/game/user")
+			return
+		}
+		cnx, err := f.cnxRegionOf(context.Background(), cView.Region)
+		if err != nil {
+			flash.Warning("Region error: " + err.Error())
+			ctx.Redirect("/game/user")
+			return
+		}
+
+		cliReg := region.NewMarketClient(cnx)
+		_, err = cliReg.Place(context.Background(), &region.MarketOrderReq{
+			Character: info.CharacterId, City: info.CityId,
+			Resource: info.Resource, Bid: info.Side == "bid",
+			Quantity: info.Quantity, Price: info.Price,
+		})
+		if err != nil {
+			flash.Warning(err.Error())
+		}
+
+		ctx.Redirect("/game/market?cid=" + utoa(info.CharacterId) + "&lid=" + utoa(info.CityId))
+	}
+
+	doMarketCancel := func(ctx *macaron.Context, flash *session.Flash, sess *Session, info FormMarketCancel) {
+		_, cView, err := f.authenticateCharacterFromSession(sess, info.CharacterId)
+		if err != nil {
+			flash.Warning(err.Error())
+			ctx.Redirect("/game/user")
+			return
+		}
+		cnx, err := f.cnxRegionOf(context.Background(), cView.Region)
+		if err != nil {
+			flash.Warning("Region error: " + err.Error())
+			ctx.Redirect("/game/user")
+			return
+		}
+
+		cliReg := region.NewMarketClient(cnx)
+		_, err = cliReg.Cancel(context.Background(),
+			&region.MarketOrderId{Character: info.CharacterId, City: info.CityId, Order: info.OrderId})
+		if err != nil {
+			flash.Warning(err.Error())
+		}
+
+		ctx.Redirect("/game/market?cid=" + utoa(info.CharacterId) + "&lid=" + utoa(info.CityId))
+	}
+
+	doTreatyPropose := func(ctx *macaron.Context, flash *session.Flash, sess *Session, info FormTreatyPropose) {
+		_, cView, err := f.authenticateCharacterFromSession(sess, info.CharacterId)
+		if err != nil {
+			flash.Warning(err.Error())
+			ctx.Redirect("/game/user")
+			return
+		}
+		cnx, err := f.cnxRegionOf(context.Background(), cView.Region)
+		if err != nil {
+			flash.Warning("Region error: " + err.Error())
+			ctx.Redirect("/game/user")
+			return
+		}
+
+		cliReg := region.NewDiplomacyClient(cnx)
+		_, err = cliReg.Propose(context.Background(), &region.TreatyReq{
+			Character: info.CharacterId, City: info.CityId, Target: info.TargetId, Kind: info.Kind,
+		})
+		if err != nil {
+			flash.Warning(err.Error())
+		}
+
+		ctx.Redirect("/game/diplomacy?cid=" + utoa(info.CharacterId) + "&lid=" + utoa(info.CityId))
+	}
+
+	doTreaty := func(action string) func(*macaron.Context, *session.Flash, *Session, FormTreaty) {
+		return func(ctx *macaron.Context, flash *session.Flash, sess *Session, info FormTreaty) {
+			_, cView, err := f.authenticateCharacterFromSession(sess, info.CharacterId)
+			if err != nil {
+				flash.Warning(err.Error())
+				ctx.Redirect("/game/user")
+				return
+			}
+			cnx, err := f.cnxRegionOf(context.Background(), cView.Region)
+			if err != nil {
+				flash.Warning("Region error: " + err.Error())
+				ctx.Redirect("/game/user")
+				return
+			}
+
+			cliReg := region.NewDiplomacyClient(cnx)
+			req := &region.TreatyId{Character: info.CharacterId, City: info.CityId, Treaty: info.TreatyId}
+			if action == "accept" {
+				_, err = cliReg.Accept(context.Background(), req)
+			} else {
+				_, err = cliReg.Break(context.Background(), req)
+			}
+			if err != nil {
+				flash.Warning(err.Error())
+			}
+
+			ctx.Redirect("/game/diplomacy?cid=" + utoa(info.CharacterId) + "&lid=" + utoa(info.CityId))
+		}
+	}
+
+	doCityArmyPosture := func(ctx *macaron.Context, flash *session.Flash, sess *Session, info FormCityArmyPosture) {
+		_, cView, err := f.authenticateCharacterFromSession(sess, info.CharacterId)
+		if err != nil {
+			flash.Warning(err.Error())
+			ctx.Redirect("/game/user")
+			return
+		}
+		cnx, err := f.cnxRegionOf(context.Background(), cView.Region)
+		if err != nil {
+			flash.Warning("Region error: " + err.Error())
+			ctx.Redirect("/game/user")
+			return
+		}
+
+		cliReg := region.NewArmyClient(cnx)
+		_, err = cliReg.SetPosture(context.Background(), &region.ArmyPostureReq{
+			Id:     &region.ArmyId{Character: info.CharacterId, City: info.CityId, Army: info.ArmyId},
+			Target: info.TargetId, Posture: info.Posture,
+		})
+		if err != nil {
+			flash.Warning(err.Error())
+		}
+
+		ctx.Redirect("/game/army?cid=" + utoa(info.CharacterId) + "&lid=" + utoa(info.CityId) + "&aid=" + utoa(info.ArmyId))
+	}
+
 	m.Post("/action/login", binding.Bind(FormLogin{}), doLogIn)
 	m.Post("/action/logout", doLogOut)
 	m.Get("/action/logout", doLogOut)
@@ -274,7 +459,14 @@ func (f *FrontService) routeForms(m *macaron.Macaron) {
 	m.Post("/action/army/disband", binding.Bind(FormCityArmyDisband{}), doCityDisbandArmy)
 	m.Post("/action/army/command", binding.Bind(FormCityArmyCommand{}), doCityCommandArmy)
 	m.Post("/action/army/create", binding.Bind(FormCityArmyCreate{}), doCityCreateArmy)
+	m.Post("/action/army/posture", binding.Bind(FormCityArmyPosture{}), doCityArmyPosture)
 	m.Post("/action/city/unit/transfer", binding.Bind(FormCityUnitTransfer{}), doCityTransferUnit)
+	m.Post("/action/reports/read", binding.Bind(FormReportsRead{}), doReportsRead)
+	m.Post("/action/market/place", binding.Bind(FormMarketPlace{}), doMarketPlace)
+	m.Post("/action/market/cancel", binding.Bind(FormMarketCancel{}), doMarketCancel)
+	m.Post("/action/treaty/propose", binding.Bind(FormTreatyPropose{}), doTreatyPropose)
+	m.Post("/action/treaty/accept", binding.Bind(FormTreaty{}), doTreaty("accept"))
+	m.Post("/action/treaty/break", binding.Bind(FormTreaty{}), doTreaty("break"))
 }
 
 type FormLogin struct {
@@ -282,6 +474,13 @@ type FormLogin struct {
 	UserPass string `form:"password" binding:"Required"`
 }
 
+// Mark a report as read, all the reports of the Character if none is given
+type FormReportsRead struct {
+	CharacterId uint64 `form:"cid" binding:"Required"`
+	CityId      uint64 `form:"lid"`
+	ReportId    uint64 `form:"rid"`
+}
+
 type FormCityStudy struct {
 	CharacterId uint64 `form:"cid" binding:"Required"`
 	CityId      uint64 `form:"lid" binding:"Required"`
@@ -322,6 +521,16 @@ type FormCityArmyCommand struct {
 	Action uint64 `form:"what" binding:"Required"`
 }
 
+// Set the posture of an Army against a City, or its default posture without
+// a target: 1 to defend, -1 to assault, 0 to ignore
+type FormCityArmyPosture struct {
+	CharacterId uint64 `form:"cid" binding:"Required"`
+	CityId      uint64 `form:"lid" binding:"Required"`
+	ArmyId      uint64 `form:"aid" binding:"Required"`
+	TargetId    uint64 `form:"target"`
+	Posture     int64  `form:"posture" binding:"Range(-1,1)"`
+}
+
 type FormCityArmyDisband struct {
 	CharacterId uint64 `form:"cid" binding:"Required"`
 	CityId      uint64 `form:"lid" binding:"Required"`
@@ -333,3 +542,33 @@ type FormCityArmyCancel struct {
 	CityId      uint64 `form:"lid" binding:"Required"`
 	ArmyId      uint64 `form:"aid" binding:"Required"`
 }
+
+// Place an order on the market of the Region, the side is either "bid" or "ask"
+type FormMarketPlace struct {
+	CharacterId uint64 `form:"cid" binding:"Required"`
+	CityId      uint64 `form:"lid" binding:"Required"`
+	Resource    uint32 `form:"resource"`
+	Side        string `form:"side" binding:"Required;In(bid,ask)"`
+	Quantity    uint64 `form:"quantity" binding:"Required"`
+	Price       uint64 `form:"price" binding:"Required"`
+}
+
+type FormMarketCancel struct {
+	CharacterId uint64 `form:"cid" binding:"Required"`
+	CityId      uint64 `form:"lid" binding:"Required"`
+	OrderId     uint64 `form:"oid" binding:"Required"`
+}
+
+// Propose a Treaty to another City: 1 for an alliance, 2 for a peace, 3 for a war
+type FormTreatyPropose struct {
+	CharacterId uint64 `form:"cid" binding:"Required"`
+	CityId      uint64 `form:"lid" binding:"Required"`
+	TargetId    uint64 `form:"target" binding:"Required"`
+	Kind        uint32 `form:"kind" binding:"Required;Range(1,3)"`
+}
+
+type FormTreaty struct {
+	CharacterId uint64 `form:"cid" binding:"Required"`
+	CityId      uint64 `form:"lid" binding:"Required"`
+	TreatyId    uint64 `form:"tid" binding:"Required"`
+}
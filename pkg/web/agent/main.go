@@ -7,9 +7,9 @@ package hegemonie_web_agent
 
 import (
 	"context"
+	"crypto/ed25519"
 	"errors"
 	"github.com/go-macaron/pongo2"
-	"github.com/go-macaron/session"
 	region "github.com/jfsmig/hegemonie/pkg/region/proto"
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc"
@@ -31,10 +31,6 @@ func Command() *cobra.Command {
 		Aliases: []string{"srv", "server", "service", "worker"},
 		Short:   "Web service",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			if front.endpointRegion == "" {
-				return errors.New("Missing region URL")
-			}
-
 			if fi, err := os.Stat(front.dirTemplates); err != nil || !fi.IsDir() {
 				return errors.New("Invalid path for the directory of templates")
 			}
@@ -43,7 +39,6 @@ func Command() *cobra.Command {
 			}
 
 			m := macaron.Classic()
-			m.SetDefaultCookieSecret("hege_session")
 			m.Use(pongo2.Pongoer(pongo2.Options{
 				Directory:       front.dirTemplates,
 				Extensions:      []string{".tpl", ".html", ".tmpl"},
@@ -52,16 +47,18 @@ func Command() *cobra.Command {
 				IndentJSON:      true,
 				IndentXML:       true,
 			}))
-			m.Use(session.Sessioner())
-			m.Use(func(ctx *macaron.Context, s session.Store) {
+			m.Use(flasher())
+			m.Use(front.sessioner())
+			m.Use(func(ctx *macaron.Context, s *Session) {
 				auth := func() {
-					uid := s.Get("userid")
-					if uid == "" {
+					if s.UserId == 0 {
 						ctx.Redirect("/index.html")
 					}
 				}
-				// Pages under the /game/* prefix require an established authentication
+				// Pages under the /game/* prefix require an established authentication,
+				// as well as the actions but the login itself
 				switch {
+				case ctx.Req.URL.Path == "/action/login":
 				case strings.HasPrefix(ctx.Req.URL.Path, "/game/"),
 					strings.HasPrefix(ctx.Req.URL.Path, "/action/"):
 					auth()
@@ -80,7 +77,7 @@ func Command() *cobra.Command {
 				return err
 			}
 
-			front.cnxRegion, err = grpc.Dial(front.endpointRegion, grpc.WithInsecure())
+			front.cnxEvents, err = grpc.Dial(front.endpointEvents, grpc.WithInsecure())
 			if err != nil {
 				return err
 			}
@@ -91,8 +88,9 @@ func Command() *cobra.Command {
 		},
 	}
 	agent.Flags().StringVar(&front.endpointNorth, "endpoint", ":8080", "TCP/IP North endpoint")
-	agent.Flags().StringVar(&front.endpointRegion, "region", "", "World Server to be contacted")
+	agent.Flags().StringVar(&front.endpointRegion, "region", "", "Region Server contacted for the regions unknown to the directory")
 	agent.Flags().StringVar(&front.endpointAuth, "auth", "", "Auth Server to be contacted")
+	agent.Flags().StringVar(&front.endpointEvents, "events", "", "Events Server to be contacted")
 	agent.Flags().StringVar(&front.dirTemplates, "templates", "/data/templates", "Directory with the HTML templates")
 	agent.Flags().StringVar(&front.dirStatic, "static", "/data/static", "Directory with the static files")
 	return agent
@@ -104,19 +102,38 @@ type FrontService struct {
 	endpointNorth  string
 	endpointRegion string
 	endpointAuth   string
+	endpointEvents string
 
-	cnxRegion *grpc.ClientConn
 	cnxAuth   *grpc.ClientConn
+	cnxEvents *grpc.ClientConn
+
+	// The directory of the Regions, by name, and the connections to their
+	// services, by endpoint
+	pool       sync.Mutex
+	regions    map[string]string
+	cnxRegions map[string]*grpc.ClientConn
 
 	rw        sync.RWMutex
 	units     map[uint64]*region.UnitTypeView
 	buildings map[uint64]*region.BuildingTypeView
 	knowledge map[uint64]*region.KnowledgeTypeView
+
+	// Verifies the session tokens issued by the auth service
+	sessionKey ed25519.PublicKey
 }
 
 func (f *FrontService) reload() {
 	ctx := context.Background()
-	cli := region.NewDefinitionsClient(f.cnxRegion)
+	f.loadSessionKey(ctx)
+	f.loadRegions(ctx)
+
+	// The definitions are common to all the Regions
+	cnx, err := f.cnxRegionDefault()
+	if err != nil {
+		log.Println("Reload error (definitions):", err.Error())
+		return
+	}
+	cli := region.NewDefinitionsClient(cnx)
 
 	func() {
 		last := uint64(0)
@@ -227,13 +244,6 @@ func atou(s string) uint64 {
 	}
 }
 
-func ptou(p interface{}) uint64 {
-	if p == nil {
-		return 0
-	}
-	return atou(p.(string))
-}
-
 func randomSecret() string {
 	var sb strings.Builder
 	sb.WriteString(strconv.FormatInt(time.Now().UnixNano(), 16))
@@ -7,19 +7,23 @@ package hegemonie_web_agent
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"github.com/go-macaron/session"
+	"google.golang.org/grpc"
 	"gopkg.in/macaron.v1"
-	"io/ioutil"
-	"net/http"
+	"sort"
+	"strings"
+	"time"
 
 	auth "github.com/jfsmig/hegemonie/pkg/auth/proto"
+	events "github.com/jfsmig/hegemonie/pkg/events/proto"
 	region "github.com/jfsmig/hegemonie/pkg/region/proto"
 )
 
-type ActionPage func(*macaron.Context, session.Store, *session.Flash)
+type ActionPage func(*macaron.Context, *Session, *session.Flash)
 
-type NoFlashPage func(*macaron.Context, session.Store)
+type NoFlashPage func(*macaron.Context, *Session)
 
 func (f *FrontService) routePages(m *macaron.Macaron) {
 	m.Get("/", serveRoot)
@@ -33,25 +37,45 @@ func (f *FrontService) routePages(m *macaron.Macaron) {
 	m.Get("/game/land/units", serveGameCityUnits(f))
 	m.Get("/game/land/knowledges", serveGameCityKnowledges(f))
 	m.Get("/game/army", serveGameArmyDetail(f))
+	m.Get("/game/reports", serveGameReports(f))
+	m.Get("/game/market", serveGameMarket(f))
+	m.Get("/game/diplomacy", serveGameDiplomacy(f))
 
 	m.Get("/game/map/region", serveRegionMap(f))
 	m.Get("/game/map/city", serveCityMap(f))
 }
 
-func serveRoot(ctx *macaron.Context, sess session.Store, flash *session.Flash) {
+func serveRoot(ctx *macaron.Context, sess *Session, flash *session.Flash) {
 	ctx.Data["Title"] = "Hegemonie"
-	ctx.Data["userid"] = sess.Get("userid")
+	ctx.Data["userid"] = sess.UserId
 	ctx.HTML(200, "index")
 }
 
 func serveGameAdmin(f *FrontService) ActionPage {
-	return func(ctx *macaron.Context, sess session.Store, flash *session.Flash) {
+	return func(ctx *macaron.Context, sess *Session, flash *session.Flash) {
 		uView, err := f.authenticateAdminFromSession(sess)
 		if err != nil {
 			flash.Error(err.Error())
 			ctx.Redirect("/")
 			return
 		}
+		// The administration applies to one Region at once
+		name := ctx.Query("region")
+		cnx, err := f.cnxRegionOf(context.Background(), name)
+		if err != nil {
+			flash.Warning(err.Error())
+		} else {
+			cliReg := region.NewAdminClient(cnx)
+			sb, err := cliReg.GetScores(context.Background(), &region.None{})
+			if err != nil {
+				flash.Warning(err.Error())
+			} else {
+				ctx.Data["Score"] = sb.Items
+			}
+		}
+		ctx.Data["Region"] = name
+		ctx.Data["Regions"] = f.regionNames()
+
 		ctx.Data["Title"] = uView.Name
 		ctx.Data["userid"] = utoa(uView.Id)
 		ctx.Data["User"] = uView
@@ -60,7 +84,7 @@ func serveGameAdmin(f *FrontService) ActionPage {
 }
 
 func serveGameUser(f *FrontService) ActionPage {
-	return func(ctx *macaron.Context, sess session.Store, flash *session.Flash) {
+	return func(ctx *macaron.Context, sess *Session, flash *session.Flash) {
 		uView, err := f.authenticateUserFromSession(sess)
 		if err != nil {
 			flash.Error(err.Error())
@@ -68,8 +92,13 @@ func serveGameUser(f *FrontService) ActionPage {
 			return
 		}
 
-		cliReg := region.NewCityClient(f.cnxRegion)
 		for _, c := range uView.Characters {
+			cnx, err := f.cnxRegionOf(context.Background(), c.Region)
+			if err != nil {
+				flash.Warning("Error with " + c.Name)
+				continue
+			}
+			cliReg := region.NewCityClient(cnx)
 			l, err := cliReg.List(context.Background(), &region.ListReq{Character: c.Id})
 			if err != nil {
 				flash.Warning("Error with " + c.Name)
@@ -88,16 +117,22 @@ func serveGameUser(f *FrontService) ActionPage {
 }
 
 func serveGameCharacter(f *FrontService) ActionPage {
-	return func(ctx *macaron.Context, sess session.Store, flash *session.Flash) {
+	return func(ctx *macaron.Context, sess *Session, flash *session.Flash) {
 		uView, cView, err := f.authenticateCharacterFromSession(sess, atou(ctx.Query("cid")))
 		if err != nil {
 			flash.Warning(err.Error())
 			ctx.Redirect("/game/user")
 			return
 		}
+		cnx, err := f.cnxRegionOf(context.Background(), cView.Region)
+		if err != nil {
+			flash.Warning("Region error: " + err.Error())
+			ctx.Redirect("/game/user")
+			return
+		}
 
 		// Load the Cities managed by the current Character
-		cliReg := region.NewCityClient(f.cnxRegion)
+		cliReg := region.NewCityClient(cnx)
 		list, err := cliReg.List(context.Background(), &region.ListReq{Character: cView.Id})
 		if err != nil {
 			flash.Warning(err.Error())
@@ -117,16 +152,22 @@ func serveGameCharacter(f *FrontService) ActionPage {
 }
 
 func serveGameCityPage(f *FrontService, template string) ActionPage {
-	return func(ctx *macaron.Context, sess session.Store, flash *session.Flash) {
+	return func(ctx *macaron.Context, sess *Session, flash *session.Flash) {
 		uView, cView, err := f.authenticateCharacterFromSession(sess, atou(ctx.Query("cid")))
 		if err != nil {
 			flash.Warning(err.Error())
 			ctx.Redirect("/game/user")
 			return
 		}
+		cnx, err := f.cnxRegionOf(context.Background(), cView.Region)
+		if err != nil {
+			flash.Warning("Region error: " + err.Error())
+			ctx.Redirect("/game/user")
+			return
+		}
 
 		// Load the chosen City
-		cliReg := region.NewCityClient(f.cnxRegion)
+		cliReg := region.NewCityClient(cnx)
 		lView, err := cliReg.Show(context.Background(),
 			&region.CityId{Character: cView.Id, City: atou(ctx.Query("lid"))})
 		if err != nil {
@@ -181,16 +222,22 @@ func serveGameCityArmies(f *FrontService) ActionPage {
 }
 
 func serveGameArmyDetail(f *FrontService) ActionPage {
-	return func(ctx *macaron.Context, sess session.Store, flash *session.Flash) {
+	return func(ctx *macaron.Context, sess *Session, flash *session.Flash) {
 		uView, cView, err := f.authenticateCharacterFromSession(sess, atou(ctx.Query("cid")))
 		if err != nil {
 			flash.Warning("Auth error: " + err.Error())
 			ctx.Redirect("/game/user")
 			return
 		}
+		cnx, err := f.cnxRegionOf(context.Background(), cView.Region)
+		if err != nil {
+			flash.Warning("Region error: " + err.Error())
+			ctx.Redirect("/game/user")
+			return
+		}
 
 		// Load the chosen City
-		cliReg := region.NewCityClient(f.cnxRegion)
+		cliReg := region.NewCityClient(cnx)
 		lView, err := cliReg.Show(context.Background(),
 			&region.CityId{Character: cView.Id, City: atou(ctx.Query("lid"))})
 		if err != nil {
@@ -200,7 +247,7 @@ func serveGameArmyDetail(f *FrontService) ActionPage {
 		}
 
 		// Load the chosen Army
-		cliArmy := region.NewArmyClient(f.cnxRegion)
+		cliArmy := region.NewArmyClient(cnx)
 		aView, err := cliArmy.Show(context.Background(),
 			&region.ArmyId{Character: cView.Id, City: lView.Id, Army: atou(ctx.Query("aid"))})
 		if err != nil {
@@ -231,67 +278,510 @@ func serveGameArmyDetail(f *FrontService) ActionPage {
 		ctx.Data["Land"] = lView
 		ctx.Data["aid"] = utoa(aView.Id)
 		ctx.Data["Army"] = aView
+		ctx.Data["Postures"] = postureViews(aView.Postures)
+		ctx.Data["DefaultPosture"] = postureNames[aView.DefaultPosture]
 
 		ctx.HTML(200, "army")
 	}
 }
 
+// The names of the postures of an Army, by value
+var postureNames = map[int64]string{1: "defend", 0: "ignore", -1: "assault"}
+
+// An explicit posture of an Army against a City
+type postureView struct {
+	City    uint64
+	Posture string
+}
+
+func postureViews(postures []int64) []postureView {
+	out := make([]postureView, 0, len(postures))
+	for _, p := range postures {
+		if p < 0 {
+			out = append(out, postureView{City: uint64(-p), Posture: postureNames[-1]})
+		} else {
+			out = append(out, postureView{City: uint64(p), Posture: postureNames[1]})
+		}
+	}
+	return out
+}
+
 func serveGameCityBudget(f *FrontService) ActionPage {
 	return serveGameCityPage(f, "land_budget")
 }
 
-func serveRegionMap(f *FrontService) NoFlashPage {
-	return func(ctx *macaron.Context, s session.Store) {
-		// TODO(VDO): handle error
-		resp, _ := http.Get("http://" + f.endpointRegion + "/cmd_back_region/places")
-		defer resp.Body.Close()
-		if resp.StatusCode != http.StatusOK {
-			// Backend error
-			ctx.Resp.WriteHeader(503)
+// The radius of the map around a City, in steps, unless ?radius= says otherwise
+const defaultCityMapRadius = 5
+
+// Load all the pages of the map, around the center Cell if not zero, and
+// the positions of the Cities, as JSON documents for the map template.
+func loadMap(ctx context.Context, cnx *grpc.ClientConn, center uint64, radius uint32) (string, string, error) {
+	cli := region.NewMapClient(cnx)
+
+	all := &region.MapView{}
+	for marker := uint64(0); ; {
+		page, err := cli.Cells(ctx, &region.MapReq{Marker: marker, Max: 1000, Center: center, Radius: radius})
+		if err != nil {
+			return "", "", err
+		}
+		if len(page.Cells) == 0 {
+			break
+		}
+		all.Cells = append(all.Cells, page.Cells...)
+		all.Roads = append(all.Roads, page.Roads...)
+		marker = page.Cells[len(page.Cells)-1].Id
+	}
+
+	var cities []*region.CityPosition
+	for marker := uint64(0); ; {
+		page, err := cli.Cities(ctx, &region.PaginatedQuery{Marker: marker, Max: 1000})
+		if err != nil {
+			return "", "", err
+		}
+		if len(page.Items) == 0 {
+			break
+		}
+		cities = append(cities, page.Items...)
+		marker = page.Items[len(page.Items)-1].Id
+	}
+
+	encodedMap, err := json.Marshal(all)
+	if err != nil {
+		return "", "", err
+	}
+	encodedCities, err := json.Marshal(cities)
+	if err != nil {
+		return "", "", err
+	}
+	return string(encodedMap), string(encodedCities), nil
+}
+
+func serveRegionMap(f *FrontService) ActionPage {
+	return func(ctx *macaron.Context, s *Session, flash *session.Flash) {
+		cnx, err := f.cnxRegionOf(context.Background(), ctx.Query("region"))
+		if err != nil {
+			flash.Warning("Region error: " + err.Error())
+			ctx.Redirect("/game/user")
+			return
+		}
+		m, cities, err := loadMap(context.Background(), cnx, 0, 0)
+		if err != nil {
+			flash.Warning("Region error: " + err.Error())
+			ctx.Redirect("/game/user")
+			return
+		}
+
+		ctx.Data["map"] = m
+		ctx.Data["cities"] = cities
+		ctx.HTML(200, "map")
+	}
+}
+
+func serveCityMap(f *FrontService) ActionPage {
+	return func(ctx *macaron.Context, sess *Session, flash *session.Flash) {
+		_, cView, err := f.authenticateCharacterFromSession(sess, atou(ctx.Query("cid")))
+		if err != nil {
+			flash.Warning(err.Error())
+			ctx.Redirect("/game/user")
+			return
+		}
+		cnx, err := f.cnxRegionOf(context.Background(), cView.Region)
+		if err != nil {
+			flash.Warning("Region error: " + err.Error())
+			ctx.Redirect("/game/user")
 			return
 		}
-		mapBytes, _ := ioutil.ReadAll(resp.Body)
 
-		resp2, _ := http.Get("http://" + f.endpointRegion + "/cmd_back_region/cities")
-		defer resp2.Body.Close()
-		if resp2.StatusCode != http.StatusOK {
-			// Backend error
-			ctx.Resp.WriteHeader(503)
+		lView, err := region.NewCityClient(cnx).Show(context.Background(),
+			&region.CityId{Character: cView.Id, City: atou(ctx.Query("lid"))})
+		if err != nil {
+			flash.Warning("Region error: " + err.Error())
+			ctx.Redirect("/game/character?cid=" + fmt.Sprint(cView.Id))
 			return
 		}
-		mapCities, _ := ioutil.ReadAll(resp2.Body)
 
-		ctx.Data["map"] = string(mapBytes)
-		ctx.Data["cities"] = string(mapCities)
+		radius := uint32(defaultCityMapRadius)
+		if r := ctx.Query("radius"); r != "" {
+			radius = uint32(atou(r))
+		}
+		m, cities, err := loadMap(context.Background(), cnx, lView.Cell, radius)
+		if err != nil {
+			flash.Warning("Region error: " + err.Error())
+			ctx.Redirect("/game/character?cid=" + fmt.Sprint(cView.Id))
+			return
+		}
 
+		ctx.Data["map"] = m
+		ctx.Data["cities"] = cities
 		ctx.HTML(200, "map")
 	}
 }
 
-func serveCityMap(f *FrontService) NoFlashPage {
-	return func(ctx *macaron.Context, s session.Store) {
-		// TODO(VDO): handle error
-		resp, _ := http.Get("http://" + f.endpointRegion + "/cmd_back_region/places")
-		defer resp.Body.Close()
-		if resp.StatusCode != http.StatusOK {
-			// Backend error
-			ctx.Resp.WriteHeader(503)
+// The number of Resources carried by the ResourcesAbs of the Region
+const resourceCount = 6
+
+// An Order of the market, as displayed in the order book
+type orderView struct {
+	Id       uint64
+	City     uint64
+	Resource uint32
+	Bid      bool
+	Quantity uint64
+	Price    uint64
+	Mine     bool
+}
+
+func serveGameMarket(f *FrontService) ActionPage {
+	return func(ctx *macaron.Context, sess *Session, flash *session.Flash) {
+		uView, cView, err := f.authenticateCharacterFromSession(sess, atou(ctx.Query("cid")))
+		if err != nil {
+			flash.Warning(err.Error())
+			ctx.Redirect("/game/user")
+			return
+		}
+		cnx, err := f.cnxRegionOf(context.Background(), cView.Region)
+		if err != nil {
+			flash.Warning("Region error: " + err.Error())
+			ctx.Redirect("/game/user")
+			return
+		}
+		lView, err := region.NewCityClient(cnx).Show(context.Background(),
+			&region.CityId{Character: cView.Id, City: atou(ctx.Query("lid"))})
+		if err != nil {
+			flash.Warning("Region error: " + err.Error())
+			ctx.Redirect("/game/character?cid=" + fmt.Sprint(cView.Id))
 			return
 		}
-		mapBytes, _ := ioutil.ReadAll(resp.Body)
 
-		resp2, _ := http.Get("http://" + f.endpointRegion + "/cmd_back_region/cities")
-		defer resp2.Body.Close()
-		if resp2.StatusCode != http.StatusOK {
-			// Backend error
-			ctx.Resp.WriteHeader(503)
+		// Load the whole order book, the best prices first
+		cli := region.NewMarketClient(cnx)
+		orders := make([]orderView, 0)
+		var currency uint32
+		for marker := uint64(0); ; {
+			page, err := cli.List(context.Background(), &region.PaginatedQuery{Marker: marker, Max: 1000})
+			if err != nil {
+				flash.Warning("Region error: " + err.Error())
+				ctx.Redirect("/game/land/overview?cid=" + utoa(cView.Id) + "&lid=" + utoa(lView.Id))
+				return
+			}
+			currency = page.Currency
+			if len(page.Items) == 0 {
+				break
+			}
+			for _, o := range page.Items {
+				orders = append(orders, orderView{
+					Id: o.Id, City: o.City, Resource: o.Resource, Bid: o.Bid,
+					Quantity: o.Quantity, Price: o.Price, Mine: o.City == lView.Id,
+				})
+			}
+			marker = page.Items[len(page.Items)-1].Id
+		}
+		sort.SliceStable(orders, func(i, j int) bool {
+			a, b := orders[i], orders[j]
+			if a.Resource != b.Resource {
+				return a.Resource < b.Resource
+			}
+			if a.Bid != b.Bid {
+				return a.Bid
+			}
+			if a.Bid {
+				return a.Price > b.Price
+			}
+			return a.Price < b.Price
+		})
+
+		tradable := make([]uint32, 0, resourceCount)
+		for r := uint32(0); r < resourceCount; r++ {
+			if r != currency {
+				tradable = append(tradable, r)
+			}
+		}
+
+		ctx.Data["Title"] = cView.Name + "|" + lView.Name
+		ctx.Data["userid"] = utoa(uView.Id)
+		ctx.Data["User"] = uView
+		ctx.Data["cid"] = utoa(cView.Id)
+		ctx.Data["Character"] = cView
+		ctx.Data["lid"] = utoa(lView.Id)
+		ctx.Data["Land"] = lView
+		ctx.Data["Orders"] = orders
+		ctx.Data["Currency"] = currency
+		ctx.Data["Tradable"] = tradable
+		ctx.HTML(200, "market")
+	}
+}
+
+// The names of the kinds of Treaty, by kind
+var treatyKinds = map[uint32]string{1: "alliance", 2: "peace", 3: "war"}
+
+// A Treaty of the City, as displayed on the diplomacy page
+type treatyView struct {
+	Id       uint64
+	Kind     string
+	Other    uint64
+	Accepted bool
+	// Proposed by the other City
+	Incoming bool
+	War      bool
+}
+
+func serveGameDiplomacy(f *FrontService) ActionPage {
+	return func(ctx *macaron.Context, sess *Session, flash *session.Flash) {
+		uView, cView, err := f.authenticateCharacterFromSession(sess, atou(ctx.Query("cid")))
+		if err != nil {
+			flash.Warning(err.Error())
+			ctx.Redirect("/game/user")
+			return
+		}
+		cnx, err := f.cnxRegionOf(context.Background(), cView.Region)
+		if err != nil {
+			flash.Warning("Region error: " + err.Error())
+			ctx.Redirect("/game/user")
+			return
+		}
+		id := &region.CityId{Character: cView.Id, City: atou(ctx.Query("lid"))}
+		lView, err := region.NewCityClient(cnx).Show(context.Background(), id)
+		if err != nil {
+			flash.Warning("Region error: " + err.Error())
+			ctx.Redirect("/game/character?cid=" + fmt.Sprint(cView.Id))
+			return
+		}
+		list, err := region.NewDiplomacyClient(cnx).List(context.Background(), id)
+		if err != nil {
+			flash.Warning("Region error: " + err.Error())
+			ctx.Redirect("/game/land/overview?cid=" + utoa(cView.Id) + "&lid=" + utoa(lView.Id))
 			return
 		}
-		mapCities, _ := ioutil.ReadAll(resp2.Body)
 
-		ctx.Data["map"] = string(mapBytes)
-		ctx.Data["cities"] = string(mapCities)
+		inForce := make([]treatyView, 0)
+		proposed := make([]treatyView, 0)
+		for _, t := range list.Items {
+			v := treatyView{
+				Id: t.Id, Kind: treatyKinds[t.Kind], Other: t.Target, Accepted: t.Accepted,
+				Incoming: t.Target == lView.Id, War: t.Kind == 3,
+			}
+			if v.Incoming {
+				v.Other = t.Proposer
+			}
+			if v.Accepted {
+				inForce = append(inForce, v)
+			} else {
+				proposed = append(proposed, v)
+			}
+		}
 
-		ctx.HTML(200, "map")
+		ctx.Data["Title"] = cView.Name + "|" + lView.Name
+		ctx.Data["userid"] = utoa(uView.Id)
+		ctx.Data["User"] = uView
+		ctx.Data["cid"] = utoa(cView.Id)
+		ctx.Data["Character"] = cView
+		ctx.Data["lid"] = utoa(lView.Id)
+		ctx.Data["Land"] = lView
+		ctx.Data["InForce"] = inForce
+		ctx.Data["Proposed"] = proposed
+		ctx.HTML(200, "diplomacy")
+	}
+}
+
+// The fields of the events of the Region, as carried by the reports
+type reportPayload struct {
+	Army      uint64
+	Other     uint64
+	Cell      uint64
+	Type      uint64
+	Count     int
+	Resources []uint64
+	Region    string
+}
+
+type reportView struct {
+	Id   uint64
+	When string
+	City uint64
+	Text string
+	Read bool
+}
+
+func serveGameReports(f *FrontService) ActionPage {
+	return func(ctx *macaron.Context, sess *Session, flash *session.Flash) {
+		uView, cView, err := f.authenticateCharacterFromSession(sess, atou(ctx.Query("cid")))
+		if err != nil {
+			flash.Warning(err.Error())
+			ctx.Redirect("/game/user")
+			return
+		}
+
+		// Optionally restrict the reports to a City managed by the Character
+		req := &events.ListReportsReq{
+			Character: cView.Id,
+			Marker:    atou(ctx.Query("marker")),
+			Max:       50,
+			Unread:    ctx.Query("unread") != "",
+		}
+		if lid := atou(ctx.Query("lid")); lid != 0 {
+			var lView *region.CityView
+			cnx, err := f.cnxRegionOf(context.Background(), cView.Region)
+			if err == nil {
+				lView, err = region.NewCityClient(cnx).Show(context.Background(),
+					&region.CityId{Character: cView.Id, City: lid})
+			}
+			if err != nil {
+				flash.Warning("Region error: " + err.Error())
+				ctx.Redirect("/game/character?cid=" + utoa(cView.Id))
+				return
+			}
+			req.Region = cView.Region
+			req.City = lView.Id
+			ctx.Data["lid"] = utoa(lView.Id)
+			ctx.Data["Land"] = lView
+		}
+
+		cliEvt := events.NewReportsClient(f.cnxEvents)
+		list, err := cliEvt.List(context.Background(), req)
+		if err != nil {
+			flash.Warning("Reports error: " + err.Error())
+			ctx.Redirect("/game/character?cid=" + utoa(cView.Id))
+			return
+		}
+
+		reports := make([]reportView, 0, len(list.Items))
+		for _, r := range list.Items {
+			reports = append(reports, reportView{
+				Id:   r.Id,
+				When: time.Unix(0, r.When).UTC().Format("2006-01-02 15:04"),
+				City: r.City,
+				Text: f.describeReport(r),
+				Read: r.Read,
+			})
+		}
+
+		ctx.Data["Title"] = cView.Name + "|Reports"
+		ctx.Data["userid"] = utoa(uView.Id)
+		ctx.Data["User"] = uView
+		ctx.Data["cid"] = utoa(cView.Id)
+		ctx.Data["Character"] = cView
+		ctx.Data["Reports"] = reports
+		ctx.Data["Unread"] = list.Unread
+		ctx.Data["UnreadOnly"] = req.Unread
+		if len(reports) >= int(req.Max) {
+			ctx.Data["Next"] = utoa(reports[len(reports)-1].Id)
+		}
+		ctx.HTML(200, "reports")
+	}
+}
+
+// Turn the report into a sentence for the player
+func (f *FrontService) describeReport(r *events.Report) string {
+	var p reportPayload
+	if err := json.Unmarshal(r.Payload, &p); err != nil {
+		return r.Kind
+	}
+
+	f.rw.RLock()
+	unit, building, knowledge := "unit", "building", "knowledge"
+	if t := f.units[p.Type]; t != nil {
+		unit = t.Name
+	}
+	if t := f.buildings[p.Type]; t != nil {
+		building = t.Name
+	}
+	if t := f.knowledge[p.Type]; t != nil {
+		knowledge = t.Name
+	}
+	f.rw.RUnlock()
+
+	city := fmt.Sprintf("city %d", p.Other)
+	army := fmt.Sprintf("army %d", p.Army)
+	switch r.Kind {
+	case "unit.trained":
+		return "A " + unit + " finished its training"
+	case "building.completed":
+		return "The construction of a " + building + " is complete"
+	case "knowledge.learned":
+		return "The study of " + knowledge + " is complete"
+	case "tax.paid":
+		return "Tax paid to " + city + ": " + formatResources(p.Resources)
+	case "tax.received":
+		return "Tax received from " + city + ": " + formatResources(p.Resources)
+	case "city.conquered":
+		return "The city has been conquered by " + city
+	case "city.conquest":
+		return "The city conquered " + city
+	case "city.liberated":
+		return "The city has been liberated by " + city
+	case "city.liberation":
+		return "The city liberated " + city
+	case "city.liege.lost":
+		return "The city is not the overlord of " + city + " anymore"
+	case "city.freed":
+		return "The city defeated its overlord " + city + " and gained its freedom"
+	case "army.moved":
+		return fmt.Sprintf("The %s moved to cell %d", army, p.Cell)
+	case "army.passage":
+		return fmt.Sprintf("The %s of %s passed by", army, city)
+	case "army.arrived":
+		return fmt.Sprintf("The %s reached cell %d", army, p.Cell)
+	case "army.noroute":
+		return fmt.Sprintf("The %s found no route to cell %d", army, p.Cell)
+	case "army.fled":
+		return fmt.Sprintf("The %s fled the fight on cell %d", army, p.Cell)
+	case "army.flipped":
+		return fmt.Sprintf("The %s changed its side in the fight on cell %d", army, p.Cell)
+	case "army.departed":
+		return fmt.Sprintf("The %s left for cell %d of %s", army, p.Cell, p.Region)
+	case "army.refused":
+		return fmt.Sprintf("The %s was refused by %s and stays on cell %d", army, p.Region, p.Cell)
+	case "army.returned":
+		return fmt.Sprintf("The %s came back from %s on cell %d", army, p.Region, p.Cell)
+	case "army.disbanded":
+		return fmt.Sprintf("The %s has been disbanded in %s, with %d units", army, city, p.Count)
+	case "resources.deposited":
+		return "The " + army + " deposited in " + city + ": " + formatResources(p.Resources)
+	case "resources.received":
+		return "An army of " + city + " delivered: " + formatResources(p.Resources)
+	case "units.received":
+		return fmt.Sprintf("An army of %s joined the city with %d units", city, p.Count)
+	case "transport.sent":
+		return "The " + army + " left for " + city + " with: " + formatResources(p.Resources)
+	case "transport.lost":
+		return fmt.Sprintf("The %s has been intercepted on cell %d by an army of %s, that seized: %s", army, p.Cell, city, formatResources(p.Resources))
+	case "transport.seized":
+		return fmt.Sprintf("The %s intercepted on cell %d a transport of %s and seized: %s", army, p.Cell, city, formatResources(p.Resources))
+	case "treaty.proposed":
+		return "A treaty of " + treatyKinds[uint32(p.Type)] + " has been proposed by " + city
+	case "treaty.accepted":
+		return "The treaty of " + treatyKinds[uint32(p.Type)] + " has been accepted by " + city
+	case "treaty.broken":
+		return "The treaty of " + treatyKinds[uint32(p.Type)] + " has been broken by " + city
+	case "war.declared":
+		return "The war has been declared by " + city
+	case "market.bought":
+		return "Bought on the market from " + city + ": " + formatResources(p.Resources)
+	case "market.sold":
+		return "Sold on the market to " + city + ", paid: " + formatResources(p.Resources)
+	case "massacre.suffered":
+		return "An army of " + city + " massacred the population"
+	case "massacre.done":
+		return "The " + army + " massacred the population of " + city
+	case "building.broken":
+		return "An army of " + city + " broke a " + building
+	case "building.destroyed":
+		return "The " + army + " broke a " + building + " of " + city
+	case "unit.killed":
+		return "A " + unit + " died in a fight"
+	case "fight.won":
+		return fmt.Sprintf("Victory in the fight on cell %d", p.Cell)
+	case "fight.lost":
+		return fmt.Sprintf("Defeat in the fight on cell %d", p.Cell)
+	default:
+		return r.Kind
+	}
+}
+
+func formatResources(r []uint64) string {
+	tokens := make([]string, 0, len(r))
+	for _, x := range r {
+		tokens = append(tokens, fmt.Sprint(x))
 	}
+	return strings.Join(tokens, "/")
 }
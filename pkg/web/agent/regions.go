@@ -0,0 +1,105 @@
+// Copyright (C) 2018-2020 Hegemonie's AUTHORS
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package hegemonie_web_agent
+
+import (
+	"context"
+	"errors"
+	auth "github.com/jfsmig/hegemonie/pkg/auth/proto"
+	"google.golang.org/grpc"
+	"log"
+	"sort"
+)
+
+// Refresh the directory of the Regions from the auth service
+func (f *FrontService) loadRegions(ctx context.Context) {
+	rep, err := auth.NewAuthClient(f.cnxAuth).RegionList(ctx, &auth.None{})
+	if err != nil {
+		log.Println("Reload error (regions):", err.Error())
+		return
+	}
+	tab := make(map[string]string)
+	for _, r := range rep.Items {
+		tab[r.Name] = r.Endpoint
+	}
+	f.pool.Lock()
+	f.regions = tab
+	f.pool.Unlock()
+}
+
+// Return the connection to the endpoint, dialed at the first use. The
+// connections are kept even when their Region leaves the directory, the
+// endpoints are few and the Regions come back after a restart.
+func (f *FrontService) dialRegion(endpoint string) (*grpc.ClientConn, error) {
+	f.pool.Lock()
+	defer f.pool.Unlock()
+	if cnx, ok := f.cnxRegions[endpoint]; ok {
+		return cnx, nil
+	}
+	cnx, err := grpc.Dial(endpoint, grpc.WithInsecure())
+	if err != nil {
+		return nil, err
+	}
+	if f.cnxRegions == nil {
+		f.cnxRegions = make(map[string]*grpc.ClientConn)
+	}
+	f.cnxRegions[endpoint] = cnx
+	return cnx, nil
+}
+
+func (f *FrontService) lookupRegion(name string) (string, bool) {
+	f.pool.Lock()
+	defer f.pool.Unlock()
+	endpoint, ok := f.regions[name]
+	return endpoint, ok
+}
+
+// Return the connection to the service of the Region. A Region unknown to
+// the directory triggers a refresh, in case it just registered, then falls
+// back to the default Region service.
+func (f *FrontService) cnxRegionOf(ctx context.Context, name string) (*grpc.ClientConn, error) {
+	if name == "" {
+		return f.cnxRegionDefault()
+	}
+	endpoint, ok := f.lookupRegion(name)
+	if !ok {
+		f.loadRegions(ctx)
+		endpoint, ok = f.lookupRegion(name)
+	}
+	if !ok {
+		if f.endpointRegion == "" {
+			return nil, errors.New("Region unavailable")
+		}
+		endpoint = f.endpointRegion
+	}
+	return f.dialRegion(endpoint)
+}
+
+// Return the connection to the default Region service, or to the first
+// Region of the directory if there is none.
+func (f *FrontService) cnxRegionDefault() (*grpc.ClientConn, error) {
+	if f.endpointRegion != "" {
+		return f.dialRegion(f.endpointRegion)
+	}
+	names := f.regionNames()
+	if len(names) == 0 {
+		return nil, errors.New("No region available")
+	}
+	endpoint, _ := f.lookupRegion(names[0])
+	return f.dialRegion(endpoint)
+}
+
+// Return the names of the Regions of the directory, sorted
+func (f *FrontService) regionNames() []string {
+	f.pool.Lock()
+	defer f.pool.Unlock()
+	names := make([]string, 0, len(f.regions))
+	for name := range f.regions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
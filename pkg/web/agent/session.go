@@ -0,0 +1,139 @@
+// Copyright (C) 2018-2020 Hegemonie's AUTHORS
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package hegemonie_web_agent
+
+import (
+	"context"
+	"crypto/ed25519"
+	"github.com/go-macaron/session"
+	authmodel "github.com/jfsmig/hegemonie/pkg/auth/model"
+	auth "github.com/jfsmig/hegemonie/pkg/auth/proto"
+	"gopkg.in/macaron.v1"
+	"log"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+const (
+	cookieSession = "hege_session"
+	cookieFlash   = "macaron_flash"
+)
+
+// The session of the current request, restored from the signed token in the
+// session cookie. The token is verified locally, so that any instance of the
+// web service can serve any request. Only the expired tokens need a call to
+// the auth service, to be refreshed.
+type Session struct {
+	UserId uint64
+	Token  string
+}
+
+func (f *FrontService) loadSessionKey(ctx context.Context) ed25519.PublicKey {
+	rep, err := auth.NewAuthClient(f.cnxAuth).SessionKey(ctx, &auth.None{})
+	if err != nil {
+		log.Println("Session key error:", err.Error())
+		return nil
+	}
+	f.rw.Lock()
+	f.sessionKey = rep.Key
+	f.rw.Unlock()
+	return rep.Key
+}
+
+func (f *FrontService) getSessionKey(ctx context.Context) ed25519.PublicKey {
+	f.rw.RLock()
+	key := f.sessionKey
+	f.rw.RUnlock()
+	if key == nil {
+		key = f.loadSessionKey(ctx)
+	}
+	return key
+}
+
+func setSessionCookie(ctx *macaron.Context, s *auth.SessionView) {
+	http.SetCookie(ctx.Resp, &http.Cookie{
+		Name:     cookieSession,
+		Value:    s.Token,
+		Path:     "/",
+		MaxAge:   int(s.Until - time.Now().Unix()),
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	})
+}
+
+func clearSessionCookie(ctx *macaron.Context) {
+	http.SetCookie(ctx.Resp, &http.Cookie{
+		Name:     cookieSession,
+		Path:     "/",
+		MaxAge:   -1,
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	})
+}
+
+// Map the *Session of the request, anonymous when the cookie is missing or
+// the token invalid
+func (f *FrontService) sessioner() macaron.Handler {
+	return func(ctx *macaron.Context) {
+		sess := &Session{}
+		if token := ctx.GetCookie(cookieSession); token != "" {
+			f.restoreSession(ctx, sess, token)
+		}
+		ctx.Map(sess)
+	}
+}
+
+func (f *FrontService) restoreSession(ctx *macaron.Context, sess *Session, token string) {
+	key := f.getSessionKey(ctx.Req.Context())
+	if key == nil {
+		// The auth service is not reachable, the session is kept for later
+		return
+	}
+	claims, err := authmodel.VerifySession(key, token, time.Now())
+	switch err {
+	case nil:
+	case authmodel.ErrSessionExpired:
+		cli := auth.NewAuthClient(f.cnxAuth)
+		rep, err := cli.SessionRefresh(ctx.Req.Context(), &auth.SessionReq{Token: token})
+		if err != nil {
+			clearSessionCookie(ctx)
+			return
+		}
+		setSessionCookie(ctx, rep)
+		token = rep.Token
+	default:
+		clearSessionCookie(ctx)
+		return
+	}
+	sess.UserId = claims.User
+	sess.Token = token
+}
+
+// Map the *session.Flash of the request, carried by a cookie to the next
+// request like session.Sessioner does, but without any session kept in RAM.
+func flasher() macaron.Handler {
+	return func(ctx *macaron.Context) {
+		vals, _ := url.ParseQuery(ctx.GetCookie(cookieFlash))
+		if len(vals) > 0 {
+			prev := &session.Flash{Values: vals}
+			prev.ErrorMsg = prev.Get("error")
+			prev.SuccessMsg = prev.Get("success")
+			prev.InfoMsg = prev.Get("info")
+			prev.WarningMsg = prev.Get("warning")
+			ctx.Data["Flash"] = prev
+			ctx.SetCookie(cookieFlash, "", -1)
+		}
+
+		next := &session.Flash{Values: url.Values{}}
+		ctx.Resp.Before(func(macaron.ResponseWriter) {
+			if encoded := next.Encode(); len(encoded) > 0 {
+				ctx.SetCookie(cookieFlash, encoded, 0)
+			}
+		})
+		ctx.Map(next)
+	}
+}
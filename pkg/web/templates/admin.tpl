@@ -1,13 +1,30 @@
 {% include "header.tpl" %}
 
+    {% if Regions %}
+    <div>
+        <h2>Regions</h2>
+        <ul>
+            {% for r in Regions %}
+            <li>{% if r == Region %}{{r}}{% else %}<a href="/game/admin?region={{r|urlencode}}">{{r}}</a>{% endif %}</li>
+            {% endfor %}
+        </ul>
+    </div>
+    {% endif %}
+
     <div>
         <h2>Production</h2>
-        <form action="/action/produce" method="post"><input type="submit" value="Produce"/></form>
+        <form action="/action/produce" method="post">
+            <input type="hidden" name="region" value="{{Region}}"/>
+            <input type="submit" value="Produce"/>
+        </form>
     </div>
 
     <div>
         <h2>Movement</h2>
-        <form action="/action/move" method="post"><input type="submit" value="Movement"/></form>
+        <form action="/action/move" method="post">
+            <input type="hidden" name="region" value="{{Region}}"/>
+            <input type="submit" value="Movement"/>
+        </form>
     </div>
 
     <div class="large">
@@ -23,7 +40,7 @@
             <tbody>
             {% for s in Score %}
             <tr>
-                <td>{{s.Id}}</td>
+                <td>{{s.City}}</td>
                 <td>{{s.Name}}</td>
                 <td>{{s.Score}}</td>
             </tr>
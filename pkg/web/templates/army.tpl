@@ -49,6 +49,26 @@
     </form>
 </div>
 
+<div><h2>Postures</h2>
+    <p>The Army defends the friendly cities, ignores the cities at peace, and
+    is set to <strong>{{DefaultPosture}}</strong> the others, except:</p>
+    <ul>{% for p in Postures %}
+        <li>{{p.Posture}} city {{p.City}}</li>{% endfor %}
+    </ul>
+    <form action="/action/army/posture" method="post">
+        <input type="hidden" name="aid" value="{{aid}}"/>
+        <input type="hidden" name="cid" value="{{cid}}"/>
+        <input type="hidden" name="lid" value="{{lid}}"/>
+        <select name="posture">
+            <option value="1">Defend</option>
+            <option value="0">Ignore</option>
+            <option value="-1">Assault</option>
+        </select>
+        city <input type="number" name="target" min="0" value="0"/> (0 for the others)
+        <input type="submit" value="Set"/>
+    </form>
+</div>
+
 <div><h2>Move</h2>
     <p>Just move by thegiven City.</p>
     <form action="/action/army/move" method="post">
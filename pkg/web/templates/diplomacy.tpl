@@ -0,0 +1,58 @@
+{% include "header.tpl" %}
+<div class="large"><h2>Treaties in force</h2>
+    <table>
+        <tbody>{% for t in InForce %}
+        <tr>
+            <td>{{t.Kind}}</td>
+            <td>with city {{t.Other}}</td>
+            <td>{% if not t.War %}
+                <form action="/action/treaty/break" method="post">
+                    <input type="hidden" name="cid" value="{{cid}}"/>
+                    <input type="hidden" name="lid" value="{{lid}}"/>
+                    <input type="hidden" name="tid" value="{{t.Id}}"/>
+                    <input type="submit" value="Break"/>
+                </form>{% endif %}
+            </td>
+        </tr>{% endfor %}
+        </tbody>
+    </table>
+</div>
+<div class="large"><h2>Proposals</h2>
+    <table>
+        <tbody>{% for t in Proposed %}
+        <tr>
+            <td>{{t.Kind}}</td>
+            <td>{% if t.Incoming %}from{% else %}to{% endif %} city {{t.Other}}</td>
+            <td>{% if t.Incoming %}
+                <form action="/action/treaty/accept" method="post">
+                    <input type="hidden" name="cid" value="{{cid}}"/>
+                    <input type="hidden" name="lid" value="{{lid}}"/>
+                    <input type="hidden" name="tid" value="{{t.Id}}"/>
+                    <input type="submit" value="Accept"/>
+                </form>{% endif %}
+                <form action="/action/treaty/break" method="post">
+                    <input type="hidden" name="cid" value="{{cid}}"/>
+                    <input type="hidden" name="lid" value="{{lid}}"/>
+                    <input type="hidden" name="tid" value="{{t.Id}}"/>
+                    <input type="submit" value="{% if t.Incoming %}Refuse{% else %}Withdraw{% endif %}"/>
+                </form>
+            </td>
+        </tr>{% endfor %}
+        </tbody>
+    </table>
+</div>
+<div><h2>New treaty</h2>
+    <form action="/action/treaty/propose" method="post">
+        <input type="hidden" name="cid" value="{{cid}}"/>
+        <input type="hidden" name="lid" value="{{lid}}"/>
+        <select name="kind">
+            <option value="1">Alliance</option>
+            <option value="2">Peace</option>
+            <option value="3">War</option>
+        </select>
+        with city <input type="number" name="target" min="1"/>
+        <input type="submit" value="Propose"/>
+    </form>
+    <p>A war is declared at once. Breaking a treaty in force costs popularity.</p>
+</div>
+{% include "footer.tpl" %}
@@ -29,6 +29,10 @@
         <a href="/game/land/armies?cid={{ cid }}&lid={{ lid }}">Armies</a>
         <a href="/game/land/buildings?cid={{ cid }}&lid={{ lid }}">Building</a>
         <a href="/game/land/knowledges?cid={{ cid }}&lid={{ lid }}">Science</a>
+        <a href="/game/reports?cid={{ cid }}&lid={{ lid }}">Reports</a>
+        <a href="/game/map/city?cid={{ cid }}&lid={{ lid }}">Map</a>
+        <a href="/game/market?cid={{ cid }}&lid={{ lid }}">Market</a>
+        <a href="/game/diplomacy?cid={{ cid }}&lid={{ lid }}">Diplomacy</a>
         <br/>
         {% endif %}
 
@@ -42,6 +46,7 @@
 
         {% if cid %}
         <a href="/game/character?cid={{ cid }}">Character</a>
+        <a href="/game/reports?cid={{ cid }}">Reports</a>
         {% endif %}
 
     </nav>
@@ -18,6 +18,7 @@
     <ul>{% for a in Land.Assets.Armies %}
         <li>
             <a href="/game/army?cid={{Character.Id}}&lid={{Land.Id}}&aid={{a.Id}}">{{a.Name}}</a>
+            {% if a.Transport %}(transport){% endif %}
         </li>{% endfor %}
     </ul>
 </div>
@@ -0,0 +1,43 @@
+{% include "header.tpl" %}
+<div class="large"><h2>Market</h2>
+    <p>Prices in r{{Currency}}, the orders are matched at each production.</p>
+    <table>
+        <thead>
+        <tr><th>Resource</th><th>Side</th><th>Quantity</th><th>Price</th><th>City</th><th></th></tr>
+        </thead>
+        <tbody>{% for o in Orders %}
+        <tr>
+            <td>r{{o.Resource}}</td>
+            <td>{% if o.Bid %}bid{% else %}ask{% endif %}</td>
+            <td>{{o.Quantity}}</td>
+            <td>{{o.Price}}</td>
+            <td>{% if o.Mine %}<strong>{{Land.Name}}</strong>{% else %}city {{o.City}}{% endif %}</td>
+            <td>{% if o.Mine %}
+                <form action="/action/market/cancel" method="post">
+                    <input type="hidden" name="cid" value="{{cid}}"/>
+                    <input type="hidden" name="lid" value="{{lid}}"/>
+                    <input type="hidden" name="oid" value="{{o.Id}}"/>
+                    <input type="submit" value="Cancel"/>
+                </form>{% endif %}
+            </td>
+        </tr>{% endfor %}
+        </tbody>
+    </table>
+</div>
+<div><h2>New order</h2>
+    <form action="/action/market/place" method="post">
+        <input type="hidden" name="cid" value="{{cid}}"/>
+        <input type="hidden" name="lid" value="{{lid}}"/>
+        <select name="side">
+            <option value="bid">Buy</option>
+            <option value="ask">Sell</option>
+        </select>
+        <input type="number" name="quantity" min="1" value="1"/>
+        <select name="resource">{% for r in Tradable %}
+            <option value="{{r}}">r{{r}}</option>{% endfor %}
+        </select>
+        at <input type="number" name="price" min="1" value="1"/> r{{Currency}} each
+        <input type="submit" value="Place"/>
+    </form>
+</div>
+{% include "footer.tpl" %}
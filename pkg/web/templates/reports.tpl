@@ -0,0 +1,36 @@
+{% include "header.tpl" %}
+<div class="large"><h2>Reports ({{Unread}} unread)</h2>
+    <p>{% if UnreadOnly %}
+        <a href="/game/reports?cid={{cid}}{% if lid %}&lid={{lid}}{% endif %}">All the reports</a>
+        {% else %}
+        <a href="/game/reports?cid={{cid}}{% if lid %}&lid={{lid}}{% endif %}&unread=1">Only the unread reports</a>
+        {% endif %}</p>
+    <table>
+        <tbody>{% for r in Reports %}
+        <tr>
+            <td>{{r.When}}</td>
+            <td>{% if not lid %}<a href="/game/land/overview?cid={{cid}}&lid={{r.City}}">city {{r.City}}</a>{% endif %}</td>
+            <td>{% if r.Read %}{{r.Text}}{% else %}<strong>{{r.Text}}</strong>{% endif %}</td>
+            <td>{% if not r.Read %}
+                <form action="/action/reports/read" method="post">
+                    <input type="hidden" name="cid" value="{{cid}}"/>
+                    <input type="hidden" name="lid" value="{{lid}}"/>
+                    <input type="hidden" name="rid" value="{{r.Id}}"/>
+                    <input type="submit" value="Read"/>
+                </form>{% endif %}
+            </td>
+        </tr>{% endfor %}
+        </tbody>
+    </table>
+    {% if Next %}
+    <p><a href="/game/reports?cid={{cid}}{% if lid %}&lid={{lid}}{% endif %}{% if UnreadOnly %}&unread=1{% endif %}&marker={{Next}}">Older reports</a></p>
+    {% endif %}
+</div>
+<div><h2>Inbox</h2>
+    <form action="/action/reports/read" method="post">
+        <input type="hidden" name="cid" value="{{cid}}"/>
+        <input type="hidden" name="lid" value="{{lid}}"/>
+        <input type="submit" value="Mark all as read"/>
+    </form>
+</div>
+{% include "footer.tpl" %}